@@ -287,6 +287,28 @@ func getManifestListMembers(cmd *cobra.Command, list, toComplete string) ([]stri
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getPlugins(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	plugins, err := engine.PluginList(registry.Context(), entities.PluginListOptions{})
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, p := range plugins {
+		if strings.HasPrefix(p.Name, toComplete) {
+			suggestions = append(suggestions, p.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func getSecrets(cmd *cobra.Command, toComplete string, cType completeType) ([]string, cobra.ShellCompDirective) {
 	suggestions := []string{}
 
@@ -730,6 +752,14 @@ func AutocompleteVolumes(cmd *cobra.Command, args []string, toComplete string) (
 }
 
 // AutocompleteSecrets - Autocomplete secrets.
+// AutocompletePlugins - Autocomplete volume plugin names.
+func AutocompletePlugins(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !ValidCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getPlugins(cmd, toComplete)
+}
+
 func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !ValidCurrentCmdLine(cmd, args, toComplete) {
 		return nil, cobra.ShellCompDirectiveNoFileComp
@@ -1178,6 +1208,25 @@ func AutocompleteLogOpt(_ *cobra.Command, _ []string, toComplete string) ([]stri
 	return logOptions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
 }
 
+// AutocompleteDfGroupBy - Autocomplete system df --group-by options.
+// -> "pod", "project", "label="
+func AutocompleteDfGroupBy(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.HasPrefix(toComplete, "label=") {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return []string{"pod", "project", "label="}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// AutocompleteRequestClasses - Autocomplete the request classes of the API service limits.
+// -> "pull=", "build=", "default="
+func AutocompleteRequestClasses(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.Contains(toComplete, "=") {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	classes := []string{"pull=", "build=", "default="}
+	return classes, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
 // AutocompletePullOption - Autocomplete pull options for create and run command.
 // -> "always", "missing", "never"
 func AutocompletePullOption(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
@@ -1585,14 +1634,15 @@ func AutocompleteEventFilter(cmd *cobra.Command, _ []string, toComplete string)
 	event := func(_ string) ([]string, cobra.ShellCompDirective) {
 		return []string{
 			events.Attach.String(), events.AutoUpdate.String(), events.Checkpoint.String(), events.Cleanup.String(),
-			events.Commit.String(), events.Create.String(), events.Exec.String(), events.ExecDied.String(),
-			events.Exited.String(), events.Export.String(), events.Import.String(), events.Init.String(), events.Kill.String(),
-			events.LoadFromArchive.String(), events.Mount.String(), events.NetworkConnect.String(),
+			events.Commit.String(), events.Create.String(), events.Denied.String(), events.Exec.String(), events.ExecDied.String(),
+			events.Exited.String(), events.Export.String(), events.GarbageCollect.String(), events.Import.String(), events.Init.String(),
+			events.Kill.String(), events.LoadFromArchive.String(), events.Mount.String(), events.NetworkConnect.String(),
 			events.NetworkDisconnect.String(), events.Pause.String(), events.Prune.String(), events.Pull.String(),
 			events.PullError.String(), events.Push.String(), events.Refresh.String(), events.Remove.String(),
 			events.Rename.String(), events.Renumber.String(), events.Restart.String(), events.Restore.String(),
-			events.Save.String(), events.Start.String(), events.Stop.String(), events.Sync.String(), events.Tag.String(),
-			events.Unmount.String(), events.Unpause.String(), events.Untag.String(), events.Update.String(),
+			events.Save.String(), events.Start.String(), events.Stop.String(), events.StorageQuotaExceeded.String(),
+			events.Sync.String(), events.Tag.String(), events.Unmount.String(), events.Unpause.String(), events.Untag.String(),
+			events.Update.String(),
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 	eventTypes := func(_ string) ([]string, cobra.ShellCompDirective) {
@@ -1774,6 +1824,13 @@ func AutocompleteSDNotify(_ *cobra.Command, _ []string, _ string) ([]string, cob
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteStorageQuotaAction - Autocomplete storage quota action options.
+// -> "pause", "stop"
+func AutocompleteStorageQuotaAction(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	actions := []string{define.StorageQuotaActionPause, define.StorageQuotaActionStop}
+	return actions, cobra.ShellCompDirectiveNoFileComp
+}
+
 var containerStatuses = []string{"created", "running", "paused", "stopped", "exited", "unknown"}
 
 var quadletStatuses = []string{entities.QuadletStatusNotLoaded, entities.QuadletStatusLoadedTemplate, "active/running", "inactive/dead", "failed/failed", "activating/start", "deactivating/stop"}
@@ -1960,6 +2017,18 @@ func AutocompleteSecretFilters(cmd *cobra.Command, _ []string, toComplete string
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompletePluginFilters - Autocomplete plugin ls --filter options.
+func AutocompletePluginFilters(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kv := keyValueCompletion{
+		"capability=": func(_ string) ([]string, cobra.ShellCompDirective) {
+			return []string{"VolumeDriver"}, cobra.ShellCompDirectiveNoFileComp
+		},
+		"enabled=": getBoolCompletion,
+		"name=":    func(s string) ([]string, cobra.ShellCompDirective) { return getPlugins(cmd, s) },
+	}
+	return completeKeyValues(toComplete, kv)
+}
+
 // AutocompleteCheckpointCompressType - Autocomplete checkpoint compress type options.
 // -> "gzip", "none", "zstd"
 func AutocompleteCheckpointCompressType(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
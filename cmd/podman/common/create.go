@@ -380,6 +380,22 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(stopTimeoutFlagName, completion.AutocompleteNone)
 
+		storageQuotaFlagName := "storage-quota"
+		createFlags.StringVar(
+			&cf.StorageQuota,
+			storageQuotaFlagName, "",
+			"Limit the combined size of the writable layer and the anonymous volumes of the container "+sizeWithUnitFormat,
+		)
+		_ = cmd.RegisterFlagCompletionFunc(storageQuotaFlagName, completion.AutocompleteNone)
+
+		storageQuotaActionFlagName := "storage-quota-action"
+		createFlags.StringVar(
+			&cf.StorageQuotaAction,
+			storageQuotaActionFlagName, "",
+			`Action to take when the storage quota is exceeded and not enforced by the file system ("pause"|"stop")`,
+		)
+		_ = cmd.RegisterFlagCompletionFunc(storageQuotaActionFlagName, AutocompleteStorageQuotaAction)
+
 		systemdFlagName := "systemd"
 		createFlags.StringVar(
 			&cf.Systemd,
@@ -993,6 +1009,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		_ = cmd.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
 	}
 	// anyone can use these
+	DefineResourceFlags(cmd, cf)
+}
+
+// DefineResourceFlags defines the cgroup resource limit flags shared by
+// container and pod commands.
+func DefineResourceFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions) {
+	createFlags := cmd.Flags()
+
 	cpusFlagName := "cpus"
 	createFlags.Float64Var(
 		&cf.CPUS,
@@ -0,0 +1,46 @@
+package containers
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+)
+
+var (
+	checkStorageQuotaDescription = `
+   podman container check-storage-quota
+
+   Checks the storage usage of a running container against its storage quota, and pauses or stops the container if the quota is exceeded. This command is used internally to monitor the storage quota of containers.
+`
+	checkStorageQuotaCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "check-storage-quota CONTAINER",
+		Short:             "Check the storage quota of a container",
+		Long:              checkStorageQuotaDescription,
+		RunE:              checkStorageQuota,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainersRunning,
+		Hidden:            true,
+		Example:           `podman container check-storage-quota ctrID`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Parent:  containerCmd,
+		Command: checkStorageQuotaCommand,
+	})
+}
+
+func checkStorageQuota(_ *cobra.Command, args []string) error {
+	exceeded, err := registry.ContainerEngine().ContainerCheckStorageQuota(registry.Context(), args[0])
+	if err != nil {
+		return err
+	}
+	if exceeded {
+		fmt.Println("exceeded")
+	}
+	return nil
+}
@@ -35,6 +35,9 @@ func cloneFlags(cmd *cobra.Command) {
 	runFlagName := "run"
 	flags.BoolVar(&ctrClone.Run, runFlagName, false, "run the new container")
 
+	copyChangesFlagName := "copy-changes"
+	flags.BoolVar(&ctrClone.CopyChanges, copyChangesFlagName, false, "copy the writable layer and anonymous volumes of the container to the clone")
+
 	forceFlagName := "force"
 	flags.BoolVarP(&ctrClone.Force, forceFlagName, "f", false, "force the existing container to be destroyed")
 
@@ -72,6 +72,12 @@ func createFlags(cmd *cobra.Command) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(initContainerFlagName, common.AutocompleteInitCtr)
 
+	flags.BoolVar(
+		&cliVals.Sidecar,
+		"sidecar", false,
+		"Make this a pod sidecar container, started before and stopped after the other containers of the pod.",
+	)
+
 	flags.SetInterspersed(false)
 	common.DefineCreateDefaults(&cliVals)
 	common.DefineCreateFlags(cmd, &cliVals, entities.CreateMode)
@@ -132,6 +138,14 @@ func create(cmd *cobra.Command, args []string) error {
 		}
 		cliVals.InitContainerType = initctr
 	}
+	if cliVals.Sidecar {
+		if !cmd.Flags().Changed("pod") {
+			return errors.New("must specify pod value with sidecar")
+		}
+		if cmd.Flags().Changed("init-ctr") {
+			return errors.New("--sidecar and --init-ctr cannot be used together")
+		}
+	}
 	// TODO: v5.0 block users from setting restart policy for a container if the container is in a pod
 
 	cliVals, err := CreateInit(cmd, cliVals, false)
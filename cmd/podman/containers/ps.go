@@ -54,6 +54,7 @@ var (
 	}
 	filters []string
 	noTrunc bool
+	removed bool
 )
 
 func init() {
@@ -94,6 +95,7 @@ func listFlagSet(cmd *cobra.Command) {
 	flags.BoolVarP(&listOpts.Pod, "pod", "p", false, "Print the ID and name of the pod the containers are associated with")
 	flags.BoolVarP(&listOpts.Quiet, "quiet", "q", false, "Print the numeric IDs of the containers only")
 	flags.Bool("noheading", false, "Do not print headers")
+	flags.BoolVar(&removed, "removed", false, "Show containers that have been removed")
 	flags.BoolVarP(&listOpts.Size, "size", "s", false, "Display the total file sizes")
 	flags.BoolVar(&listOpts.Sync, "sync", false, "Sync container state with OCI runtime")
 
@@ -114,6 +116,14 @@ func checkFlags(c *cobra.Command) error {
 	if listOpts.Last >= 0 && listOpts.Latest {
 		return errors.New("last and latest are mutually exclusive")
 	}
+	// Removed containers only have the fields kept in their tombstones.
+	if removed {
+		for _, name := range []string{"all", "external", "filter", "latest", "ns", "pod", "size", "sort", "sync", "watch"} {
+			if c.Flags().Changed(name) {
+				return fmt.Errorf("--removed and --%s cannot be used together", name)
+			}
+		}
+	}
 	// Quiet conflicts with size and namespace and is overridden by a Go
 	// template.
 	if listOpts.Quiet {
@@ -192,6 +202,10 @@ func ps(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if removed {
+		return psRemoved(cmd)
+	}
+
 	if !listOpts.Pod {
 		listOpts.Pod = strings.Contains(listOpts.Format, ".PodName")
 	}
@@ -422,6 +436,14 @@ func (l psReporter) Size() string {
 
 	virt := units.HumanSizeWithPrecision(float64(l.ListContainer.Size.RootFsSize), 3)
 	s := units.HumanSizeWithPrecision(float64(l.ListContainer.Size.RwSize), 3)
+	if l.ListContainer.Size.StorageQuota > 0 {
+		quota := units.HumanSizeWithPrecision(float64(l.ListContainer.Size.StorageQuota), 3)
+		if l.ListContainer.Size.StorageQuotaUsage == nil {
+			return fmt.Sprintf("%s (virtual %s, quota %s)", s, virt, quota)
+		}
+		usage := units.HumanSizeWithPrecision(float64(*l.ListContainer.Size.StorageQuotaUsage), 3)
+		return fmt.Sprintf("%s (virtual %s, quota %s / %s)", s, virt, usage, quota)
+	}
 	return fmt.Sprintf("%s (virtual %s)", s, virt)
 }
 
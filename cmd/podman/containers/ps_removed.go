@@ -0,0 +1,146 @@
+package containers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+// psRemoved lists the tombstones of removed containers.
+func psRemoved(cmd *cobra.Command) error {
+	tombstones, err := registry.ContainerEngine().ContainerListRemoved(registry.Context(), entities.ContainerListRemovedOptions{Last: listOpts.Last})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case report.IsJSON(listOpts.Format):
+		b, err := json.MarshalIndent(tombstones, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	case listOpts.Quiet && !cmd.Flags().Changed("format"):
+		for _, t := range tombstones {
+			fmt.Println(removedReporter{t}.ID())
+		}
+		return nil
+	}
+
+	responses := make([]removedReporter, 0, len(tombstones))
+	for _, t := range tombstones {
+		responses = append(responses, removedReporter{t})
+	}
+
+	hdrs := report.Headers(removedReporter{}, map[string]string{
+		"CreatedHuman": "created",
+		"ID":           "container id",
+		"RemovedHuman": "removed",
+	})
+	format := "{{range .}}{{.ID}}\t{{.Image}}\t{{.Command}}\t{{.CreatedHuman}}\t{{.Status}}\t{{.RemovedHuman}}\t{{.Names}}\n{{end -}}"
+
+	var origin report.Origin
+	noHeading, _ := cmd.Flags().GetBool("noheading")
+	if cmd.Flags().Changed("format") {
+		noHeading = noHeading || !report.HasTable(listOpts.Format)
+		format = listOpts.Format
+		origin = report.OriginUser
+	} else {
+		origin = report.OriginPodman
+	}
+
+	rpt, err := report.New(os.Stdout, cmd.Name()).Parse(origin, format)
+	if err != nil {
+		return err
+	}
+	defer rpt.Flush()
+
+	if !noHeading {
+		if err := rpt.Execute(hdrs); err != nil {
+			return err
+		}
+	}
+	return rpt.Execute(responses)
+}
+
+type removedReporter struct {
+	entities.ContainerTombstone
+}
+
+// ID returns the ID of the container
+func (l removedReporter) ID() string {
+	if !noTrunc && len(l.ContainerTombstone.ID) > 12 {
+		return l.ContainerTombstone.ID[0:12]
+	}
+	return l.ContainerTombstone.ID
+}
+
+// ImageID returns the ID of the image of the container
+func (l removedReporter) ImageID() string {
+	if !noTrunc && len(l.ContainerTombstone.ImageID) > 12 {
+		return l.ContainerTombstone.ImageID[0:12]
+	}
+	return l.ContainerTombstone.ImageID
+}
+
+// Pod returns the ID of the pod the container belonged to
+func (l removedReporter) Pod() string {
+	if !noTrunc && len(l.ContainerTombstone.Pod) > 12 {
+		return l.ContainerTombstone.Pod[0:12]
+	}
+	return l.ContainerTombstone.Pod
+}
+
+// Command returns the container command in string format
+func (l removedReporter) Command() string {
+	command := strings.Join(l.ContainerTombstone.Command, " ")
+	if !noTrunc {
+		if len(command) > 17 {
+			return command[0:17] + "..."
+		}
+	}
+	return command
+}
+
+// Names returns the name of the container
+func (l removedReporter) Names() string {
+	return l.Name
+}
+
+// Status returns the state the container was removed in
+func (l removedReporter) Status() string {
+	switch l.State {
+	case "exited", "stopped":
+		t := units.HumanDuration(time.Since(l.FinishedAt))
+		return fmt.Sprintf("Exited (%d) %s ago", l.ExitCode, t)
+	case "":
+		return ""
+	default:
+		return strings.ToUpper(l.State[:1]) + l.State[1:]
+	}
+}
+
+// CreatedHuman returns the creation time in human readable format
+func (l removedReporter) CreatedHuman() string {
+	return units.HumanDuration(time.Since(l.Created)) + " ago"
+}
+
+// RemovedHuman returns the removal time in human readable format
+func (l removedReporter) RemovedHuman() string {
+	return units.HumanDuration(time.Since(l.Removed)) + " ago"
+}
+
+// Labels returns the container's labels as a sorted, comma-separated list of
+// key=value pairs
+func (l removedReporter) Labels() string {
+	return common.FormatLabels(l.ContainerTombstone.Labels)
+}
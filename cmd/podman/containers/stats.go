@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 
 	"github.com/docker/go-units"
@@ -149,6 +150,7 @@ func outputStats(cmd *cobra.Command, reports []define.ContainerStats) error {
 		"NetIO":         "NET IO",
 		"BlockIO":       "BLOCK IO",
 		"PIDS":          "PIDS",
+		"StorageUsage":  "STORAGE USAGE / QUOTA",
 	})
 	if !statsOptions.NoReset {
 		common.ClearScreen()
@@ -168,7 +170,12 @@ func outputStats(cmd *cobra.Command, reports []define.ContainerStats) error {
 	if cmd.Flags().Changed("format") {
 		rpt, err = rpt.Parse(report.OriginUser, statsOptions.Format)
 	} else {
-		format := "{{range .}}{{.ID}}\t{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}\t{{.MemPerc}}\t{{.NetIO}}\t{{.BlockIO}}\t{{.PIDS}}\t{{.UpTime}}\t{{.AVGCPU}}\n{{end -}}"
+		format := "{{range .}}{{.ID}}\t{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}\t{{.MemPerc}}\t{{.NetIO}}\t{{.BlockIO}}\t{{.PIDS}}\t{{.UpTime}}\t{{.AVGCPU}}"
+		// Only show the storage usage if a container has a quota
+		if slices.ContainsFunc(reports, func(r define.ContainerStats) bool { return r.StorageQuota > 0 }) {
+			format += "\t{{.StorageUsage}}"
+		}
+		format += "\n{{end -}}"
 		rpt, err = rpt.Parse(report.OriginPodman, format)
 	}
 	if err != nil {
@@ -230,6 +237,13 @@ func (s *containerStats) PIDS() string {
 	return strconv.FormatUint(s.PIDs, 10)
 }
 
+func (s *containerStats) StorageUsage() string {
+	if s.StorageQuota == 0 {
+		return "--"
+	}
+	return combineHumanValues(s.StorageQuotaUsage, s.StorageQuota)
+}
+
 func (s *containerStats) MemUsage() string {
 	return combineHumanValues(s.ContainerStats.MemUsage, s.ContainerStats.MemLimit)
 }
@@ -262,9 +276,15 @@ func outputJSON(stats []containerStats) error {
 		NetIO      string `json:"net_io"`
 		BlockIO    string `json:"block_io"`
 		Pids       string `json:"pids"`
+		// StorageUsage is only set for containers with a storage quota
+		StorageUsage string `json:"storage_usage,omitempty"`
 	}
 	jstats := make([]jstat, 0, len(stats))
 	for _, j := range stats {
+		var storageUsage string
+		if j.StorageQuota > 0 {
+			storageUsage = j.StorageUsage()
+		}
 		jstats = append(jstats, jstat{
 			Id:         j.ID(),
 			Name:       j.Name,
@@ -276,6 +296,8 @@ func outputJSON(stats []containerStats) error {
 			NetIO:      j.NetIO(),
 			BlockIO:    j.BlockIO(),
 			Pids:       j.PIDS(),
+
+			StorageUsage: storageUsage,
 		})
 	}
 	b, err := json.MarshalIndent(jstats, "", " ")
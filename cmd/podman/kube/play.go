@@ -342,20 +342,9 @@ func play(cmd *cobra.Command, args []string) error {
 		})
 	}
 
+	// On failure, the pods, volumes and secrets created before the error
+	// have already been removed, while the ones which existed are kept.
 	if playErr := kubeplay(reader); playErr != nil {
-		// FIXME: The cleanup logic below must be fixed to only remove
-		// resources that were created before a failure.  Otherwise,
-		// rerunning the same YAML file will cause an error and remove
-		// the previously created workload.
-		//
-		// teardown any containers, pods, and volumes that might have been created before we hit the error
-		// reader, err := readerFromArg(args[0])
-		// if err != nil {
-		// 	return err
-		// }
-		// if err := teardown(reader, entities.PlayKubeDownOptions{Force: true}, true); err != nil && !errorhandling.Contains(err, define.ErrNoSuchPod) {
-		// 	return fmt.Errorf("error tearing down workloads %q after kube play error %q", err, playErr)
-		// }
 		return playErr
 	}
 
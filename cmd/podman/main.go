@@ -20,6 +20,7 @@ import (
 	_ "go.podman.io/podman/v6/cmd/podman/machine/os"
 	_ "go.podman.io/podman/v6/cmd/podman/manifest"
 	_ "go.podman.io/podman/v6/cmd/podman/networks"
+	_ "go.podman.io/podman/v6/cmd/podman/plugins"
 	_ "go.podman.io/podman/v6/cmd/podman/pods"
 	_ "go.podman.io/podman/v6/cmd/podman/quadlet"
 	"go.podman.io/podman/v6/cmd/podman/registry"
@@ -0,0 +1,77 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:               "inspect [options] PLUGIN [PLUGIN...]",
+		Short:             "Inspect a volume plugin",
+		Long:              "Display detailed information on one or more volume plugins",
+		RunE:              inspect,
+		Example:           "podman plugin inspect myplugin",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompletePlugins,
+	}
+	inspectFormat string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: inspectCmd,
+		Parent:  pluginCmd,
+	})
+	flags := inspectCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVarP(&inspectFormat, formatFlagName, "f", "", "Format inspect output using Go template")
+	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.PluginReport{}))
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	inspected, errs, err := registry.ContainerEngine().PluginInspect(context.Background(), args)
+	if err != nil {
+		return err
+	}
+
+	// always print valid list
+	if len(inspected) == 0 {
+		inspected = []*entities.PluginReport{}
+	}
+
+	if cmd.Flags().Changed("format") {
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		rpt, err := rpt.Parse(report.OriginUser, inspectFormat)
+		if err != nil {
+			return err
+		}
+		if err := rpt.Execute(inspected); err != nil {
+			return err
+		}
+	} else {
+		buf, err := json.MarshalIndent(inspected, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	}
+
+	if len(errs) > 0 {
+		for _, err := range errs[1:] {
+			fmt.Fprintf(os.Stderr, "error inspecting plugin: %v\n", err)
+		}
+		return fmt.Errorf("inspecting plugin: %w", errs[0])
+	}
+	return nil
+}
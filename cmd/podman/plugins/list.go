@@ -0,0 +1,106 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/parse"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	lsDescription = `List the volume plugins configured in containers.conf.
+
+  The plugins are contacted to report whether they are reachable and which capabilities they implement.`
+	lsCmd = &cobra.Command{
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Short:             "List volume plugins",
+		Long:              lsDescription,
+		RunE:              ls,
+		Example:           "podman plugin ls --filter enabled=false",
+		Args:              validate.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+	listFlag = listFlagType{}
+)
+
+type listFlagType struct {
+	format    string
+	noHeading bool
+	filter    []string
+	quiet     bool
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: lsCmd,
+		Parent:  pluginCmd,
+	})
+
+	flags := lsCmd.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&listFlag.format, formatFlagName, "{{range .}}{{.Name}}\t{{.Reachable}}\t{{join .Implements \",\"}}\t{{.SocketPath}}\n{{end -}}", "Format plugin output using Go template")
+	_ = lsCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.PluginReport{}))
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&listFlag.filter, filterFlagName, "f", []string{}, "Filter plugin output")
+	_ = lsCmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePluginFilters)
+
+	noHeadingFlagName := "noheading"
+	flags.BoolVarP(&listFlag.noHeading, noHeadingFlagName, "n", false, "Do not print headers")
+
+	quietFlagName := "quiet"
+	flags.BoolVarP(&listFlag.quiet, quietFlagName, "q", false, "Print plugin names only")
+}
+
+func ls(cmd *cobra.Command, _ []string) error {
+	filters, err := parse.FilterArgumentsIntoFilters(listFlag.filter)
+	if err != nil {
+		return err
+	}
+
+	responses, err := registry.ContainerEngine().PluginList(context.Background(), entities.PluginListOptions{Filter: filters})
+	if err != nil {
+		return err
+	}
+
+	if listFlag.quiet && !cmd.Flags().Changed("format") {
+		for _, response := range responses {
+			fmt.Println(response.Name)
+		}
+		return nil
+	}
+
+	headers := report.Headers(entities.PluginReport{}, map[string]string{
+		"Implements": "CAPABILITIES",
+		"SocketPath": "SOCKET",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, listFlag.format)
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman, listFlag.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !listFlag.noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(responses)
+}
@@ -0,0 +1,21 @@
+package plugins
+
+import (
+	"github.com/spf13/cobra"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+)
+
+// Command: podman _plugin_
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage volume plugins",
+	Long:  "List and inspect the volume plugins configured in containers.conf",
+	RunE:  validate.SubCommandExists,
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginCmd,
+	})
+}
@@ -0,0 +1,111 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/utils"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/rootless"
+	"go.podman.io/storage/pkg/archive"
+)
+
+var (
+	podCheckpointDescription = `The pod name or ID can be used.
+
+  All running containers within each specified pod are frozen and checkpointed together. The infra container keeps running.`
+	checkpointCommand = &cobra.Command{
+		Use:   "checkpoint [options] POD [POD...]",
+		Short: "Checkpoint one or more pods",
+		Long:  podCheckpointDescription,
+		RunE:  checkpoint,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return validate.CheckAllLatestAndIDFile(cmd, args, false, "")
+		},
+		ValidArgsFunction: common.AutocompletePodsRunning,
+		Example: `podman pod checkpoint podID
+podman pod checkpoint --export=/tmp/pod.tar mypod
+podman pod checkpoint --all`,
+	}
+)
+
+var checkpointOptions entities.PodCheckpointOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: checkpointCommand,
+		Parent:  podCmd,
+	})
+	flags := checkpointCommand.Flags()
+	flags.BoolVarP(&checkpointOptions.All, "all", "a", false, "Checkpoint all running pods")
+	flags.BoolVarP(&checkpointOptions.Keep, "keep", "k", false, "Keep all temporary checkpoint files")
+	flags.BoolVarP(&checkpointOptions.LeaveRunning, "leave-running", "R", false, "Leave the containers running after writing checkpoint to disk")
+	flags.BoolVar(&checkpointOptions.TCPEstablished, "tcp-established", false, "Checkpoint containers with established TCP connections")
+	flags.BoolVar(&checkpointOptions.FileLocks, "file-locks", false, "Checkpoint containers with file locks")
+
+	exportFlagName := "export"
+	flags.StringVarP(&checkpointOptions.Export, exportFlagName, "e", "", "Export the pod checkpoint, including the pod configuration, to a tar archive")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&checkpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
+	flags.BoolVar(&checkpointOptions.IgnoreVolumes, "ignore-volumes", false, "Do not export volumes associated with the containers")
+
+	flags.StringP("compress", "c", "zstd", "Select compression algorithm (gzip, none, zstd) for the container checkpoints in the archive.")
+	_ = checkpointCommand.RegisterFlagCompletionFunc("compress", common.AutocompleteCheckpointCompressType)
+
+	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
+}
+
+func checkpoint(cmd *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	if cmd.Flags().Changed("compress") {
+		if checkpointOptions.Export == "" {
+			return errors.New("--compress can only be used with --export")
+		}
+		compress, _ := cmd.Flags().GetString("compress")
+		switch strings.ToLower(compress) {
+		case "none":
+			checkpointOptions.Compression = archive.Uncompressed
+		case "gzip":
+			checkpointOptions.Compression = archive.Gzip
+		case "zstd":
+			checkpointOptions.Compression = archive.Zstd
+		default:
+			return fmt.Errorf("selected compression algorithm (%q) not supported. Please select one from: gzip, none, zstd", compress)
+		}
+	} else {
+		checkpointOptions.Compression = archive.Zstd
+	}
+	if rootless.IsRootless() {
+		return errors.New("checkpointing a pod requires root")
+	}
+	if checkpointOptions.Export == "" && checkpointOptions.IgnoreRootFS {
+		return errors.New("--ignore-rootfs can only be used with --export")
+	}
+	if checkpointOptions.Export == "" && checkpointOptions.IgnoreVolumes {
+		return errors.New("--ignore-volumes can only be used with --export")
+	}
+	if checkpointOptions.Export != "" && (checkpointOptions.All || len(args) > 1) {
+		return errors.New("--export can only be used with a single pod")
+	}
+	responses, err := registry.ContainerEngine().PodCheckpoint(context.Background(), args, checkpointOptions)
+	if err != nil {
+		return err
+	}
+	// in the cli, first we print out all the successful attempts
+	for _, r := range responses {
+		if len(r.Errs) == 0 {
+			fmt.Println(r.Id)
+		} else {
+			errs = append(errs, r.Errs...)
+		}
+	}
+	return errs.PrintErrors()
+}
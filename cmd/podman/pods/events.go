@@ -0,0 +1,221 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/system"
+	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	podEventsDescription = `Monitor the events of a pod and of all containers that are or ever were part of it, including removed containers.
+
+  By default, streaming mode is used, printing new events as they occur.  Previous events can be listed via --since and --until.
+  With --timeline, the lifecycle transitions of the containers are shown side by side.`
+	podEventsCommand = &cobra.Command{
+		Use:               "events [options] POD",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Show the events of a pod and its containers",
+		Long:              podEventsDescription,
+		RunE:              podEvents,
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod events mypod
+podman pod events --stream=false --filter event=died mypod
+podman pod events --timeline --since 1h mypod`,
+	}
+)
+
+var (
+	podEventsOptions entities.PodEventsOptions
+	podEventsFormat  string
+	podEventsNoTrunc bool
+	podEventTimeline bool
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: podEventsCommand,
+		Parent:  podCmd,
+	})
+	flags := podEventsCommand.Flags()
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&podEventsOptions.Filter, filterFlagName, "f", []string{}, "filter output")
+	_ = podEventsCommand.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteEventFilter)
+
+	formatFlagName := "format"
+	flags.StringVar(&podEventsFormat, formatFlagName, "", "format the output using a Go template")
+	_ = podEventsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&system.Event{}))
+
+	flags.BoolVar(&podEventsOptions.Stream, "stream", true, "stream events and do not exit when returning the last known event")
+
+	sinceFlagName := "since"
+	flags.StringVar(&podEventsOptions.Since, sinceFlagName, "", "show all events created since timestamp")
+	_ = podEventsCommand.RegisterFlagCompletionFunc(sinceFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&podEventsNoTrunc, "no-trunc", true, "do not truncate the output")
+
+	untilFlagName := "until"
+	flags.StringVar(&podEventsOptions.Until, untilFlagName, "", "show all events until timestamp")
+	_ = podEventsCommand.RegisterFlagCompletionFunc(untilFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&podEventTimeline, "timeline", false, "show the lifecycle transitions of the containers side by side")
+}
+
+func podEvents(cmd *cobra.Command, args []string) error {
+	if podEventTimeline {
+		if cmd.Flags().Changed("format") {
+			return errors.New("--timeline and --format cannot be used together")
+		}
+		if cmd.Flags().Changed("stream") && podEventsOptions.Stream {
+			return errors.New("--timeline and --stream cannot be used together")
+		}
+		// The timeline is rendered once all past events are read.
+		podEventsOptions.Stream = false
+		podEventsOptions.FromStart = true
+	}
+	if len(podEventsOptions.Since) > 0 || len(podEventsOptions.Until) > 0 {
+		podEventsOptions.FromStart = true
+	}
+	eventChannel := make(chan events.ReadResult, 1)
+	podEventsOptions.EventChan = eventChannel
+
+	printer, err := system.NewEventPrinter(podEventsFormat, podEventsNoTrunc)
+	if err != nil {
+		return err
+	}
+
+	if err := registry.ContainerEngine().PodEvents(context.Background(), args[0], podEventsOptions); err != nil {
+		return err
+	}
+
+	var podEvts []*events.Event
+	for evt := range eventChannel {
+		if evt.Error != nil {
+			logrus.Errorf("Failed to read event: %v", evt.Error)
+			continue
+		}
+		if podEventTimeline {
+			podEvts = append(podEvts, evt.Event)
+			continue
+		}
+		if err := printer.Print(evt.Event); err != nil {
+			return err
+		}
+	}
+	if !podEventTimeline {
+		return nil
+	}
+
+	header, rows := buildTimeline(podEvts)
+	w, err := report.NewWriterDefault(os.Stdout)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(w, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// timelineStatuses are the lifecycle transitions shown in the timeline.
+var timelineStatuses = map[events.Status]bool{
+	events.Checkpoint:   true,
+	events.Create:       true,
+	events.Exited:       true,
+	events.HealthStatus: true,
+	events.Init:         true,
+	events.Kill:         true,
+	events.Pause:        true,
+	events.Remove:       true,
+	events.Restart:      true,
+	events.Restore:      true,
+	events.Start:        true,
+	events.Stop:         true,
+	events.Unpause:      true,
+}
+
+// buildTimeline arranges the lifecycle events of a pod and its containers in
+// a table with one column for the pod and one for every container, in the
+// order they first appear, and one row per transition. Health status events
+// are only shown when the health of a container changes.
+func buildTimeline(evts []*events.Event) ([]string, [][]string) {
+	header := []string{"TIME", "POD"}
+	columns := make(map[string]int)
+	health := make(map[string]string)
+	rows := [][]string{}
+
+	for _, e := range evts {
+		if !timelineStatuses[e.Status] {
+			continue
+		}
+		var column int
+		switch e.Type {
+		case events.Pod:
+			column = 1
+		case events.Container:
+			if e.Status == events.HealthStatus {
+				if health[e.ID] == e.HealthStatus {
+					continue
+				}
+				health[e.ID] = e.HealthStatus
+			}
+			var ok bool
+			if column, ok = columns[e.ID]; !ok {
+				column = len(header)
+				columns[e.ID] = column
+				name := e.Name
+				if name == "" {
+					name = e.ID
+				}
+				header = append(header, name)
+			}
+		default:
+			continue
+		}
+
+		row := make([]string, column+1)
+		row[0] = e.Time.Format("2006-01-02 15:04:05.000")
+		row[column] = timelineCell(e)
+		rows = append(rows, row)
+	}
+
+	// Pad all rows to the final number of columns.
+	for i, row := range rows {
+		if len(row) < len(header) {
+			rows[i] = append(row, make([]string, len(header)-len(row))...)
+		}
+	}
+	return header, rows
+}
+
+func timelineCell(e *events.Event) string {
+	switch e.Status {
+	case events.HealthStatus:
+		return "health: " + e.HealthStatus
+	case events.Exited:
+		switch {
+		case e.OOMKilled != nil && *e.OOMKilled:
+			return "died (oom)"
+		case e.ContainerExitCode != nil:
+			return fmt.Sprintf("died (exit %d)", *e.ContainerExitCode)
+		}
+	}
+	return string(e.Status)
+}
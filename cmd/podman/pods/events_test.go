@@ -0,0 +1,43 @@
+package pods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/podman/v6/libpod/events"
+)
+
+func Test_buildTimeline(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time {
+		return start.Add(time.Duration(sec) * time.Second)
+	}
+	exitCode := 3
+	oomKilled := true
+
+	evts := []*events.Event{
+		{Type: events.Pod, ID: "pod", Name: "mypod", Status: events.Create, Time: at(0)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.Create, Time: at(1)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.Mount, Time: at(2)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.Start, Time: at(3)},
+		{Type: events.Container, ID: "b", Name: "db", Status: events.Start, Time: at(4)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.HealthStatus, HealthStatus: "healthy", Time: at(5)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.HealthStatus, HealthStatus: "healthy", Time: at(6)},
+		{Type: events.Network, ID: "a", Name: "web", Status: events.NetworkConnect, Time: at(7)},
+		{Type: events.Container, ID: "b", Name: "db", Status: events.Exited, ContainerExitCode: &exitCode, Time: at(8)},
+		{Type: events.Container, ID: "a", Name: "web", Status: events.Exited, OOMKilled: &oomKilled, Time: at(9)},
+	}
+
+	header, rows := buildTimeline(evts)
+	assert.Equal(t, []string{"TIME", "POD", "web", "db"}, header)
+	assert.Equal(t, [][]string{
+		{"2024-05-01 10:00:00.000", "create", "", ""},
+		{"2024-05-01 10:00:01.000", "", "create", ""},
+		{"2024-05-01 10:00:03.000", "", "start", ""},
+		{"2024-05-01 10:00:04.000", "", "", "start"},
+		{"2024-05-01 10:00:05.000", "", "health: healthy", ""},
+		{"2024-05-01 10:00:08.000", "", "", "died (exit 3)"},
+		{"2024-05-01 10:00:09.000", "", "died (oom)", ""},
+	}, rows)
+}
@@ -0,0 +1,121 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/utils"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/rootless"
+)
+
+var (
+	podRestoreDescription = `The pod name or ID can be used.
+
+  All checkpointed containers within each specified pod are restored. With --import, the pod is recreated from an archive created by 'podman pod checkpoint --export'.`
+	restoreCommand = &cobra.Command{
+		Use:   "restore [options] [POD...]",
+		Short: "Restore one or more pods from a checkpoint",
+		Long:  podRestoreDescription,
+		RunE:  restore,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return validate.CheckAllLatestAndIDFile(cmd, args, true, "")
+		},
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod restore podID
+podman pod restore --import=/tmp/pod.tar
+podman pod restore --import=/tmp/pod.tar --name=newpod`,
+	}
+)
+
+var restoreOptions entities.PodRestoreOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: restoreCommand,
+		Parent:  podCmd,
+	})
+	flags := restoreCommand.Flags()
+	flags.BoolVarP(&restoreOptions.All, "all", "a", false, "Restore all pods with checkpointed containers")
+	flags.BoolVarP(&restoreOptions.Keep, "keep", "k", false, "Keep all temporary checkpoint files")
+	flags.BoolVar(&restoreOptions.TCPEstablished, "tcp-established", false, "Restore containers with established TCP connections")
+	flags.BoolVar(&restoreOptions.TCPClose, "tcp-close", false, "Restore containers and close all TCP connections")
+	flags.BoolVar(&restoreOptions.FileLocks, "file-locks", false, "Restore containers with file locks")
+
+	importFlagName := "import"
+	flags.StringVarP(&restoreOptions.Import, importFlagName, "i", "", "Restore from exported pod checkpoint archive")
+	_ = restoreCommand.RegisterFlagCompletionFunc(importFlagName, completion.AutocompleteDefault)
+
+	nameFlagName := "name"
+	flags.StringVarP(&restoreOptions.Name, nameFlagName, "n", "", "Specify new name for the pod restored from exported checkpoint (only works with --import)")
+	_ = restoreCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&restoreOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not apply root file-system changes when importing from exported checkpoint")
+	flags.BoolVar(&restoreOptions.IgnoreStaticIP, "ignore-static-ip", false, "Ignore IP addresses set via --ip")
+	flags.BoolVar(&restoreOptions.IgnoreStaticMAC, "ignore-static-mac", false, "Ignore MAC addresses set via --mac-address")
+	flags.BoolVar(&restoreOptions.IgnoreVolumes, "ignore-volumes", false, "Do not import volumes associated with the containers")
+
+	flags.StringSliceVarP(
+		&restoreOptions.PublishPorts,
+		"publish", "p", []string{},
+		"Publish a port, or a range of ports, of the restored pod to the host (default [])",
+	)
+	_ = restoreCommand.RegisterFlagCompletionFunc("publish", completion.AutocompleteNone)
+
+	validate.AddLatestFlag(restoreCommand, &restoreOptions.Latest)
+}
+
+func restore(_ *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	if rootless.IsRootless() {
+		return errors.New("restoring a pod requires root")
+	}
+
+	notImport := restoreOptions.Import == ""
+	if notImport && restoreOptions.IgnoreRootFS {
+		return errors.New("--ignore-rootfs can only be used with --import")
+	}
+	if notImport && restoreOptions.IgnoreVolumes {
+		return errors.New("--ignore-volumes can only be used with --import")
+	}
+	if notImport && restoreOptions.Name != "" {
+		return errors.New("--name can only be used with --import")
+	}
+	if notImport && len(restoreOptions.PublishPorts) > 0 {
+		return errors.New("--publish can only be used with --import")
+	}
+	if restoreOptions.Name != "" && restoreOptions.TCPEstablished {
+		return errors.New("--tcp-established cannot be used with --name")
+	}
+
+	if !notImport {
+		if restoreOptions.All || restoreOptions.Latest {
+			return errors.New("cannot use --import with --all or --latest")
+		}
+		if len(args) > 0 {
+			return errors.New("cannot use --import with positional arguments")
+		}
+	} else if len(args) < 1 && !restoreOptions.All && !restoreOptions.Latest {
+		return errors.New("you must provide at least one name or id")
+	}
+
+	responses, err := registry.ContainerEngine().PodRestore(context.Background(), args, restoreOptions)
+	if err != nil {
+		return err
+	}
+	// in the cli, first we print out all the successful attempts
+	for _, r := range responses {
+		if len(r.Errs) == 0 {
+			fmt.Println(r.Id)
+		} else {
+			errs = append(errs, r.Errs...)
+		}
+	}
+	return errs.PrintErrors()
+}
@@ -0,0 +1,132 @@
+package pods
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/containers"
+	"go.podman.io/podman/v6/cmd/podman/parse"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/specgen"
+	"go.podman.io/podman/v6/pkg/specgenutil"
+	"go.podman.io/podman/v6/pkg/util"
+)
+
+var (
+	podUpdateDescription = `Updates the configuration of an existing pod, allowing changes to the pod cgroup resource limits, the restart policy of its containers and its labels.`
+
+	updateCommand = &cobra.Command{
+		Use:               "update [options] POD",
+		Short:             "Update an existing pod",
+		Long:              podUpdateDescription,
+		RunE:              update,
+		Args:              validate.IDOrLatestArgs,
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod update --cpus=2 --memory=1g mypod
+podman pod update --restart=always mypod`,
+	}
+)
+
+var (
+	updateOptions     entities.ContainerCreateOptions
+	updateLabels      []string
+	updateUnsetLabels []string
+	updateLatest      bool
+)
+
+// podResourceFlags are the flags that change the pod cgroup configuration.
+var podResourceFlags = []string{
+	"blkio-weight",
+	"blkio-weight-device",
+	"cpu-shares",
+	"cpus",
+	"cpuset-cpus",
+	"cpuset-mems",
+	"device-read-bps",
+	"device-write-bps",
+	"memory",
+	"memory-swap",
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCommand,
+		Parent:  podCmd,
+	})
+	flags := updateCommand.Flags()
+
+	common.DefineResourceFlags(updateCommand, &updateOptions)
+
+	restartFlagName := "restart"
+	flags.StringVar(&updateOptions.Restart, restartFlagName, "", `Restart policy to apply to the containers in the pod ("always"|"no"|"never"|"on-failure"|"unless-stopped")`)
+	_ = updateCommand.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+
+	labelFlagName := "label"
+	flags.StringArrayVar(&updateLabels, labelFlagName, []string{}, "Set or replace metadata on the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(labelFlagName, completion.AutocompleteNone)
+
+	unsetLabelFlagName := "unset-label"
+	flags.StringArrayVar(&updateUnsetLabels, unsetLabelFlagName, []string{}, "Remove metadata from the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(unsetLabelFlagName, completion.AutocompleteNone)
+
+	validate.AddLatestFlag(updateCommand, &updateLatest)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	opts := &entities.PodUpdateOptions{
+		Latest:      updateLatest,
+		UnsetLabels: updateUnsetLabels,
+	}
+	if !updateLatest {
+		opts.NameOrID = args[0]
+	}
+
+	if slices.ContainsFunc(podResourceFlags, cmd.Flags().Changed) {
+		// use a specgen since this is the easiest way to hold resource info
+		s := &specgen.SpecGenerator{}
+		s.ResourceLimits = &specs.LinuxResources{}
+		resources, err := specgenutil.GetResources(s, &updateOptions)
+		if err != nil {
+			return err
+		}
+		if resources == nil {
+			resources = &specs.LinuxResources{}
+		}
+		opts.Resources = resources
+		opts.DevicesLimits = containers.GetChangedDeviceLimits(s)
+	}
+
+	if cmd.Flags().Changed("restart") {
+		policy, retries, err := util.ParseRestartPolicy(updateOptions.Restart)
+		if err != nil {
+			return err
+		}
+		opts.RestartPolicy = &policy
+		if policy == define.RestartPolicyOnFailure {
+			opts.RestartRetries = &retries
+		}
+	}
+
+	if len(updateLabels) > 0 {
+		labels, err := parse.GetAllLabels(nil, updateLabels)
+		if err != nil {
+			return fmt.Errorf("unable to process labels: %w", err)
+		}
+		opts.Labels = labels
+	}
+
+	rep, err := registry.ContainerEngine().PodUpdate(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(rep)
+	return nil
+}
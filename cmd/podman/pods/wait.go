@@ -0,0 +1,95 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/utils"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	podWaitDescription = `The pod name or ID can be used.
+
+  Block until the containers of one or more pods meet the given conditions. When waiting for
+  the containers to exit, their exit codes are aggregated into one exit code per pod.`
+	waitCommand = &cobra.Command{
+		Use:               "wait [options] POD [POD...]",
+		Short:             "Block on one or more pods",
+		Long:              podWaitDescription,
+		RunE:              wait,
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod wait podID
+podman pod wait --exit-code-propagation all podID1 podID2
+podman pod wait --condition healthy --any podID`,
+	}
+)
+
+var (
+	waitOptions  entities.PodWaitOptions
+	waitInterval string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: waitCommand,
+		Parent:  podCmd,
+	})
+	flags := waitCommand.Flags()
+
+	intervalFlagName := "interval"
+	flags.StringVarP(&waitInterval, intervalFlagName, "i", "250ms", "Time Interval to wait before polling for completion")
+	_ = waitCommand.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
+
+	conditionFlagName := "condition"
+	flags.StringSliceVar(&waitOptions.Conditions, conditionFlagName, []string{}, "Condition to wait on")
+	_ = waitCommand.RegisterFlagCompletionFunc(conditionFlagName, common.AutocompleteWaitCondition)
+
+	flags.BoolVar(&waitOptions.Any, "any", false, "Return once any container of the pod meets a condition")
+
+	exitFlagName := "exit-code-propagation"
+	flags.StringVar(&waitOptions.ExitCodePropagation, exitFlagName, "", "How to aggregate the exit codes of the containers (none, any, all)")
+	_ = waitCommand.RegisterFlagCompletionFunc(exitFlagName, completion.AutocompleteNone)
+
+	validate.AddLatestFlag(waitCommand, &waitOptions.Latest)
+}
+
+func wait(cmd *cobra.Command, args []string) error {
+	var (
+		err  error
+		errs utils.OutputErrors
+	)
+	if waitOptions.Interval, err = time.ParseDuration(waitInterval); err != nil {
+		var err1 error
+		if waitOptions.Interval, err1 = time.ParseDuration(waitInterval + "ms"); err1 != nil {
+			return err
+		}
+	}
+
+	if !waitOptions.Latest && len(args) == 0 {
+		return fmt.Errorf("%q requires a name, id, or the \"--latest\" flag", cmd.CommandPath())
+	}
+	if waitOptions.Latest && len(args) > 0 {
+		return errors.New("--latest and pods cannot be used together")
+	}
+
+	responses, err := registry.ContainerEngine().PodWait(context.Background(), args, waitOptions)
+	if err != nil {
+		return err
+	}
+	for _, r := range responses {
+		if r.Err == nil {
+			fmt.Println(r.ExitCode)
+		} else {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errs.PrintErrors()
+}
@@ -680,6 +680,14 @@ func rootFlags(cmd *cobra.Command, podmanConfig *entities.PodmanConfig) {
 		pFlags.StringVar(&podmanConfig.ContainersConf.Engine.TmpDir, tmpdirFlagName, podmanConfig.ContainersConfDefaultsRO.Engine.TmpDir, "Path to the tmp directory for libpod state content.\n\nNote: use the environment variable 'TMPDIR' to change the temporary storage location for container images, '/var/tmp'.\n")
 		_ = cmd.RegisterFlagCompletionFunc(tmpdirFlagName, completion.AutocompleteDefault)
 
+		tombstoneCountFlagName := "tombstone-count"
+		pFlags.IntVar(&podmanConfig.TombstoneCount, tombstoneCountFlagName, define.DefaultTombstoneMaxCount, "Number of removed containers to keep tombstones of, 0 disables tombstones")
+		_ = cmd.RegisterFlagCompletionFunc(tombstoneCountFlagName, completion.AutocompleteNone)
+
+		tombstoneMaxAgeFlagName := "tombstone-max-age"
+		pFlags.DurationVar(&podmanConfig.TombstoneMaxAge, tombstoneMaxAgeFlagName, define.DefaultTombstoneMaxAge, "Time to keep tombstones of removed containers for, 0 keeps them regardless of age")
+		_ = cmd.RegisterFlagCompletionFunc(tombstoneMaxAgeFlagName, completion.AutocompleteNone)
+
 		pFlags.BoolVar(&podmanConfig.Trace, "trace", false, "Enable opentracing output (default false)")
 
 		volumePathFlagName := "volumepath"
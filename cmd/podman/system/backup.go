@@ -0,0 +1,63 @@
+package system
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	backupDescription = `
+        podman system backup
+
+        Write the configuration of all containers, pods, volumes, networks and secrets to an archive,
+        which podman system restore recreates them from on another host.
+`
+
+	backupCommand = &cobra.Command{
+		Use:               "backup [options] FILE",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Back up the engine state",
+		Long:              backupDescription,
+		RunE:              backup,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example: `podman system backup backup.tar
+podman system backup --volumes --images backup.tar`,
+	}
+
+	backupOptions = entities.SystemBackupOptions{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: backupCommand,
+		Parent:  systemCmd,
+	})
+	flags := backupCommand.Flags()
+	flags.BoolVar(&backupOptions.Volumes, "volumes", false, "Include the contents of local volumes")
+	flags.BoolVar(&backupOptions.Images, "images", false, "Include all images as an OCI layout")
+	flags.BoolVar(&backupOptions.SecretData, "secret-data", false, "Include the data of the secrets")
+}
+
+func backup(_ *cobra.Command, args []string) error {
+	// The archive may contain the values of the secrets
+	f, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("unable to create backup file: %w", err)
+	}
+	defer f.Close()
+
+	backupOptions.Output = f
+	if err := registry.ContainerEngine().SystemBackup(registry.Context(), backupOptions); err != nil {
+		if err := os.Remove(args[0]); err != nil {
+			logrus.Errorf("Removing incomplete backup file %s: %v", args[0], err)
+		}
+		return err
+	}
+	return f.Close()
+}
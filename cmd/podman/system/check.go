@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
 	"go.podman.io/podman/v6/cmd/podman/registry"
 	"go.podman.io/podman/v6/cmd/podman/validate"
 	"go.podman.io/podman/v6/pkg/domain/entities/types"
@@ -16,15 +19,16 @@ import (
 
 var (
 	checkOptions     = types.SystemCheckOptions{}
+	checkFormat      string
 	checkDescription = `
 	podman system check
 
-        Check storage for consistency and remove anything that looks damaged
+        Check storage and the database for consistency and repair or remove anything that looks damaged
 `
 
 	checkCommand = &cobra.Command{
 		Use:               "check [options]",
-		Short:             "Check storage consistency",
+		Short:             "Check storage and database consistency",
 		Args:              validate.NoArgs,
 		Long:              checkDescription,
 		RunE:              check,
@@ -40,8 +44,11 @@ func init() {
 	})
 	flags := checkCommand.Flags()
 	flags.BoolVarP(&checkOptions.Quick, "quick", "q", false, "Skip time-consuming checks. The default is to include time-consuming checks")
-	flags.BoolVarP(&checkOptions.Repair, "repair", "r", false, "Remove inconsistent images")
-	flags.BoolVarP(&checkOptions.RepairLossy, "force", "f", false, "Remove inconsistent images and containers")
+	flags.BoolVarP(&checkOptions.Repair, "repair", "r", false, "Remove inconsistent images and repair inconsistent database entries")
+	flags.BoolVarP(&checkOptions.RepairLossy, "force", "f", false, "Remove inconsistent images and containers, and repair inconsistent database entries")
+	formatFlagName := "format"
+	flags.StringVar(&checkFormat, formatFlagName, "", "Change the output format to JSON or a Go template")
+	_ = checkCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&types.SystemCheckReport{}))
 	flags.DurationP("max", "m", 24*time.Hour, "Maximum allowed age of unreferenced layers")
 	_ = checkCommand.RegisterFlagCompletionFunc("max", completion.AutocompleteNone)
 }
@@ -60,13 +67,18 @@ func check(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if err = printSystemCheckResults(response); err != nil {
+	if flags.Changed("format") {
+		err = printSystemCheckFormat(cmd, response)
+	} else {
+		err = printSystemCheckResults(response)
+	}
+	if err != nil {
 		return err
 	}
 
 	if !checkOptions.Repair && !checkOptions.RepairLossy {
 		if response.Errors {
-			return errors.New("damage detected in local storage")
+			return fmt.Errorf("damage detected in %s", damageLocation(response))
 		}
 		return nil
 	}
@@ -78,12 +90,27 @@ func check(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 	if response.Errors {
-		return errors.New("damage in local storage still present after repair attempt")
+		return fmt.Errorf("damage in %s still present after repair attempt", damageLocation(response))
 	}
 
 	return nil
 }
 
+// damageLocation describes where the problems in the report were found.
+func damageLocation(report *types.SystemCheckReport) string {
+	storage := len(report.Layers) > 0 || len(report.ROLayers) > 0 || len(report.Images) > 0 ||
+		len(report.ROImages) > 0 || len(report.Containers) > 0
+	database := len(report.Libpod) > 0
+	switch {
+	case storage && database:
+		return "local storage and the database"
+	case database:
+		return "the database"
+	default:
+		return "local storage"
+	}
+}
+
 func printSystemCheckResults(report *types.SystemCheckReport) error {
 	if !report.Errors {
 		return nil
@@ -137,5 +164,42 @@ func printSystemCheckResults(report *types.SystemCheckReport) error {
 	for removedContainer := range report.RemovedContainers {
 		fmt.Printf("Deleted damaged container: %s\n", removedContainer)
 	}
+	for _, issue := range report.Libpod {
+		fmt.Printf("Inconsistent %s %s", issue.Type, issue.ID)
+		if issue.Name != "" && issue.Name != issue.ID {
+			fmt.Printf(" (%s)", issue.Name)
+		}
+		fmt.Printf(": %s\n", issue.Message)
+		switch {
+		case issue.Repaired:
+			fmt.Printf("\trepaired\n")
+		case issue.RepairError != "":
+			fmt.Printf("\trepair failed: %s\n", issue.RepairError)
+		case issue.RepairLossy && checkOptions.Repair:
+			fmt.Printf("\trepairing it removes the %s, use --force to do so\n", issue.Type)
+		}
+	}
 	return nil
 }
+
+func printSystemCheckFormat(cmd *cobra.Command, response *types.SystemCheckReport) error {
+	if report.IsJSON(checkFormat) {
+		b, err := json.MarshalIndent(response, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	// Use OriginUnknown so it does not add an extra range since it
+	// will only be called for a single element and not a slice.
+	rpt, err := rpt.Parse(report.OriginUnknown, checkFormat)
+	if err != nil {
+		return err
+	}
+	return rpt.Execute(response)
+}
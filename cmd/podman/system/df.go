@@ -47,9 +47,22 @@ func init() {
 	formatFlagName := "format"
 	flags.StringVar(&dfOptions.Format, formatFlagName, "", "Pretty-print images using a Go template")
 	_ = dfSystemCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&dfSummary{}))
+
+	groupByFlagName := "group-by"
+	flags.StringVar(&dfOptions.GroupBy, groupByFlagName, "", "Show disk usage of groups of containers: pod, project or label=KEY")
+	_ = dfSystemCommand.RegisterFlagCompletionFunc(groupByFlagName, common.AutocompleteDfGroupBy)
+
+	flags.BoolVar(&dfOptions.Exclusive, "exclusive", false, "Attribute image layers and volumes shared between groups to none of them")
 }
 
 func df(cmd *cobra.Command, _ []string) error {
+	if dfOptions.GroupBy != "" && dfOptions.Verbose {
+		return errors.New("cannot combine --group-by and --verbose flags")
+	}
+	if dfOptions.Exclusive && dfOptions.GroupBy == "" {
+		return errors.New("--exclusive requires --group-by")
+	}
+
 	reports, err := registry.ContainerEngine().SystemDf(registry.Context(), dfOptions)
 	if err != nil {
 		return err
@@ -59,6 +72,9 @@ func df(cmd *cobra.Command, _ []string) error {
 		return errors.New("cannot combine --format and --verbose flags")
 	}
 
+	if dfOptions.GroupBy != "" {
+		return printGroups(cmd, reports)
+	}
 	if dfOptions.Verbose {
 		return printVerbose(cmd, reports)
 	}
@@ -232,6 +248,46 @@ func printVerbose(cmd *cobra.Command, reports *entities.SystemDfReport) error {
 	return writeTemplate(rpt, hdrs, dfVolumes)
 }
 
+func printGroups(cmd *cobra.Command, reports *entities.SystemDfReport) error {
+	if report.IsJSON(dfOptions.Format) {
+		bytes, err := json.MarshalIndent(reports.Groups, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bytes))
+		return nil
+	}
+
+	dfGroups := make([]*dfGroup, 0, len(reports.Groups))
+	for _, d := range reports.Groups {
+		dfGroups = append(dfGroups, &dfGroup{SystemDfGroupReport: d})
+	}
+	hdrs := report.Headers(entities.SystemDfGroupReport{}, map[string]string{
+		"ImagesSize":         "IMAGES",
+		"RWSize":             "WRITABLE",
+		"VolumesSize":        "VOLUMES",
+		"LogSize":            "LOGS",
+		"CheckpointSize":     "CHECKPOINTS",
+		"HealthCheckLogSize": "HEALTHCHECK LOGS",
+		"TotalSize":          "TOTAL",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	var err error
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, dfOptions.Format)
+	} else {
+		row := "{{range . }}{{.Group}}\t{{.Containers}}\t{{.ImagesSize}}\t{{.RWSize}}\t{{.VolumesSize}}\t{{.LogSize}}\t{{.CheckpointSize}}\t{{.HealthCheckLogSize}}\t{{.TotalSize}}\n{{end -}}"
+		rpt, err = rpt.Parse(report.OriginPodman, row)
+	}
+	if err != nil {
+		return err
+	}
+	return writeTemplate(rpt, hdrs, dfGroups)
+}
+
 func writeTemplate(rpt *report.Formatter, hdrs []map[string]string, output any) error {
 	if rpt.RenderHeaders {
 		if err := rpt.Execute(hdrs); err != nil {
@@ -300,6 +356,45 @@ func (d *dfVolume) Size() string {
 	return units.HumanSize(float64(d.SystemDfVolumeReport.Size))
 }
 
+type dfGroup struct {
+	*entities.SystemDfGroupReport
+}
+
+func (d *dfGroup) Group() string {
+	if d.SystemDfGroupReport.Group == "" {
+		return "<none>"
+	}
+	return d.SystemDfGroupReport.Group
+}
+
+func (d *dfGroup) ImagesSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.ImagesSize))
+}
+
+func (d *dfGroup) RWSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.RWSize))
+}
+
+func (d *dfGroup) VolumesSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.VolumesSize))
+}
+
+func (d *dfGroup) LogSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.LogSize))
+}
+
+func (d *dfGroup) CheckpointSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.CheckpointSize))
+}
+
+func (d *dfGroup) HealthCheckLogSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.HealthCheckLogSize))
+}
+
+func (d *dfGroup) TotalSize() string {
+	return units.HumanSize(float64(d.SystemDfGroupReport.TotalSize))
+}
+
 type dfSummary struct {
 	Type           string
 	Total          int
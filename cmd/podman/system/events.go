@@ -139,33 +139,19 @@ func eventsFlags(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc(untilFlagName, completion.AutocompleteNone)
 }
 
-func eventsCmd(cmd *cobra.Command, _ []string) error {
+func eventsCmd(_ *cobra.Command, _ []string) error {
 	if len(eventOptions.Since) > 0 || len(eventOptions.Until) > 0 {
 		eventOptions.FromStart = true
 	}
 	eventChannel := make(chan events.ReadResult, 1)
 	eventOptions.EventChan = eventChannel
 
-	var (
-		rpt    *report.Formatter
-		doJSON bool
-	)
-
-	if cmd.Flags().Changed("format") {
-		doJSON = report.IsJSON(eventFormat)
-		if !doJSON {
-			var err error
-			// Use OriginUnknown so it does not add an extra range since it
-			// will only be called for each single element and not a slice.
-			rpt, err = report.New(os.Stdout, cmd.Name()).Parse(report.OriginUnknown, eventFormat)
-			if err != nil {
-				return err
-			}
-		}
+	printer, err := NewEventPrinter(eventFormat, noTrunc)
+	if err != nil {
+		return err
 	}
 
-	err := registry.ContainerEngine().Events(context.Background(), eventOptions)
-	if err != nil {
+	if err := registry.ContainerEngine().Events(context.Background(), eventOptions); err != nil {
 		return err
 	}
 
@@ -174,21 +160,55 @@ func eventsCmd(cmd *cobra.Command, _ []string) error {
 			logrus.Errorf("Failed to read event: %v", evt.Error)
 			continue
 		}
-		switch {
-		case doJSON:
-			e := newEventFromLibpodEvent(evt.Event)
-			jsonStr, err := e.ToJSONString()
-			if err != nil {
-				return err
-			}
-			fmt.Println(jsonStr)
-		case cmd.Flags().Changed("format"):
-			if err := rpt.Execute(newEventFromLibpodEvent(evt.Event)); err != nil {
-				return err
-			}
-		default:
-			fmt.Println(evt.Event.ToHumanReadable(!noTrunc))
+		if err := printer.Print(evt.Event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EventPrinter prints events as JSON, with a Go template or in the default
+// human-readable format.
+type EventPrinter struct {
+	rpt     *report.Formatter
+	doJSON  bool
+	noTrunc bool
+}
+
+// NewEventPrinter returns an EventPrinter for the given format; the default
+// human-readable format is used if it is empty.
+func NewEventPrinter(format string, noTrunc bool) (*EventPrinter, error) {
+	p := &EventPrinter{noTrunc: noTrunc}
+	if format == "" {
+		return p, nil
+	}
+	p.doJSON = report.IsJSON(format)
+	if !p.doJSON {
+		var err error
+		// Use OriginUnknown so it does not add an extra range since it
+		// will only be called for each single element and not a slice.
+		p.rpt, err = report.New(os.Stdout, "events").Parse(report.OriginUnknown, format)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Print prints a single event.
+func (p *EventPrinter) Print(evt *events.Event) error {
+	switch {
+	case p.doJSON:
+		e := newEventFromLibpodEvent(evt)
+		jsonStr, err := e.ToJSONString()
+		if err != nil {
+			return err
 		}
+		fmt.Println(jsonStr)
+	case p.rpt != nil:
+		return p.rpt.Execute(newEventFromLibpodEvent(evt))
+	default:
+		fmt.Println(evt.ToHumanReadable(!p.noTrunc))
 	}
 	return nil
 }
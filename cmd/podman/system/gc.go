@@ -0,0 +1,144 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	gcDescription = `
+	podman system gc
+
+        Remove exited containers, build containers and images according to a policy
+`
+
+	gcCommand = &cobra.Command{
+		Use:               "gc [options]",
+		Short:             "Remove containers and images according to a policy",
+		Args:              validate.NoArgs,
+		Long:              gcDescription,
+		RunE:              gc,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system gc --container-ttl 72h --image-unused-for 168h --protected-image 'registry.example.com/base/*'
+podman system gc --max-disk-usage 80 --dry-run
+podman system gc --container-ttl 24h --build-cache --interval 1h`,
+	}
+)
+
+var (
+	gcOptions  entities.SystemGCOptions
+	gcFormat   string
+	gcInterval time.Duration
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: gcCommand,
+		Parent:  systemCmd,
+	})
+	flags := gcCommand.Flags()
+	flags.BoolVar(&gcOptions.DryRun, "dry-run", false, "Report what would be removed without removing it")
+	flags.BoolVar(&gcOptions.BuildCache, "build-cache", false, "Remove the containers left behind by interrupted builds")
+
+	containerTTLFlagName := "container-ttl"
+	flags.DurationVar(&gcOptions.ContainerTTL, containerTTLFlagName, 0, "Remove containers which exited longer ago than `duration`")
+	_ = gcCommand.RegisterFlagCompletionFunc(containerTTLFlagName, completion.AutocompleteNone)
+
+	keepLabelFlagName := "keep-label"
+	flags.StringVar(&gcOptions.KeepLabel, keepLabelFlagName, define.GCKeepLabel, "Never remove containers with this label")
+	_ = gcCommand.RegisterFlagCompletionFunc(keepLabelFlagName, completion.AutocompleteNone)
+
+	imageUnusedForFlagName := "image-unused-for"
+	flags.DurationVar(&gcOptions.ImageUnusedFor, imageUnusedForFlagName, 0, "Remove images not used by a container for longer than `duration`")
+	_ = gcCommand.RegisterFlagCompletionFunc(imageUnusedForFlagName, completion.AutocompleteNone)
+
+	protectedImageFlagName := "protected-image"
+	flags.StringArrayVar(&gcOptions.ProtectedImages, protectedImageFlagName, nil, "Never remove images with a reference matching `pattern`")
+	_ = gcCommand.RegisterFlagCompletionFunc(protectedImageFlagName, completion.AutocompleteNone)
+
+	maxDiskUsageFlagName := "max-disk-usage"
+	flags.IntVar(&gcOptions.MaxDiskUsage, maxDiskUsageFlagName, 0, "Remove the least recently used images while more than `percent` of the graph root file system is used")
+	_ = gcCommand.RegisterFlagCompletionFunc(maxDiskUsageFlagName, completion.AutocompleteNone)
+
+	intervalFlagName := "interval"
+	flags.DurationVar(&gcInterval, intervalFlagName, 0, "Keep running, collecting garbage every `duration`")
+	_ = gcCommand.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
+
+	formatFlagName := "format"
+	flags.StringVar(&gcFormat, formatFlagName, "", "Format the report using JSON or a Go template")
+	_ = gcCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.SystemGCReport{}))
+}
+
+func gc(cmd *cobra.Command, _ []string) error {
+	if gcOptions.ContainerTTL == 0 && gcOptions.ImageUnusedFor == 0 && gcOptions.MaxDiskUsage == 0 && !gcOptions.BuildCache {
+		return errors.New("no garbage collection policy given: use at least one of --container-ttl, --image-unused-for, --max-disk-usage and --build-cache")
+	}
+	if gcInterval < 0 {
+		return errors.New("--interval must not be negative")
+	}
+
+	for {
+		gcReport, err := registry.ContainerEngine().SystemGC(registry.Context(), gcOptions)
+		if err == nil {
+			err = printGCReport(cmd, gcReport)
+		}
+		if gcInterval == 0 {
+			return err
+		}
+		if err != nil {
+			logrus.Errorf("Collecting garbage: %v", err)
+		}
+		time.Sleep(gcInterval)
+	}
+}
+
+func printGCReport(cmd *cobra.Command, gcReport *entities.SystemGCReport) error {
+	switch {
+	case report.IsJSON(gcFormat):
+		b, err := json.MarshalIndent(gcReport, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	case cmd.Flags().Changed("format"):
+		rpt, err := report.New(os.Stdout, cmd.Name()).Parse(report.OriginUser, gcFormat)
+		if err != nil {
+			return err
+		}
+		defer rpt.Flush()
+		return rpt.Execute(gcReport)
+	}
+
+	verb := "Removed"
+	if gcReport.DryRun {
+		verb = "Would remove"
+	}
+	var errs []error
+	for _, removal := range gcReport.Removed {
+		name := removal.ID
+		if removal.Name != "" {
+			name = fmt.Sprintf("%s (%s)", removal.ID, removal.Name)
+		}
+		if removal.Error != "" {
+			errs = append(errs, fmt.Errorf("removing %s %s: %s", removal.Type, name, removal.Error))
+			continue
+		}
+		fmt.Printf("%s %s %s: %s\n", verb, removal.Type, name, removal.Reason)
+	}
+	fmt.Printf("Total reclaimed space: %s\n", units.HumanSize(float64(gcReport.ReclaimedSpace)))
+	return errors.Join(errs...)
+}
@@ -2,36 +2,60 @@ package system
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
 	"go.podman.io/podman/v6/cmd/podman/registry"
 	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/pkg/domain/entities"
 )
 
-var locksCommand = &cobra.Command{
-	Use:    "locks",
-	Short:  "Debug Libpod's use of locks, identifying any potential conflicts",
-	Args:   validate.NoArgs,
-	Hidden: true,
-	RunE: func(_ *cobra.Command, _ []string) error {
-		return runLocks()
-	},
-	Example: "podman system locks",
-}
+var (
+	locksCommand = &cobra.Command{
+		Use:    "locks [options]",
+		Short:  "Debug Libpod's use of locks, identifying any potential conflicts",
+		Args:   validate.NoArgs,
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if cmd.Flags().Changed("record-holders") {
+				locksOptions.RecordHolders = &locksRecordHolders
+			}
+			return runLocks()
+		},
+		Example: `podman system locks
+  podman system locks --record-holders
+  podman system locks --verbose
+  podman system locks --break`,
+	}
+	locksOptions       entities.LocksOptions
+	locksVerbose       bool
+	locksRecordHolders bool
+)
 
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: locksCommand,
 		Parent:  systemCmd,
 	})
+	flags := locksCommand.Flags()
+	flags.BoolVarP(&locksVerbose, "verbose", "v", false, "Show the processes holding and waiting for locks, and any lock-order cycles")
+	flags.BoolVar(&locksOptions.Break, "break", false, "Release locks whose holder has exited")
+	flags.BoolVar(&locksRecordHolders, "record-holders", false, "Record the processes holding and waiting for locks, from the next Podman command on")
 }
 
 func runLocks() error {
-	report, err := registry.ContainerEngine().Locks(registry.Context())
+	report, err := registry.ContainerEngine().Locks(registry.Context(), locksOptions)
 	if err != nil {
 		return err
 	}
 
+	for _, lockNum := range report.Broken {
+		fmt.Printf("Lock %d was held by a process that has exited and has been released\n", lockNum)
+	}
+
 	for lockNum, objects := range report.LockConflicts {
 		fmt.Printf("Lock %d is in use by the following\n:", lockNum)
 		for _, obj := range objects {
@@ -45,9 +69,58 @@ func runLocks() error {
 		fmt.Printf("\nNo lock conflicts have been detected.\n\n")
 	}
 
-	for _, lockNum := range report.LocksHeld {
-		fmt.Printf("Lock %d is presently being held\n", lockNum)
+	if !locksVerbose {
+		for _, lockNum := range report.LocksHeld {
+			fmt.Printf("Lock %d is presently being held\n", lockNum)
+		}
+		return nil
+	}
+
+	if !report.RecordingHolders {
+		fmt.Printf("Lock holders are not being recorded, use --record-holders to record them from the next Podman command on.\n\n")
+	}
+
+	users := make(map[uint32][]string)
+	for _, holder := range report.Holders {
+		users[holder.LockID] = holder.Users
+		if holder.Held {
+			fmt.Printf("Lock %d%s is presently being held by %s\n", holder.LockID, lockUsersString(holder.Users), lockHolderString(holder.Holder))
+		} else if holder.Holder != nil {
+			fmt.Printf("Lock %d%s is not held, but is recorded as held by %s\n", holder.LockID, lockUsersString(holder.Users), lockHolderString(holder.Holder))
+		}
+		for _, waiter := range holder.Waiters {
+			fmt.Printf("\twaited on by %s\n", lockHolderString(&waiter))
+		}
 	}
 
+	if len(report.Cycles) == 0 {
+		return nil
+	}
+	fmt.Printf("\nLock-order cycles have been detected. The processes holding these locks are deadlocked:\n")
+	for _, cycle := range report.Cycles {
+		steps := make([]string, 0, len(cycle)+1)
+		for _, lockNum := range append(slices.Clone(cycle), cycle[0]) {
+			steps = append(steps, fmt.Sprintf("lock %d%s", lockNum, lockUsersString(users[lockNum])))
+		}
+		fmt.Printf("\t%s\n", strings.Join(steps, " -> "))
+	}
 	return nil
 }
+
+func lockUsersString(users []string) string {
+	if len(users) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(users, ", "))
+}
+
+func lockHolderString(holder *entities.LockHolder) string {
+	if holder == nil {
+		return "an unknown process"
+	}
+	state := ""
+	if !holder.Alive {
+		state = ", exited"
+	}
+	return fmt.Sprintf("process %d (%s) for %s%s", holder.PID, holder.Command, units.HumanDuration(time.Since(holder.Since)), state)
+}
@@ -0,0 +1,58 @@
+package system
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	restoreDescription = `
+        podman system restore
+
+        Recreate the containers, pods, volumes, networks and secrets of an archive written by podman system backup.
+`
+
+	restoreCommand = &cobra.Command{
+		Use:               "restore FILE",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Restore the engine state",
+		Long:              restoreDescription,
+		RunE:              restore,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example:           `podman system restore backup.tar`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: restoreCommand,
+		Parent:  systemCmd,
+	})
+}
+
+func restore(_ *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to open backup file: %w", err)
+	}
+	defer f.Close()
+
+	report, err := registry.ContainerEngine().SystemRestore(registry.Context(), entities.SystemRestoreOptions{Input: f})
+	if err != nil {
+		return err
+	}
+	for _, name := range report.ReusedNetworks {
+		fmt.Printf("Network %s already exists and matches the backup, reusing it\n", name)
+	}
+	for oldID, newID := range report.RemappedIDs {
+		fmt.Printf("ID %s is already in use, restored as %s\n", oldID, newID)
+	}
+	fmt.Printf("Restored %d containers, %d pods, %d volumes, %d networks, %d secrets and %d images\n",
+		len(report.Containers), len(report.Pods), len(report.Volumes), len(report.Networks), len(report.Secrets), len(report.Images))
+	return nil
+}
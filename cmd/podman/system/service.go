@@ -11,6 +11,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -50,6 +51,15 @@ podman system service --time=0 --tls-cert=tls.crt --tls-key=tls.key --tls-client
 		TLSCertFile     string
 		TLSKeyFile      string
 		TLSClientCAFile string
+		MetricsLabels   []string
+		AuthzPolicy     string
+		AuditLog        string
+		AuditLogMaxSize string
+		AuditLogMaxFile uint
+		AuditPolicy     string
+		RateLimits      map[string]string
+		MaxInFlight     map[string]int
+		QueueTimeout    time.Duration
 	}{}
 )
 
@@ -84,6 +94,51 @@ func init() {
 	flags.StringVarP(&srvArgs.TLSClientCAFile, "tls-client-ca", "", "",
 		"Only trust client connections with certificates signed by this CA PEM file")
 	_ = srvCmd.RegisterFlagCompletionFunc("tls-client-ca", completion.AutocompleteDefault)
+
+	metricsLabelFlagName := "metrics-label"
+	flags.StringArrayVar(&srvArgs.MetricsLabels, metricsLabelFlagName, nil,
+		"Container label to expose as label of the container metrics (can be specified multiple times)")
+	_ = srvCmd.RegisterFlagCompletionFunc(metricsLabelFlagName, completion.AutocompleteNone)
+
+	authzPolicyFlagName := "authorization-policy"
+	flags.StringVar(&srvArgs.AuthzPolicy, authzPolicyFlagName, "",
+		"Path to the authorization policy file restricting API clients")
+	_ = srvCmd.RegisterFlagCompletionFunc(authzPolicyFlagName, completion.AutocompleteDefault)
+
+	auditLogFlagName := "audit-log"
+	flags.StringVar(&srvArgs.AuditLog, auditLogFlagName, "",
+		"Path to the audit log recording mutating API requests")
+	_ = srvCmd.RegisterFlagCompletionFunc(auditLogFlagName, completion.AutocompleteDefault)
+
+	auditLogMaxSizeFlagName := "audit-log-max-size"
+	flags.StringVar(&srvArgs.AuditLogMaxSize, auditLogMaxSizeFlagName, "10mb",
+		"Size of the audit log triggering a rotation, 0 disables rotation")
+	_ = srvCmd.RegisterFlagCompletionFunc(auditLogMaxSizeFlagName, completion.AutocompleteNone)
+
+	auditLogMaxFileFlagName := "audit-log-max-file"
+	flags.UintVar(&srvArgs.AuditLogMaxFile, auditLogMaxFileFlagName, 5,
+		"Number of rotated audit log files kept")
+	_ = srvCmd.RegisterFlagCompletionFunc(auditLogMaxFileFlagName, completion.AutocompleteNone)
+
+	auditPolicyFlagName := "audit-policy"
+	flags.StringVar(&srvArgs.AuditPolicy, auditPolicyFlagName, "",
+		"Path to the audit policy file selecting the requests recorded in the audit log")
+	_ = srvCmd.RegisterFlagCompletionFunc(auditPolicyFlagName, completion.AutocompleteDefault)
+
+	rateLimitFlagName := "rate-limit"
+	flags.StringToStringVar(&srvArgs.RateLimits, rateLimitFlagName, nil,
+		"Request rate allowed to each client for a class of requests, `CLASS=N/UNIT` (can be specified multiple times)")
+	_ = srvCmd.RegisterFlagCompletionFunc(rateLimitFlagName, common.AutocompleteRequestClasses)
+
+	maxInFlightFlagName := "max-in-flight"
+	flags.StringToIntVar(&srvArgs.MaxInFlight, maxInFlightFlagName, nil,
+		"Requests of each client processed at the same time for a class of requests, `CLASS=N` (can be specified multiple times)")
+	_ = srvCmd.RegisterFlagCompletionFunc(maxInFlightFlagName, common.AutocompleteRequestClasses)
+
+	queueTimeoutFlagName := "queue-timeout"
+	flags.DurationVar(&srvArgs.QueueTimeout, queueTimeoutFlagName, 30*time.Second,
+		"Time a request over the --max-in-flight limit waits before it is refused")
+	_ = srvCmd.RegisterFlagCompletionFunc(queueTimeoutFlagName, completion.AutocompleteNone)
 }
 
 func aliasTimeoutFlag(_ *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -123,6 +178,16 @@ func service(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--tls-key provided without --tls-cert")
 	}
 
+	for _, name := range []string{"audit-log-max-size", "audit-log-max-file", "audit-policy"} {
+		if cmd.Flags().Changed(name) && srvArgs.AuditLog == "" {
+			return fmt.Errorf("--%s requires --audit-log", name)
+		}
+	}
+	auditLogMaxSize, err := units.FromHumanSize(srvArgs.AuditLogMaxSize)
+	if err != nil {
+		return fmt.Errorf("invalid --audit-log-max-size: %w", err)
+	}
+
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
 		CorsHeaders:     srvArgs.CorsHeaders,
 		PProfAddr:       srvArgs.PProfAddr,
@@ -131,6 +196,15 @@ func service(cmd *cobra.Command, args []string) error {
 		TLSCertFile:     srvArgs.TLSCertFile,
 		TLSKeyFile:      srvArgs.TLSKeyFile,
 		TLSClientCAFile: srvArgs.TLSClientCAFile,
+		MetricsLabels:   srvArgs.MetricsLabels,
+		AuthzPolicy:     srvArgs.AuthzPolicy,
+		AuditLog:        srvArgs.AuditLog,
+		AuditLogMaxSize: auditLogMaxSize,
+		AuditLogMaxFile: int(srvArgs.AuditLogMaxFile),
+		AuditPolicy:     srvArgs.AuditPolicy,
+		RateLimits:      srvArgs.RateLimits,
+		MaxInFlight:     srvArgs.MaxInFlight,
+		QueueTimeout:    srvArgs.QueueTimeout,
 	})
 }
 
@@ -0,0 +1,170 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+	"go.podman.io/podman/v6/cmd/podman/common"
+	"go.podman.io/podman/v6/cmd/podman/registry"
+	"go.podman.io/podman/v6/cmd/podman/validate"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var (
+	topSystemDescription = `Display a live stream of the resource usage of the host and of the engine.
+
+  Shows the CPU, memory and process usage of all running containers, the number of containers in each state,
+  the number of running conmon processes, the usage of the graph root file system and the usage of the locks.`
+	topSystemCommand = &cobra.Command{
+		Use:               "top [options]",
+		Args:              validate.NoArgs,
+		Short:             "Display a live stream of system resource usage",
+		Long:              topSystemDescription,
+		RunE:              top,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system top
+podman system top --no-stream --format json
+podman system top --interval 1 --format "{{.CPUPerc}} {{.Running}}"`,
+	}
+)
+
+type topOptionsCLI struct {
+	Format   string
+	NoReset  bool
+	NoStream bool
+	Interval int
+}
+
+var topOptions topOptionsCLI
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: topSystemCommand,
+		Parent:  systemCmd,
+	})
+	flags := topSystemCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&topOptions.Format, formatFlagName, "", "Pretty-print system usage to JSON or using a Go template")
+	_ = topSystemCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&systemUsage{}))
+
+	flags.BoolVar(&topOptions.NoReset, "no-reset", false, "Disable resetting the screen between intervals")
+	flags.BoolVar(&topOptions.NoStream, "no-stream", false, "Disable streaming system usage and only pull the first result")
+	intervalFlagName := "interval"
+	flags.IntVarP(&topOptions.Interval, intervalFlagName, "i", 5, "Time in seconds between samples")
+	_ = topSystemCommand.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
+}
+
+func top(cmd *cobra.Command, _ []string) error {
+	opts := entities.SystemMonitorOptions{
+		Stream:   !topOptions.NoStream,
+		Interval: topOptions.Interval,
+	}
+	reportChan, err := registry.ContainerEngine().SystemMonitor(registry.Context(), opts)
+	if err != nil {
+		return err
+	}
+	for r := range reportChan {
+		if r.Error != nil {
+			return r.Error
+		}
+		if err := outputUsage(cmd, r.Usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func outputUsage(cmd *cobra.Command, usage *define.SystemUsage) error {
+	if !topOptions.NoReset && !topOptions.NoStream {
+		common.ClearScreen()
+	}
+	if report.IsJSON(topOptions.Format) {
+		b, err := json.MarshalIndent(usage, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	headers := report.Headers(systemUsage{}, map[string]string{
+		"CPUPerc":   "CPU %",
+		"MemUsage":  "MEM USAGE / TOTAL",
+		"PIDS":      "PIDS",
+		"Total":     "CONTAINERS",
+		"Running":   "RUNNING",
+		"Conmon":    "CONMON",
+		"GraphRoot": "GRAPHROOT USED / SIZE",
+		"Locks":     "LOCKS",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	var err error
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, topOptions.Format)
+	} else {
+		format := "{{range .}}{{.CPUPerc}}\t{{.MemUsage}}\t{{.PIDS}}\t{{.Total}}\t{{.Running}}\t{{.Conmon}}\t{{.GraphRoot}}\t{{.Locks}}\n{{end -}}"
+		rpt, err = rpt.Parse(report.OriginPodman, format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders {
+		if err := rpt.Execute(headers); err != nil {
+			return err
+		}
+	}
+	return rpt.Execute([]systemUsage{{*usage}})
+}
+
+type systemUsage struct {
+	define.SystemUsage
+}
+
+func (u *systemUsage) CPUPerc() string {
+	return fmt.Sprintf("%.2f%%", u.Containers.CPU)
+}
+
+func (u *systemUsage) MemUsage() string {
+	return fmt.Sprintf("%s / %s", units.HumanSize(float64(u.Containers.MemUsage)), units.HumanSize(float64(u.Host.MemTotal)))
+}
+
+func (u *systemUsage) PIDS() string {
+	return strconv.FormatUint(u.Containers.PIDs, 10)
+}
+
+func (u *systemUsage) Total() int {
+	return u.Containers.Total
+}
+
+func (u *systemUsage) Running() int {
+	return u.Containers.States[define.ContainerStateRunning.String()]
+}
+
+func (u *systemUsage) Conmon() int {
+	return u.Containers.ConmonProcesses
+}
+
+func (u *systemUsage) GraphRoot() string {
+	return fmt.Sprintf("%s / %s", units.HumanSize(float64(u.Storage.GraphRootUsed)), units.HumanSize(float64(u.Storage.GraphRootAllocated)))
+}
+
+// Locks returns the allocated locks, and the size of the lock pool if it is
+// limited
+func (u *systemUsage) Locks() string {
+	if u.SystemUsage.Locks.Available == nil {
+		return strconv.Itoa(u.SystemUsage.Locks.Allocated)
+	}
+	total := uint64(u.SystemUsage.Locks.Allocated) + uint64(*u.SystemUsage.Locks.Available)
+	return fmt.Sprintf("%d / %d", u.SystemUsage.Locks.Allocated, total)
+}
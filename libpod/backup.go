@@ -0,0 +1,947 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/libimage"
+	"go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/common/pkg/secrets"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/version"
+	"go.podman.io/storage"
+	"go.podman.io/storage/pkg/archive"
+	"go.podman.io/storage/pkg/fileutils"
+	"go.podman.io/storage/pkg/stringid"
+)
+
+// Layout of a backup archive. The archive is a tar file holding a manifest,
+// one JSON file per container, pod, volume, network and secret and,
+// optionally, a tar file with the contents of each local volume and an OCI
+// layout with the images. The data of the secrets is only included on
+// request.
+const (
+	backupVersion       = 1
+	backupManifestFile  = "manifest.json"
+	backupContainersDir = "containers"
+	backupPodsDir       = "pods"
+	backupVolumesDir    = "volumes"
+	backupNetworksDir   = "networks"
+	backupSecretsDir    = "secrets"
+	backupImagesDir     = "images"
+
+	// backupImagePlaceholder is the reference name of images without a
+	// name in the OCI layout of a backup archive
+	backupImagePlaceholder = "localhost/podman-backup"
+)
+
+// backupManifest describes the contents of a backup archive
+type backupManifest struct {
+	Version       int           `json:"version"`
+	PodmanVersion string        `json:"podmanVersion"`
+	Created       time.Time     `json:"created"`
+	Images        []backupImage `json:"images,omitempty"`
+}
+
+// backupImage is an image stored in the OCI layout of a backup archive
+type backupImage struct {
+	ID    string   `json:"id"`
+	Names []string `json:"names,omitempty"`
+	// Ref is the reference name of the image in the OCI layout
+	Ref string `json:"ref"`
+}
+
+// backupSecret is a secret stored in a backup archive. Data is only set if
+// the backup includes the secret data, secrets are never empty.
+type backupSecret struct {
+	Secret *secrets.Secret `json:"secret"`
+	Data   []byte          `json:"data,omitempty"`
+}
+
+// SystemBackup writes the configuration of all containers, pods, volumes,
+// networks and secrets to a tar archive, which SystemRestore recreates them
+// from.
+func (r *Runtime) SystemBackup(ctx context.Context, options entities.SystemBackupOptions) error {
+	// Acquire the alive lock and hold it.
+	// Ensures that no other Podman command starts changing the state while
+	// the backup is taken. Running containers and a running API service do
+	// not take the lock and may still change it.
+	aliveLock, err := r.getRuntimeAliveLock()
+	if err != nil {
+		return fmt.Errorf("retrieving alive lock: %w", err)
+	}
+	aliveLock.Lock()
+	defer aliveLock.Unlock()
+
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	dir, err := os.MkdirTemp("", "podman_backup")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Removing backup directory %s: %v", dir, err)
+		}
+	}()
+	for _, sub := range []string{backupContainersDir, backupPodsDir, backupVolumesDir, backupNetworksDir, backupSecretsDir} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o700); err != nil {
+			return err
+		}
+	}
+
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		if err := writeBackupJSON(filepath.Join(dir, backupContainersDir, ctr.ID()+".json"), ctr.config); err != nil {
+			return fmt.Errorf("backing up container %s: %w", ctr.ID(), err)
+		}
+	}
+
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if err := writeBackupJSON(filepath.Join(dir, backupPodsDir, pod.ID()+".json"), pod.config); err != nil {
+			return fmt.Errorf("backing up pod %s: %w", pod.ID(), err)
+		}
+	}
+
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if err := writeBackupJSON(filepath.Join(dir, backupVolumesDir, vol.Name()+".json"), vol.config); err != nil {
+			return fmt.Errorf("backing up volume %s: %w", vol.Name(), err)
+		}
+		// The contents of other volumes are not managed by us
+		if !options.Volumes || vol.config.Driver != define.VolumeDriverLocal {
+			continue
+		}
+		if err := r.checkVolumeNotInUse(vol); err != nil {
+			return fmt.Errorf("backing up volume %s contents: %w", vol.Name(), err)
+		}
+		if err := backupVolumeContents(vol, filepath.Join(dir, backupVolumesDir, vol.Name()+".tar")); err != nil {
+			return fmt.Errorf("backing up volume %s contents: %w", vol.Name(), err)
+		}
+	}
+
+	networks, err := r.network.NetworkList()
+	if err != nil {
+		return err
+	}
+	for _, network := range networks {
+		// The default network is always present
+		if network.Name == r.config.Network.DefaultNetwork {
+			continue
+		}
+		if err := writeBackupJSON(filepath.Join(dir, backupNetworksDir, network.Name+".json"), network); err != nil {
+			return fmt.Errorf("backing up network %s: %w", network.Name, err)
+		}
+	}
+
+	manager, err := r.SecretsManager()
+	if err != nil {
+		return err
+	}
+	secretList, err := manager.List()
+	if err != nil {
+		return err
+	}
+	for _, s := range secretList {
+		backup := backupSecret{Secret: &s}
+		if options.SecretData {
+			if backup.Secret, backup.Data, err = manager.LookupSecretData(s.ID); err != nil {
+				return fmt.Errorf("backing up secret %s: %w", s.Name, err)
+			}
+		}
+		if err := writeBackupJSON(filepath.Join(dir, backupSecretsDir, s.ID+".json"), backup); err != nil {
+			return fmt.Errorf("backing up secret %s: %w", s.Name, err)
+		}
+	}
+
+	manifest := backupManifest{
+		Version:       backupVersion,
+		PodmanVersion: version.Version.String(),
+		Created:       time.Now(),
+	}
+	if options.Images {
+		if manifest.Images, err = r.backupImages(ctx, filepath.Join(dir, backupImagesDir)); err != nil {
+			return err
+		}
+	}
+	if err := writeBackupJSON(filepath.Join(dir, backupManifestFile), manifest); err != nil {
+		return err
+	}
+
+	contents, err := archive.Tar(dir, archive.Uncompressed)
+	if err != nil {
+		return fmt.Errorf("creating backup archive: %w", err)
+	}
+	defer contents.Close()
+	if _, err := io.Copy(options.Output, contents); err != nil {
+		return fmt.Errorf("writing backup archive: %w", err)
+	}
+	return nil
+}
+
+// checkVolumeNotInUse returns an error if a running container uses the
+// volume, its contents could change while they are backed up
+func (r *Runtime) checkVolumeNotInUse(vol *Volume) error {
+	ctrIDs, err := r.state.VolumeInUse(vol)
+	if err != nil {
+		return err
+	}
+	for _, id := range ctrIDs {
+		ctr, err := r.state.Container(id)
+		if err != nil {
+			return err
+		}
+		state, err := ctr.State()
+		if err != nil {
+			return err
+		}
+		if state == define.ContainerStateRunning || state == define.ContainerStatePaused {
+			return fmt.Errorf("volume is in use by running container %s, stop it first: %w", ctr.ID(), define.ErrVolumeBeingUsed)
+		}
+	}
+	return nil
+}
+
+// backupVolumeContents writes the contents of the volume to a tar file
+func backupVolumeContents(vol *Volume, path string) error {
+	contents, err := vol.Export()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, contents); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// backupImages writes all images to an OCI layout
+func (r *Runtime) backupImages(ctx context.Context, dir string) ([]backupImage, error) {
+	images, err := r.libimageRuntime.ListImages(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	backedUp := make([]backupImage, 0, len(images))
+	for _, img := range images {
+		// Images of additional stores are not ours to restore
+		if img.IsReadOnly() {
+			continue
+		}
+		isList, err := img.IsManifestList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if isList {
+			logrus.Debugf("Skipping backup of manifest list %s", img.ID())
+			continue
+		}
+		bi := backupImage{ID: img.ID(), Names: img.Names(), Ref: backupImageRef(img)}
+		if _, err := r.libimageRuntime.Push(ctx, img.ID(), "oci:"+dir+":"+bi.Ref, nil); err != nil {
+			return nil, fmt.Errorf("backing up image %s: %w", img.ID(), err)
+		}
+		backedUp = append(backedUp, bi)
+	}
+	return backedUp, nil
+}
+
+// backupImageRef returns the reference name of the image in the OCI layout of
+// a backup archive, the first tagged name of the image or a placeholder for
+// images without one
+func backupImageRef(img *libimage.Image) string {
+	for _, name := range img.Names() {
+		if !strings.Contains(name, "@") {
+			return name
+		}
+	}
+	return backupImagePlaceholder + ":" + img.ID()[:12]
+}
+
+// SystemRestore recreates the containers, pods, volumes, networks and secrets
+// of a backup archive written by SystemBackup. Locks are allocated anew, and
+// containers and pods whose ID is already in use are given a new one.
+// Containers are restored in the created state. Secrets backed up without
+// their data must have been created already. If the restore fails, all
+// objects restored so far are removed again.
+func (r *Runtime) SystemRestore(ctx context.Context, options entities.SystemRestoreOptions) (_ *entities.SystemRestoreReport, retErr error) {
+	// Acquire the alive lock and hold it.
+	// Ensures that no other Podman command creates conflicting objects
+	// while the backup is restored.
+	aliveLock, err := r.getRuntimeAliveLock()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving alive lock: %w", err)
+	}
+	aliveLock.Lock()
+	defer aliveLock.Unlock()
+
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	dir, err := os.MkdirTemp("", "podman_restore")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Removing restore directory %s: %v", dir, err)
+		}
+	}()
+	if err := archive.Untar(options.Input, dir, nil); err != nil {
+		return nil, fmt.Errorf("extracting backup archive: %w", err)
+	}
+
+	var manifest backupManifest
+	if err := readBackupJSON(filepath.Join(dir, backupManifestFile), &manifest); err != nil {
+		return nil, fmt.Errorf("reading backup manifest: %w", err)
+	}
+	if manifest.Version != backupVersion {
+		return nil, fmt.Errorf("unsupported backup version %d: %w", manifest.Version, define.ErrInvalidArg)
+	}
+
+	ctrConfigs, err := readBackupObjects[ContainerConfig](filepath.Join(dir, backupContainersDir))
+	if err != nil {
+		return nil, err
+	}
+	podConfigs, err := readBackupObjects[PodConfig](filepath.Join(dir, backupPodsDir))
+	if err != nil {
+		return nil, err
+	}
+	volConfigs, err := readBackupObjects[VolumeConfig](filepath.Join(dir, backupVolumesDir))
+	if err != nil {
+		return nil, err
+	}
+	networks, err := readBackupObjects[types.Network](filepath.Join(dir, backupNetworksDir))
+	if err != nil {
+		return nil, err
+	}
+	backupSecrets, err := readBackupObjects[backupSecret](filepath.Join(dir, backupSecretsDir))
+	if err != nil {
+		return nil, err
+	}
+	manager, err := r.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.SystemRestoreReport{RemappedIDs: make(map[string]string)}
+	if err := r.checkRestoreConflicts(ctrConfigs, podConfigs, volConfigs, networks, backupSecrets, manager, report.RemappedIDs); err != nil {
+		return nil, err
+	}
+	for _, config := range ctrConfigs {
+		remapContainerConfig(config, report.RemappedIDs)
+	}
+	for _, config := range podConfigs {
+		remapPodConfig(config, report.RemappedIDs)
+	}
+
+	rb := &restoreRollback{}
+	// Restored containers never ran, so they are removed without waiting
+	rollbackTimeout := uint(0)
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		if err := rb.run(); err != nil {
+			logrus.Errorf("Removing objects of failed restore: %v", err)
+		}
+	}()
+
+	for _, network := range networks {
+		// Networks which exist were checked to match the backup
+		if _, err := r.network.NetworkInspect(network.Name); err == nil {
+			report.ReusedNetworks = append(report.ReusedNetworks, network.Name)
+			continue
+		}
+		network.ID = ""
+		network.Created = time.Time{}
+		if _, err := r.network.NetworkCreate(*network, nil); err != nil {
+			return nil, fmt.Errorf("restoring network %s: %w", network.Name, err)
+		}
+		rb.add("network", network.Name, func() error {
+			if err := r.network.NetworkRemove(network.Name); err != nil && !errors.Is(err, types.ErrNoSuchNetwork) {
+				return err
+			}
+			return nil
+		})
+		report.Networks = append(report.Networks, network.Name)
+	}
+
+	for _, s := range backupSecrets {
+		// Secrets without data were created by the user beforehand
+		if s.Data == nil {
+			continue
+		}
+		driverOpts := s.Secret.DriverOptions
+		if s.Secret.Driver == "file" {
+			// The data of the file driver is stored with the engine
+			driverOpts = map[string]string{"path": filepath.Join(r.GetSecretsStorageDir(), "filedriver")}
+		}
+		id, err := manager.Store(s.Secret.Name, s.Data, s.Secret.Driver, secrets.StoreOptions{
+			DriverOpts: driverOpts,
+			Metadata:   s.Secret.Metadata,
+			Labels:     s.Secret.Labels,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("restoring secret %s: %w", s.Secret.Name, err)
+		}
+		rb.add("secret", id, func() error {
+			if _, err := manager.Delete(id); err != nil && !errors.Is(err, secrets.ErrNoSuchSecret) {
+				return err
+			}
+			return nil
+		})
+		report.Secrets = append(report.Secrets, id)
+	}
+
+	// Images of the backup, and images pulled for containers and volumes
+	// of the backup, by their ID in the backup
+	imageIDs := make(map[string]string)
+	if report.Images, err = r.restoreImages(ctx, rb, filepath.Join(dir, backupImagesDir), manifest.Images, imageIDs); err != nil {
+		return nil, err
+	}
+
+	for _, config := range volConfigs {
+		if config.Driver == define.VolumeDriverImage {
+			if _, err := r.restoreLookupImage(ctx, rb, config.StorageImageID, config.Options["image"], imageIDs); err != nil {
+				return nil, fmt.Errorf("restoring volume %s: %w", config.Name, err)
+			}
+		}
+		vol, err := r.newVolume(ctx, false, withBackupVolumeConfig(config))
+		if err != nil {
+			return nil, fmt.Errorf("restoring volume %s: %w", config.Name, err)
+		}
+		rb.add("volume", vol.Name(), func() error {
+			err := r.RemoveVolume(context.WithoutCancel(ctx), vol, true, &rollbackTimeout)
+			if errors.Is(err, define.ErrNoSuchVolume) || errors.Is(err, define.ErrVolumeRemoved) {
+				return nil
+			}
+			return err
+		})
+		contents := filepath.Join(dir, backupVolumesDir, config.Name+".tar")
+		if err := fileutils.Exists(contents); err == nil {
+			if err := restoreVolumeContents(vol, contents); err != nil {
+				return nil, fmt.Errorf("restoring volume %s contents: %w", config.Name, err)
+			}
+		}
+		report.Volumes = append(report.Volumes, vol.Name())
+	}
+
+	pods := make(map[string]*Pod, len(podConfigs))
+	for _, config := range podConfigs {
+		pod, err := r.restorePod(config)
+		if err != nil {
+			return nil, fmt.Errorf("restoring pod %s: %w", config.ID, err)
+		}
+		rb.add("pod", pod.ID(), func() error {
+			ctrErrs, err := r.RemovePod(context.WithoutCancel(ctx), pod, true, true, &rollbackTimeout)
+			if errors.Is(err, define.ErrNoSuchPod) || errors.Is(err, define.ErrPodRemoved) {
+				return nil
+			}
+			for id, ctrErr := range ctrErrs {
+				if ctrErr != nil {
+					err = errors.Join(err, fmt.Errorf("removing container %s: %w", id, ctrErr))
+				}
+			}
+			return err
+		})
+		pods[pod.ID()] = pod
+		report.Pods = append(report.Pods, pod.ID())
+	}
+
+	// Containers can only be added once their dependencies exist
+	restored := make(map[string]bool, len(ctrConfigs))
+	for remaining := ctrConfigs; len(remaining) > 0; {
+		var next []*ContainerConfig
+		for _, config := range remaining {
+			deps := (&Container{config: config}).Dependencies()
+			if slices.ContainsFunc(deps, func(id string) bool { return !restored[id] }) {
+				next = append(next, config)
+				continue
+			}
+			if config.RootfsImageID != "" {
+				if config.RootfsImageID, err = r.restoreLookupImage(ctx, rb, config.RootfsImageID, config.RootfsImageName, imageIDs); err != nil {
+					return nil, fmt.Errorf("restoring container %s: %w", config.ID, err)
+				}
+			}
+			for _, secr := range config.Secrets {
+				if secr.Secret, err = manager.Lookup(secr.Name); err != nil {
+					return nil, fmt.Errorf("restoring container %s: %w", config.ID, err)
+				}
+			}
+			ctr, err := r.restoreContainer(ctx, config, pods[config.Pod])
+			if err != nil {
+				return nil, fmt.Errorf("restoring container %s: %w", config.ID, err)
+			}
+			// Containers in a pod are removed together with it
+			if ctr.config.Pod == "" {
+				rb.add("container", ctr.ID(), func() error {
+					err := r.RemoveContainer(context.WithoutCancel(ctx), ctr, true, true, &rollbackTimeout)
+					if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+						return nil
+					}
+					return err
+				})
+			}
+			if ctr.config.IsInfra {
+				pods[ctr.config.Pod].state.InfraContainerID = ctr.ID()
+			}
+			restored[ctr.ID()] = true
+			report.Containers = append(report.Containers, ctr.ID())
+		}
+		if len(next) == len(remaining) {
+			ids := make([]string, 0, len(next))
+			for _, config := range next {
+				ids = append(ids, config.ID)
+			}
+			return nil, fmt.Errorf("containers %s depend on containers missing from the backup: %w", strings.Join(ids, ", "), define.ErrNoSuchCtr)
+		}
+		remaining = next
+	}
+
+	for _, pod := range pods {
+		pod.lock.Lock()
+		err := pod.save()
+		pod.lock.Unlock()
+		if err != nil {
+			return nil, fmt.Errorf("saving pod %s: %w", pod.ID(), err)
+		}
+		pod.newPodEvent(events.Create)
+	}
+
+	return report, nil
+}
+
+// checkRestoreConflicts verifies that no object of the backup has a name in
+// use, that networks of the same name match those of the backup and that the
+// secrets backed up without their data exist, before anything is restored.
+// Containers and pods whose ID is in use are given a new ID in ids.
+func (r *Runtime) checkRestoreConflicts(ctrConfigs []*ContainerConfig, podConfigs []*PodConfig, volConfigs []*VolumeConfig, networks []*types.Network, backupSecrets []*backupSecret, manager *secrets.SecretsManager, ids map[string]string) error {
+	// Containers and pods share their names and IDs
+	names := make(map[string]bool)
+	inUse := make(map[string]bool)
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		names[ctr.Name()] = true
+		inUse[ctr.ID()] = true
+	}
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		names[pod.Name()] = true
+		inUse[pod.ID()] = true
+	}
+
+	remap := func(id string) {
+		if !inUse[id] {
+			inUse[id] = true
+			return
+		}
+		newID := stringid.GenerateRandomID()
+		for inUse[newID] {
+			newID = stringid.GenerateRandomID()
+		}
+		inUse[newID] = true
+		ids[id] = newID
+	}
+	for _, config := range ctrConfigs {
+		if names[config.Name] {
+			return fmt.Errorf("container name %q is already in use: %w", config.Name, define.ErrCtrExists)
+		}
+		remap(config.ID)
+	}
+	for _, config := range podConfigs {
+		if names[config.Name] {
+			return fmt.Errorf("pod name %q is already in use: %w", config.Name, define.ErrPodExists)
+		}
+		remap(config.ID)
+	}
+
+	for _, config := range volConfigs {
+		exists, err := r.state.HasVolume(config.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("volume name %q is already in use: %w", config.Name, define.ErrVolumeExists)
+		}
+	}
+
+	for _, network := range networks {
+		existing, err := r.network.NetworkInspect(network.Name)
+		if err != nil {
+			if errors.Is(err, types.ErrNoSuchNetwork) {
+				continue
+			}
+			return err
+		}
+		if diff := networkDifference(&existing, network); diff != "" {
+			return fmt.Errorf("network %q exists and differs from the backup in its %s: %w", network.Name, diff, types.ErrNetworkExists)
+		}
+	}
+
+	var missing []string
+	for _, s := range backupSecrets {
+		_, err := manager.Lookup(s.Secret.Name)
+		switch {
+		case err != nil && !errors.Is(err, secrets.ErrNoSuchSecret):
+			return err
+		case s.Data == nil:
+			if err != nil {
+				missing = append(missing, s.Secret.Name)
+			}
+		case err == nil:
+			return fmt.Errorf("secret name %q is already in use", s.Secret.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("secrets %s were backed up without their data and must be created with podman secret create before restoring: %w", strings.Join(missing, ", "), secrets.ErrNoSuchSecret)
+	}
+	return nil
+}
+
+// remapContainerConfig replaces the IDs of the container and of the pod and
+// containers it refers to
+func remapContainerConfig(config *ContainerConfig, ids map[string]string) {
+	remap := func(id *string) {
+		if newID, ok := ids[*id]; ok {
+			*id = newID
+		}
+	}
+	remap(&config.ID)
+	remap(&config.Pod)
+	remap(&config.IPCNsCtr)
+	remap(&config.MountNsCtr)
+	remap(&config.NetNsCtr)
+	remap(&config.PIDNsCtr)
+	remap(&config.UserNsCtr)
+	remap(&config.UTSNsCtr)
+	remap(&config.CgroupNsCtr)
+	for i := range config.Dependencies {
+		remap(&config.Dependencies[i])
+	}
+	for i := range config.HealthyDependencies {
+		remap(&config.HealthyDependencies[i])
+	}
+}
+
+// remapPodConfig replaces the IDs of the pod and of its service container
+func remapPodConfig(config *PodConfig, ids map[string]string) {
+	if newID, ok := ids[config.ID]; ok {
+		config.ID = newID
+	}
+	if newID, ok := ids[config.ServiceContainerID]; ok {
+		config.ServiceContainerID = newID
+	}
+}
+
+// restoreImages loads the images of the OCI layout of a backup archive which
+// are not present yet, and names them as in the backup
+func (r *Runtime) restoreImages(ctx context.Context, rb *restoreRollback, dir string, images []backupImage, imageIDs map[string]string) ([]string, error) {
+	var restored []string
+	for _, bi := range images {
+		img, _, err := r.libimageRuntime.LookupImage(bi.ID, nil)
+		switch {
+		case err == nil:
+		case errors.Is(err, storage.ErrImageUnknown):
+			pulled, err := r.libimageRuntime.Pull(ctx, "oci:"+dir+":"+bi.Ref, config.PullPolicyAlways, nil)
+			if err != nil {
+				return nil, fmt.Errorf("restoring image %s: %w", bi.ID, err)
+			}
+			img = pulled[0]
+			r.addImageRollback(ctx, rb, img.ID())
+			if strings.HasPrefix(bi.Ref, backupImagePlaceholder+":") {
+				if err := img.Untag(bi.Ref); err != nil {
+					return nil, fmt.Errorf("restoring image %s: %w", bi.ID, err)
+				}
+			}
+			restored = append(restored, img.ID())
+		default:
+			return nil, err
+		}
+		for _, name := range bi.Names {
+			// Digested names cannot be added as tags
+			if strings.Contains(name, "@") {
+				continue
+			}
+			if err := img.Tag(name); err != nil {
+				return nil, fmt.Errorf("restoring image %s: %w", bi.ID, err)
+			}
+		}
+		imageIDs[bi.ID] = img.ID()
+	}
+	return restored, nil
+}
+
+// restoreLookupImage returns the ID of the image a container or volume of a
+// backup uses, pulling it by name if it is missing
+func (r *Runtime) restoreLookupImage(ctx context.Context, rb *restoreRollback, id, name string, imageIDs map[string]string) (string, error) {
+	if newID, ok := imageIDs[id]; ok {
+		return newID, nil
+	}
+	img, _, err := r.libimageRuntime.LookupImage(id, nil)
+	if err != nil {
+		if !errors.Is(err, storage.ErrImageUnknown) {
+			return "", err
+		}
+		pulled, err := r.libimageRuntime.Pull(ctx, name, config.PullPolicyMissing, nil)
+		if err != nil {
+			return "", fmt.Errorf("image %s is missing and could not be pulled: %w", name, err)
+		}
+		img = pulled[0]
+		r.addImageRollback(ctx, rb, img.ID())
+	}
+	imageIDs[id] = img.ID()
+	return img.ID(), nil
+}
+
+// withBackupVolumeConfig sets the configuration of a volume restored from a
+// backup
+func withBackupVolumeConfig(config *VolumeConfig) VolumeCreateOption {
+	return func(volume *Volume) error {
+		volume.config.Name = config.Name
+		volume.config.Driver = config.Driver
+		if config.Labels != nil {
+			volume.config.Labels = config.Labels
+		}
+		if config.Options != nil {
+			volume.config.Options = config.Options
+		}
+		volume.config.IsAnon = config.IsAnon
+		volume.config.UID = config.UID
+		volume.config.GID = config.GID
+		volume.config.Size = config.Size
+		volume.config.Inodes = config.Inodes
+		volume.config.DisableQuota = config.DisableQuota
+		volume.config.Timeout = config.Timeout
+		volume.config.MountLabel = config.MountLabel
+		return nil
+	}
+}
+
+// restoreVolumeContents imports the contents of a volume from a tar file
+func restoreVolumeContents(vol *Volume, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := vol.Import(f); err != nil {
+		return err
+	}
+
+	// The contents must not be replaced by the image contents or chowned
+	// when the volume is first mounted
+	vol.lock.Lock()
+	defer vol.lock.Unlock()
+	vol.state.NeedsCopyUp = false
+	vol.state.NeedsChown = false
+	return vol.save()
+}
+
+// restorePod adds a pod of a backup to the state
+func (r *Runtime) restorePod(config *PodConfig) (_ *Pod, retErr error) {
+	pod := newPod(r)
+	if err := JSONDeepCopy(config, pod.config); err != nil {
+		return nil, fmt.Errorf("copying pod config for restore: %w", err)
+	}
+
+	lock, err := r.lockManager.AllocateLock()
+	if err != nil {
+		return nil, fmt.Errorf("allocating lock for pod: %w", err)
+	}
+	pod.lock = lock
+	pod.config.LockID = pod.lock.ID()
+	defer func() {
+		if retErr != nil {
+			if err := pod.lock.Free(); err != nil {
+				logrus.Errorf("Freeing pod lock after failed restore: %v", err)
+			}
+		}
+	}()
+
+	pod.valid = true
+	if _, err := r.platformMakePod(pod, &pod.config.ResourceLimits); err != nil {
+		return nil, err
+	}
+	if err := r.state.AddPod(pod); err != nil {
+		return nil, fmt.Errorf("adding pod to state: %w", err)
+	}
+	return pod, nil
+}
+
+// restoreContainer creates a container of a backup. Paths which were derived
+// from the storage of the container are derived again.
+func (r *Runtime) restoreContainer(ctx context.Context, config *ContainerConfig, pod *Pod) (*Container, error) {
+	// The cgroup parent of containers in a pod is derived from the pod
+	if pod != nil && pod.config.UsePodCgroup {
+		config.CgroupParent = ""
+	}
+	if config.ShmDir != "" {
+		config.Mounts = slices.DeleteFunc(config.Mounts, func(m string) bool { return m == config.ShmDir })
+	}
+
+	ctr, err := r.initContainerVariables(config.Spec, config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing container variables: %w", err)
+	}
+	ctr.config.CreatedTime = config.CreatedTime
+	if _, ok := r.ociRuntimes[config.OCIRuntime]; ok {
+		ctr.config.OCIRuntime = config.OCIRuntime
+	}
+	if config.LogPath != filepath.Join(config.StaticDir, "ctr.log") {
+		ctr.config.LogPath = config.LogPath
+	}
+	if strings.HasPrefix(config.ConmonPidFile, r.storageConfig.RunRoot) || config.ConmonPidFile == filepath.Join(config.StaticDir, "conmon.pid") {
+		ctr.config.ConmonPidFile = ""
+	}
+	if strings.HasPrefix(config.PidFile, r.storageConfig.RunRoot) {
+		ctr.config.PidFile = ""
+	}
+
+	return r.setupContainer(ctx, ctr)
+}
+
+// writeBackupJSON writes an object of a backup archive
+func writeBackupJSON(path string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
+
+// readBackupJSON reads an object of a backup archive
+func readBackupJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// readBackupObjects reads the objects of one directory of a backup archive
+func readBackupObjects[T any](dir string) ([]*T, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	objects := make([]*T, 0, len(entries))
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		obj := new(T)
+		if err := readBackupJSON(filepath.Join(dir, entry.Name()), obj); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", entry.Name(), err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// restoreRollback records how to remove the objects created by a restore, so
+// they can be removed again if the restore fails halfway.  Objects which
+// existed before and were merely reused are not recorded, and neither are
+// containers in a pod, which are removed together with it.
+type restoreRollback struct {
+	steps []restoreRollbackStep
+}
+
+type restoreRollbackStep struct {
+	kind   string
+	id     string
+	remove func() error
+}
+
+// add records that the object of the given kind and ID was created, and is
+// removed by calling remove.
+func (rb *restoreRollback) add(kind, id string, remove func() error) {
+	rb.steps = append(rb.steps, restoreRollbackStep{kind: kind, id: id, remove: remove})
+}
+
+// run removes all recorded objects in the reverse order of their creation.
+// It tries to remove all of them even if some cannot be removed, and returns
+// the errors of all failed removals.
+func (rb *restoreRollback) run() error {
+	var errs []error
+	for i := len(rb.steps) - 1; i >= 0; i-- {
+		step := rb.steps[i]
+		logrus.Debugf("Rolling back restore of %s %s", step.kind, step.id)
+		if err := step.remove(); err != nil {
+			errs = append(errs, fmt.Errorf("removing %s %s: %w", step.kind, step.id, err))
+		}
+	}
+	rb.steps = nil
+	return errors.Join(errs...)
+}
+
+// addImageRollback records that the image with the given ID was loaded or
+// pulled by the restore.  The containers using it were restored afterwards
+// and are removed before it.
+func (r *Runtime) addImageRollback(ctx context.Context, rb *restoreRollback, id string) {
+	rb.add("image", id, func() error {
+		_, rmErrs := r.libimageRuntime.RemoveImages(context.WithoutCancel(ctx), []string{id}, &libimage.RemoveImagesOptions{Ignore: true})
+		return errors.Join(rmErrs...)
+	})
+}
+
+// networkDifference returns the first property in which an existing network
+// differs from the network of the same name in a backup, or "" if it can be
+// used in its place.  The host interface name is assigned per host and not
+// compared.
+func networkDifference(existing, backup *types.Network) string {
+	switch {
+	case existing.Driver != backup.Driver:
+		return "driver"
+	case !slices.EqualFunc(existing.Subnets, backup.Subnets, func(a, b types.Subnet) bool {
+		return a.Subnet.String() == b.Subnet.String() && a.Gateway.Equal(b.Gateway)
+	}):
+		return "subnets"
+	case existing.Internal != backup.Internal:
+		return "internal setting"
+	case existing.IPv6Enabled != backup.IPv6Enabled:
+		return "IPv6 setting"
+	case existing.DNSEnabled != backup.DNSEnabled:
+		return "DNS setting"
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/common/libnetwork/types"
+)
+
+func TestRemapContainerConfig(t *testing.T) {
+	ids := map[string]string{
+		"infra": "newinfra",
+		"pod":   "newpod",
+		"dep":   "newdep",
+	}
+	config := &ContainerConfig{ID: "ctr", Pod: "pod"}
+	config.NetNsCtr = "infra"
+	config.UTSNsCtr = "infra"
+	config.IPCNsCtr = "other"
+	config.Dependencies = []string{"infra", "dep", "other"}
+	config.HealthyDependencies = []string{"dep"}
+
+	remapContainerConfig(config, ids)
+	assert.Equal(t, "ctr", config.ID)
+	assert.Equal(t, "newpod", config.Pod)
+	assert.Equal(t, "newinfra", config.NetNsCtr)
+	assert.Equal(t, "newinfra", config.UTSNsCtr)
+	assert.Equal(t, "other", config.IPCNsCtr)
+	assert.Empty(t, config.PIDNsCtr)
+	assert.Equal(t, []string{"newinfra", "newdep", "other"}, config.Dependencies)
+	assert.Equal(t, []string{"newdep"}, config.HealthyDependencies)
+
+	podConfig := &PodConfig{ID: "pod", ServiceContainerID: "dep"}
+	remapPodConfig(podConfig, ids)
+	assert.Equal(t, "newpod", podConfig.ID)
+	assert.Equal(t, "newdep", podConfig.ServiceContainerID)
+}
+
+func TestReadBackupObjects(t *testing.T) {
+	dir := t.TempDir()
+
+	// A directory missing from the archive has no objects
+	objects, err := readBackupObjects[VolumeConfig](filepath.Join(dir, backupVolumesDir))
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, backupVolumesDir), 0o700))
+	for _, name := range []string{"vol1", "vol2"} {
+		config := &VolumeConfig{Name: name, Labels: map[string]string{"name": name}}
+		require.NoError(t, writeBackupJSON(filepath.Join(dir, backupVolumesDir, name+".json"), config))
+	}
+	// The contents of a volume are not an object
+	require.NoError(t, os.WriteFile(filepath.Join(dir, backupVolumesDir, "vol1.tar"), nil, 0o600))
+
+	objects, err = readBackupObjects[VolumeConfig](filepath.Join(dir, backupVolumesDir))
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "vol1", objects[0].Name)
+	assert.Equal(t, map[string]string{"name": "vol1"}, objects[0].Labels)
+	assert.Equal(t, "vol2", objects[1].Name)
+}
+
+func TestNetworkDifference(t *testing.T) {
+	subnet := func(cidr, gateway string) types.Subnet {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		require.NoError(t, err)
+		return types.Subnet{Subnet: types.IPNet{IPNet: *ipnet}, Gateway: net.ParseIP(gateway)}
+	}
+	backup := &types.Network{
+		Name:             "net",
+		Driver:           types.BridgeNetworkDriver,
+		NetworkInterface: "podman1",
+		Subnets:          []types.Subnet{subnet("10.89.0.0/24", "10.89.0.1")},
+		DNSEnabled:       true,
+	}
+
+	// The interface name is assigned per host
+	existing := *backup
+	existing.NetworkInterface = "podman3"
+	existing.Subnets = []types.Subnet{subnet("10.89.0.0/24", "10.89.0.1")}
+	assert.Empty(t, networkDifference(&existing, backup))
+
+	existing.Subnets = []types.Subnet{subnet("10.89.1.0/24", "10.89.1.1")}
+	assert.Equal(t, "subnets", networkDifference(&existing, backup))
+
+	existing = *backup
+	existing.Driver = types.MacVLANNetworkDriver
+	assert.Equal(t, "driver", networkDifference(&existing, backup))
+
+	existing = *backup
+	existing.DNSEnabled = false
+	assert.Equal(t, "DNS setting", networkDifference(&existing, backup))
+}
+
+func TestRestoreRollback(t *testing.T) {
+	var removed []string
+	rb := &restoreRollback{}
+	for _, id := range []string{"a", "b", "c"} {
+		rb.add("volume", id, func() error {
+			removed = append(removed, id)
+			if id == "b" {
+				return errors.New("busy")
+			}
+			return nil
+		})
+	}
+
+	// All objects are removed in reverse order, even after a failure
+	err := rb.run()
+	assert.EqualError(t, err, "removing volume b: busy")
+	assert.Equal(t, []string{"c", "b", "a"}, removed)
+	assert.NoError(t, rb.run())
+}
@@ -205,6 +205,9 @@ type ContainerState struct {
 	// HCUnitName records the name of the healthcheck unit.
 	// Automatically generated when the healthcheck is started.
 	HCUnitName string `json:"hcUnitName,omitempty"`
+	// StorageQuotaUnitName records the name of the systemd unit
+	// monitoring the storage quota of the container.
+	StorageQuotaUnitName string `json:"storageQuotaUnitName,omitempty"`
 
 	// ExtensionStageHooks holds hooks which will be executed by libpod
 	// and not delegated to the OCI runtime.
@@ -1241,6 +1244,46 @@ func (c *Container) RWSize() (int64, error) {
 	return c.rwSize()
 }
 
+// LogSize returns the size of the container's log file. Containers using a
+// log driver which does not write a file, such as journald, have a size of 0.
+func (c *Container) LogSize() (int64, error) {
+	switch c.LogDriver() {
+	case define.KubernetesLogging, define.JSONLogging, "":
+	default:
+		return 0, nil
+	}
+	return fileSize(c.LogPath())
+}
+
+// CheckpointSize returns the size of the checkpoint and pre-checkpoint images
+// kept with the container.
+func (c *Container) CheckpointSize() (int64, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return -1, fmt.Errorf("updating container %s state: %w", c.ID(), err)
+		}
+	}
+	return c.checkpointSize()
+}
+
+// HealthCheckLogSize returns the size of the file the container's healthcheck
+// results are logged to.
+func (c *Container) HealthCheckLogSize() (int64, error) {
+	if !c.HasHealthCheck() {
+		return 0, nil
+	}
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return -1, fmt.Errorf("updating container %s state: %w", c.ID(), err)
+		}
+	}
+	return fileSize(c.getHealthCheckLogDestination())
+}
+
 // IDMappings returns the UID/GID mapping used for the container
 func (c *Container) IDMappings() storage.IDMappingOptions {
 	return c.config.IDMappings
@@ -1287,6 +1330,17 @@ func (c *Container) IsInitCtr() bool {
 	return len(c.config.InitContainerType) > 0
 }
 
+// IsSidecar returns whether the container is a sidecar of its pod
+func (c *Container) IsSidecar() bool {
+	return c.config.Sidecar
+}
+
+// HealthyDependencies returns the IDs of the dependency containers that must
+// be healthy before the container is started.
+func (c *Container) HealthyDependencies() []string {
+	return slices.Clone(c.config.HealthyDependencies)
+}
+
 // IsReadOnly returns whether the container is running in read-only mode
 func (c *Container) IsReadOnly() bool {
 	return c.config.Spec.Root.Readonly
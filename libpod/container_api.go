@@ -173,6 +173,14 @@ func (c *Container) Update(updateOptions *entities.ContainerUpdateOptions) error
 // Attach call occurs before Start).
 func (c *Container) Attach(ctx context.Context, streams *define.AttachStreams, keys string, resize <-chan resize.TerminalSize, start bool) (retChan <-chan error, finalErr error) {
 	if !c.batched {
+		// Must be done before we lock, waiting for healthy
+		// dependencies can take a long time.
+		if start {
+			if err := c.prepareDependencies(ctx, true); err != nil {
+				return nil, err
+			}
+		}
+
 		c.lock.Lock()
 		defer c.lock.Unlock()
 
@@ -196,7 +204,12 @@ func (c *Container) Attach(ctx context.Context, streams *define.AttachStreams, k
 			return nil, errors.New("you can only attach to running containers")
 		}
 
-		if err := c.prepareToStart(ctx, true); err != nil {
+		if c.batched {
+			if err := c.prepareDependencies(ctx, true); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.prepareToStart(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -1044,6 +1057,11 @@ type ContainerCheckpointOptions struct {
 	// FileLocks tells the API to checkpoint/restore a container
 	// with file-locks
 	FileLocks bool
+	// keepFrozen tells the API that the container may have been frozen
+	// by the caller, and to leave it frozen after its checkpoint has been
+	// written. It is used by pod checkpoints, which freeze all members
+	// before the first dump and thaw them all at once when done.
+	keepFrozen bool
 }
 
 // Checkpoint checkpoints a container
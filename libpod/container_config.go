@@ -82,6 +82,11 @@ type ContainerConfig struct {
 	// These containers must be started before this container is started.
 	Dependencies []string
 
+	// HealthyDependencies are the IDs of dependency containers that must
+	// not only be running but also healthy before this container is
+	// started. They are a subset of Dependencies.
+	HealthyDependencies []string `json:"healthyDependencies,omitempty"`
+
 	// rewrite is an internal bool to indicate that the config was modified after
 	// a read from the db, e.g. to migrate config fields after an upgrade.
 	// This field should never be written to the db, the json tag ensures this.
@@ -177,6 +182,17 @@ type ContainerRootFSConfig struct {
 	// Volatile specifies whether the container storage can be optimized
 	// at the cost of not syncing all the dirty files in memory.
 	Volatile bool `json:"volatile,omitempty"`
+	// StorageQuota is the maximum size in bytes of the writable layer and
+	// the anonymous volumes of the container. 0 means no quota.
+	StorageQuota uint64 `json:"storageQuota,omitempty"`
+	// StorageQuotaAction is the action taken when the storage quota is
+	// exceeded and not enforced by project quotas: "pause" or "stop".
+	StorageQuotaAction string `json:"storageQuotaAction,omitempty"`
+	// StorageQuotaProject is set when the storage quota is enforced by a
+	// project quota of the file system holding the writable layer, rather
+	// than by monitoring the usage. Containers with anonymous volumes are
+	// always monitored.
+	StorageQuotaProject bool `json:"storageQuotaProject,omitempty"`
 	// Passwd allows to user to override podman's passwd/group file setup
 	Passwd *bool `json:"passwd,omitempty"`
 	// ChrootDirs is an additional set of directories that need to be
@@ -459,6 +475,10 @@ type ContainerMiscConfig struct {
 	// InitContainerType specifies if the container is an initcontainer
 	// and if so, what type: always or once are possible non-nil entries
 	InitContainerType string `json:"init_container_type,omitempty"`
+	// Sidecar indicates that the container is a sidecar of its pod. Sidecars
+	// are started before and stopped after all other containers of the pod
+	// and do not keep the pod running on their own.
+	Sidecar bool `json:"sidecar,omitempty"`
 	// PasswdEntry specifies arbitrary data to append to a file.
 	PasswdEntry string `json:"passwd_entry,omitempty"`
 	// MountAllDevices is an option to indicate whether a privileged container
@@ -466,6 +486,9 @@ type ContainerMiscConfig struct {
 	MountAllDevices bool `json:"mountAllDevices"`
 	// ReadWriteTmpfs indicates whether all tmpfs should be mounted readonly when in ReadOnly mode
 	ReadWriteTmpfs bool `json:"readWriteTmpfs"`
+	// IdempotencyKey is the key supplied with the request that created the
+	// container, if any
+	IdempotencyKey *define.IdempotencyKey `json:"idempotencyKey,omitempty"`
 }
 
 // InfraInherit contains the compatible options inheritable from the infra container
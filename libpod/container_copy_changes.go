@@ -0,0 +1,222 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/shutdown"
+	"go.podman.io/podman/v6/pkg/rootless"
+	"go.podman.io/storage"
+	"go.podman.io/storage/drivers/copy"
+	"go.podman.io/storage/pkg/chrootarchive"
+)
+
+// CopyChangesTo copies the writable layer and the contents of the anonymous
+// volumes of the container to clone.  The clone must be a newly created
+// container based on the same image, with the same ID mappings; its anonymous
+// volumes are matched to ours by destination.  Where the storage supports it,
+// files are copied as reflinks.  A running container is paused while its
+// changes are copied.
+func (c *Container) CopyChangesTo(clone *Container) error {
+	if c.config.Rootfs != "" || clone.config.Rootfs != "" {
+		return fmt.Errorf("cannot copy the changes of a container that uses an exploded rootfs: %w", define.ErrInvalidArg)
+	}
+	if c.config.RootfsImageID != clone.config.RootfsImageID {
+		return fmt.Errorf("container %s is not based on the image of container %s: %w", clone.ID(), c.ID(), define.ErrInvalidArg)
+	}
+
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+	if !clone.batched {
+		clone.lock.Lock()
+		defer clone.lock.Unlock()
+
+		if err := clone.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	switch clone.state.State {
+	case define.ContainerStateConfigured, define.ContainerStateCreated:
+	default:
+		return fmt.Errorf("container %s must not have been started to receive the changes of container %s: %w", clone.ID(), c.ID(), define.ErrCtrStateInvalid)
+	}
+
+	if c.state.State == define.ContainerStateRunning || c.state.State == define.ContainerStateStopping {
+		// The container lock is held, so no concurrent copy can
+		// register a handler with the same name.
+		handlerName := fmt.Sprintf("copy-changes-unpause-%s", c.ID())
+		if err := shutdown.Register(handlerName, func(sig os.Signal) error {
+			logrus.Debugf("Received %v, unpausing container %q", sig, c.ID())
+			return c.unpause()
+		}); err != nil && !errors.Is(err, shutdown.ErrHandlerExists) {
+			logrus.Errorf("Registering shutdown handler for container %q: %v", c.ID(), err)
+		}
+		if err := c.pause(); err != nil {
+			_ = shutdown.Unregister(handlerName)
+			return fmt.Errorf("pausing container %q to copy its changes: %w", c.ID(), err)
+		}
+		defer func() {
+			_ = shutdown.Unregister(handlerName)
+			if err := c.unpause(); err != nil {
+				logrus.Errorf("Unpausing container %q: %v", c.ID(), err)
+			}
+		}()
+	}
+
+	if err := c.copyLayerTo(clone); err != nil {
+		return fmt.Errorf("copying writable layer of container %s: %w", c.ID(), err)
+	}
+	return c.copyAnonymousVolumesTo(clone)
+}
+
+// copyLayerTo copies the writable layer of the container to the empty
+// writable layer of clone.
+func (c *Container) copyLayerTo(clone *Container) error {
+	storeCtr, err := c.runtime.store.Container(c.ID())
+	if err != nil {
+		return fmt.Errorf("getting container from store %q: %w", c.ID(), err)
+	}
+	cloneStoreCtr, err := c.runtime.store.Container(clone.ID())
+	if err != nil {
+		return fmt.Errorf("getting container from store %q: %w", clone.ID(), err)
+	}
+	if !slices.Equal(storeCtr.UIDMap, cloneStoreCtr.UIDMap) || !slices.Equal(storeCtr.GIDMap, cloneStoreCtr.GIDMap) {
+		return fmt.Errorf("container %s uses different ID mappings: %w", clone.ID(), define.ErrInvalidArg)
+	}
+
+	// Copying the upper directories directly can use reflinks, but it
+	// loses the whiteouts of deleted files when running rootless, since
+	// device nodes cannot be created.
+	if !rootless.IsRootless() {
+		upperDir, err := c.upperDir()
+		if err != nil {
+			return err
+		}
+		cloneUpperDir, err := clone.upperDir()
+		if err != nil {
+			return err
+		}
+		if upperDir != "" && cloneUpperDir != "" {
+			return copy.DirCopy(upperDir, cloneUpperDir, copy.Content, true)
+		}
+	}
+
+	return copyLayerDiff(c.runtime.store, c.runtime.imageContext.BigFilesTemporaryDir, storeCtr.LayerID, cloneStoreCtr.LayerID)
+}
+
+// copyLayerDiff applies the changes of layer from to its parent, including
+// whiteouts of deleted files, to layer to, which must have the same parent.
+// The store holds the layer store lock while a diff is read, so the diff is
+// buffered in a temporary file in tmpDir before it is applied.
+func copyLayerDiff(store storage.Store, tmpDir, from, to string) error {
+	tmpFile, err := os.CreateTemp(tmpDir, "podman-copy-changes-")
+	if err != nil {
+		return fmt.Errorf("creating temporary file for the changes of layer %s: %w", from, err)
+	}
+	defer func() {
+		tmpFile.Close()
+		if err := os.Remove(tmpFile.Name()); err != nil {
+			logrus.Errorf("Removing temporary file %s: %v", tmpFile.Name(), err)
+		}
+	}()
+
+	diff, err := store.Diff("", from, nil)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmpFile, diff)
+	if closeErr := diff.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("reading changes of layer %s: %w", from, err)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = store.ApplyDiff(to, tmpFile)
+	return err
+}
+
+// copyAnonymousVolumesTo copies the contents of the anonymous volumes of the
+// container to the anonymous volumes of clone with the same destinations.
+func (c *Container) copyAnonymousVolumesTo(clone *Container) error {
+	for _, namedVol := range c.config.NamedVolumes {
+		vol, err := c.runtime.state.Volume(namedVol.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving volume %s: %w", namedVol.Name, err)
+		}
+		if !vol.Anonymous() {
+			continue
+		}
+		idx := slices.IndexFunc(clone.config.NamedVolumes, func(v *ContainerNamedVolume) bool {
+			return v.Dest == namedVol.Dest
+		})
+		if idx < 0 {
+			logrus.Debugf("Container %s has no volume at %s, not copying volume %s", clone.ID(), namedVol.Dest, vol.Name())
+			continue
+		}
+		cloneVol, err := c.runtime.state.Volume(clone.config.NamedVolumes[idx].Name)
+		if err != nil {
+			return fmt.Errorf("retrieving volume %s: %w", clone.config.NamedVolumes[idx].Name, err)
+		}
+		if cloneVol.Name() == vol.Name() {
+			// Both containers use the same volume
+			continue
+		}
+		if vol.UsesVolumeDriver() || cloneVol.UsesVolumeDriver() {
+			return fmt.Errorf("cannot copy volume %s which uses a volume driver: %w", vol.Name(), define.ErrInvalidArg)
+		}
+		if err := copyVolume(vol, cloneVol); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyVolume copies the contents of volume src to volume dst.
+func copyVolume(src, dst *Volume) error {
+	srcDir, err := src.Mount()
+	if err != nil {
+		return fmt.Errorf("mounting volume %s: %w", src.Name(), err)
+	}
+	defer func() {
+		if err := src.Unmount(); err != nil {
+			logrus.Errorf("Unmounting volume %s: %v", src.Name(), err)
+		}
+	}()
+	dstDir, err := dst.Mount()
+	if err != nil {
+		return fmt.Errorf("mounting volume %s: %w", dst.Name(), err)
+	}
+	defer func() {
+		if err := dst.Unmount(); err != nil {
+			logrus.Errorf("Unmounting volume %s: %v", dst.Name(), err)
+		}
+	}()
+
+	if rootless.IsRootless() {
+		err = chrootarchive.NewArchiver(nil).CopyWithTar(srcDir, dstDir)
+	} else {
+		err = copy.DirCopy(srcDir, dstDir, copy.Content, true)
+	}
+	if err != nil {
+		return fmt.Errorf("copying volume %s to volume %s: %w", src.Name(), dst.Name(), err)
+	}
+	return nil
+}
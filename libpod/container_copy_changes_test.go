@@ -0,0 +1,88 @@
+//go:build !remote && linux
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/storage"
+)
+
+// TestCopyLayerDiff tests the copy of a writable layer used when the upper
+// directories cannot be copied directly, such as when running rootless.
+func TestCopyLayerDiff(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("applying layer diffs requires root")
+	}
+
+	dir := t.TempDir()
+	store, err := storage.GetStore(storage.StoreOptions{
+		RunRoot:         filepath.Join(dir, "run"),
+		GraphRoot:       filepath.Join(dir, "root"),
+		GraphDriverName: "vfs",
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, _ = store.Shutdown(true)
+	}()
+
+	// writeLayer mounts the layer, lets fn change its contents and
+	// unmounts it again
+	writeLayer := func(id string, fn func(root string)) {
+		root, err := store.Mount(id, "")
+		require.NoError(t, err)
+		fn(root)
+		_, err = store.Unmount(id, true)
+		require.NoError(t, err)
+	}
+
+	// The image layer, shared by both containers
+	image, err := store.CreateLayer("", "", nil, "", false, nil)
+	require.NoError(t, err)
+	writeLayer(image.ID, func(root string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "etc", "dir"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "release"), []byte("image"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "dir", "file"), []byte("image"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "kept"), []byte("image"), 0o644))
+	})
+
+	// The container adds and changes files and deletes a file and a
+	// directory of the image
+	layer, err := store.CreateLayer("", image.ID, nil, "", true, nil)
+	require.NoError(t, err)
+	writeLayer(layer.ID, func(root string) {
+		require.NoError(t, os.WriteFile(filepath.Join(root, "added"), []byte("added"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "kept"), []byte("changed"), 0o644))
+		require.NoError(t, os.Remove(filepath.Join(root, "etc", "release")))
+		require.NoError(t, os.RemoveAll(filepath.Join(root, "etc", "dir")))
+	})
+
+	clone, err := store.CreateLayer("", image.ID, nil, "", true, nil)
+	require.NoError(t, err)
+	require.NoError(t, copyLayerDiff(store, t.TempDir(), layer.ID, clone.ID))
+
+	writeLayer(clone.ID, func(root string) {
+		content, err := os.ReadFile(filepath.Join(root, "added"))
+		require.NoError(t, err)
+		assert.Equal(t, "added", string(content))
+		content, err = os.ReadFile(filepath.Join(root, "etc", "kept"))
+		require.NoError(t, err)
+		assert.Equal(t, "changed", string(content))
+
+		// The whiteouts of the deleted file and directory are applied
+		assert.NoFileExists(t, filepath.Join(root, "etc", "release"))
+		assert.NoDirExists(t, filepath.Join(root, "etc", "dir"))
+		assert.NoFileExists(t, filepath.Join(root, ".wh.release"))
+		assert.NoFileExists(t, filepath.Join(root, "etc", ".wh.release"))
+	})
+
+	// The image layer is left alone
+	writeLayer(image.ID, func(root string) {
+		assert.FileExists(t, filepath.Join(root, "etc", "release"))
+		assert.DirExists(t, filepath.Join(root, "etc", "dir"))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
@@ -21,6 +22,9 @@ type containerNode struct {
 	container  *Container
 	dependsOn  []*containerNode
 	dependedOn []*containerNode
+	// healthyDeps are the dependencies that must be healthy, not only
+	// running, before the container is started.
+	healthyDeps []*containerNode
 }
 
 // ContainerGraph is a dependency graph based on a set of containers.
@@ -70,18 +74,46 @@ func BuildContainerGraph(ctrs []*Container) (*ContainerGraph, error) {
 				return nil, fmt.Errorf("container %s depends on container %s not found in input list: %w", node.id, dep, define.ErrNoSuchCtr)
 			}
 
-			// Add the dependent node to the node's dependencies
-			// And add the node to the dependent node's dependedOn
-			node.dependsOn = append(node.dependsOn, depNode)
-			depNode.dependedOn = append(depNode.dependedOn, node)
+			graph.addEdge(node, depNode)
+		}
+
+		for _, dep := range node.container.config.HealthyDependencies {
+			if depNode, ok := graph.nodes[dep]; ok {
+				node.healthyDeps = append(node.healthyDeps, depNode)
+			}
+		}
+	}
 
-			// The dependency now has something depending on it
-			delete(graph.notDependedOnNodes, dep)
+	// Sidecars must be up before, and stay up longer than, all other
+	// containers of their pod. Add implicit edges from every regular
+	// container of a pod to the sidecars of the same pod.
+	sidecars := make(map[string][]*containerNode)
+	for _, node := range graph.nodes {
+		if node.container.IsSidecar() {
+			sidecars[node.container.PodID()] = append(sidecars[node.container.PodID()], node)
+		}
+	}
+	if len(sidecars) > 0 {
+		for _, node := range graph.nodes {
+			ctr := node.container
+			if !ctr.dependsOnSidecars() {
+				continue
+			}
+			for _, sidecar := range sidecars[ctr.PodID()] {
+				if !slices.Contains(node.dependsOn, sidecar) {
+					graph.addEdge(node, sidecar)
+				}
+				if sidecar.container.HasHealthCheck() && !slices.Contains(node.healthyDeps, sidecar) {
+					node.healthyDeps = append(node.healthyDeps, sidecar)
+				}
+			}
 		}
+	}
 
-		// Maintain a list of nodes with no dependencies
-		// (no edges coming from them)
-		if len(deps) == 0 {
+	// Maintain a list of nodes with no dependencies
+	// (no edges coming from them)
+	for _, node := range graph.nodes {
+		if len(node.dependsOn) == 0 {
 			graph.noDepNodes = append(graph.noDepNodes, node)
 		}
 	}
@@ -98,6 +130,17 @@ func BuildContainerGraph(ctrs []*Container) (*ContainerGraph, error) {
 	return graph, nil
 }
 
+// addEdge makes node depend on depNode.
+func (cg *ContainerGraph) addEdge(node, depNode *containerNode) {
+	// Add the dependent node to the node's dependencies
+	// And add the node to the dependent node's dependedOn
+	node.dependsOn = append(node.dependsOn, depNode)
+	depNode.dependedOn = append(depNode.dependedOn, node)
+
+	// The dependency now has something depending on it
+	delete(cg.notDependedOnNodes, depNode.id)
+}
+
 // Detect cycles in a container graph using Tarjan's strongly connected
 // components algorithm
 // Return true if a cycle is found, false otherwise
@@ -254,6 +297,18 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 		ctrErrored = true
 	}
 
+	// Wait for the dependencies that must be healthy. This must happen
+	// before we take our lock, the wait can take a long time.
+	if !ctrErrored {
+		for _, dep := range node.healthyDeps {
+			if err := node.container.waitForDependencyHealthy(ctx, dep.container); err != nil {
+				ctrErrors[node.id] = err
+				ctrErrored = true
+				break
+			}
+		}
+	}
+
 	// Lock before we start
 	node.container.lock.Lock()
 
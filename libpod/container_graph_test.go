@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	manifest "go.podman.io/image/v5/manifest"
 	"go.podman.io/podman/v6/libpod/lock"
 )
 
@@ -311,3 +312,95 @@ func TestBuildContainerGraphFourContainersNoneInCycle(t *testing.T) {
 	assert.Equal(t, 2, len(graph.noDepNodes))
 	assert.Equal(t, 2, len(graph.notDependedOnNodes))
 }
+
+func TestBuildContainerGraphPodSidecars(t *testing.T) {
+	manager, err := lock.NewInMemoryManager(16)
+	if err != nil {
+		t.Fatalf("Error setting up locks: %v", err)
+	}
+
+	infra, err := getTestCtrN("infra", manager)
+	assert.NoError(t, err)
+	sidecar, err := getTestCtrN("sidecar", manager)
+	assert.NoError(t, err)
+	app1, err := getTestCtr1(manager)
+	assert.NoError(t, err)
+	app2, err := getTestCtr2(manager)
+	assert.NoError(t, err)
+	other, err := getTestCtrN("other", manager)
+	assert.NoError(t, err)
+
+	for _, ctr := range []*Container{infra, sidecar, app1, app2} {
+		ctr.config.Pod = "pod1"
+	}
+	infra.config.IsInfra = true
+	sidecar.config.Sidecar = true
+	sidecar.config.HealthCheckConfig = &manifest.Schema2HealthConfig{Test: []string{"CMD", "true"}}
+	// app2 already depends on the sidecar explicitly, no duplicate edge
+	app2.config.Dependencies = []string{sidecar.ID()}
+
+	graph, err := BuildContainerGraph([]*Container{infra, sidecar, app1, app2, other})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(graph.nodes))
+	// infra, sidecar and the container outside the pod have no dependencies
+	assert.Equal(t, 3, len(graph.noDepNodes))
+	// infra, both apps and the container outside the pod
+	assert.Equal(t, 4, len(graph.notDependedOnNodes))
+
+	sidecarNode := graph.nodes[sidecar.ID()]
+	assert.Len(t, sidecarNode.dependsOn, 0)
+	assert.Len(t, sidecarNode.dependedOn, 2)
+	for _, app := range []*Container{app1, app2} {
+		node := graph.nodes[app.ID()]
+		assert.Equal(t, []*containerNode{sidecarNode}, node.dependsOn)
+		assert.Equal(t, []*containerNode{sidecarNode}, node.healthyDeps)
+	}
+	assert.Len(t, graph.nodes[infra.ID()].dependsOn, 0)
+	assert.Len(t, graph.nodes[other.ID()].dependsOn, 0)
+}
+
+func TestBuildContainerGraphHealthyDependencies(t *testing.T) {
+	manager, err := lock.NewInMemoryManager(16)
+	if err != nil {
+		t.Fatalf("Error setting up locks: %v", err)
+	}
+
+	ctr1, err := getTestCtr1(manager)
+	assert.NoError(t, err)
+	ctr2, err := getTestCtr2(manager)
+	assert.NoError(t, err)
+	ctr3, err := getTestCtrN("3", manager)
+	assert.NoError(t, err)
+
+	ctr3.config.Dependencies = []string{ctr1.ID(), ctr2.ID()}
+	ctr3.config.HealthyDependencies = []string{ctr2.ID()}
+
+	graph, err := BuildContainerGraph([]*Container{ctr1, ctr2, ctr3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(graph.noDepNodes))
+
+	node := graph.nodes[ctr3.ID()]
+	assert.Len(t, node.dependsOn, 2)
+	assert.Equal(t, []*containerNode{graph.nodes[ctr2.ID()]}, node.healthyDeps)
+}
+
+func TestBuildContainerGraphSidecarCycle(t *testing.T) {
+	manager, err := lock.NewInMemoryManager(16)
+	if err != nil {
+		t.Fatalf("Error setting up locks: %v", err)
+	}
+
+	sidecar, err := getTestCtr1(manager)
+	assert.NoError(t, err)
+	app, err := getTestCtr2(manager)
+	assert.NoError(t, err)
+
+	sidecar.config.Pod = "pod1"
+	sidecar.config.Sidecar = true
+	app.config.Pod = "pod1"
+	// A sidecar cannot depend on an app container of its pod
+	sidecar.config.Dependencies = []string{app.ID()}
+
+	_, err = BuildContainerGraph([]*Container{sidecar, app})
+	assert.Error(t, err)
+}
@@ -462,6 +462,15 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	ctrConfig.SdNotifyMode = c.config.SdNotifyMode
 	ctrConfig.SdNotifySocket = c.config.SdNotifySocket
 
+	if c.config.StorageQuota > 0 {
+		ctrConfig.StorageQuota = c.config.StorageQuota
+		ctrConfig.StorageQuotaAction = c.config.StorageQuotaAction
+		ctrConfig.StorageQuotaMode = "monitor"
+		if c.config.StorageQuotaProject {
+			ctrConfig.StorageQuotaMode = "project"
+		}
+	}
+
 	// Exposed ports consists of all exposed ports and all port mappings for
 	// this container. It does *NOT* follow to another container if we share
 	// the network namespace.
@@ -112,6 +112,35 @@ func (c *Container) rwSize() (int64, error) {
 	return layerSize, nil
 }
 
+// checkpointSize gets the combined size of the checkpoint, checkpointed volumes
+// and pre-checkpoint directories of the container.
+func (c *Container) checkpointSize() (int64, error) {
+	var size int64
+	for _, dir := range []string{c.CheckpointPath(), c.CheckpointVolumesPath(), c.PreCheckPointPath()} {
+		dirSize, err := directory.Size(dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return 0, err
+		}
+		size += dirSize
+	}
+	return size, nil
+}
+
+// fileSize returns the size of the file at path, or 0 if it does not exist.
+func fileSize(path string) (int64, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return st.Size(), nil
+}
+
 // bundlePath returns the path to the container's root filesystem - where the OCI spec will be
 // placed, amongst other things
 func (c *Container) bundlePath() string {
@@ -814,9 +843,9 @@ func (c *Container) save() error {
 }
 
 // Checks the container is in the right state, then initializes the container in preparation to start the container.
-// If recursive is true, each of the container's dependencies will be started.
-// Otherwise, this function will return with error if there are dependencies of this container that aren't running.
-func (c *Container) prepareToStart(ctx context.Context, recursive bool) (retErr error) {
+// This function will return with error if there are dependencies of this container that aren't running, they must
+// have been started by prepareDependencies beforehand.
+func (c *Container) prepareToStart(ctx context.Context) (retErr error) {
 	// Container must be created or stopped to be started
 	if !c.ensureState(define.ContainerStateConfigured, define.ContainerStateCreated, define.ContainerStateStopped, define.ContainerStateExited) {
 		// Special case: Let the caller know that container is already running,
@@ -827,14 +856,8 @@ func (c *Container) prepareToStart(ctx context.Context, recursive bool) (retErr
 		return fmt.Errorf("container %s must be in Created or Stopped state to be started: %w", c.ID(), define.ErrCtrStateInvalid)
 	}
 
-	if !recursive {
-		if err := c.checkDependenciesAndHandleError(); err != nil {
-			return err
-		}
-	} else {
-		if err := c.startDependencies(ctx); err != nil {
-			return err
-		}
+	if err := c.checkDependenciesAndHandleError(); err != nil {
+		return err
 	}
 
 	defer func() {
@@ -877,10 +900,29 @@ func (c *Container) checkDependenciesAndHandleError() error {
 	return nil
 }
 
+// prepareDependencies starts all dependencies of the container if recursive is
+// true, and then waits for the dependencies that must be healthy before the
+// container is started. The wait can take a long time, so unless the container
+// is batched, this must be called before the container is locked; the caller
+// must sync the container and validate its state afterwards.
+func (c *Container) prepareDependencies(ctx context.Context, recursive bool) error {
+	if recursive {
+		if err := c.startDependencies(ctx); err != nil {
+			return err
+		}
+	}
+	return c.waitForHealthyDependencies(ctx)
+}
+
 // Recursively start all dependencies of a container so the container can be started.
+// The sidecars of the pod of the container are started as well.
 func (c *Container) startDependencies(ctx context.Context) error {
+	sidecars, err := c.sidecarDependencies()
+	if err != nil {
+		return fmt.Errorf("starting dependency for container %s: %w", c.ID(), err)
+	}
 	depCtrIDs := c.Dependencies()
-	if len(depCtrIDs) == 0 {
+	if len(depCtrIDs) == 0 && len(sidecars) == 0 {
 		return nil
 	}
 
@@ -888,6 +930,15 @@ func (c *Container) startDependencies(ctx context.Context) error {
 	if err := c.getAllDependencies(depVisitedCtrs); err != nil {
 		return fmt.Errorf("starting dependency for container %s: %w", c.ID(), err)
 	}
+	for _, sidecar := range sidecars {
+		if _, ok := depVisitedCtrs[sidecar.ID()]; ok {
+			continue
+		}
+		depVisitedCtrs[sidecar.ID()] = sidecar
+		if err := sidecar.getAllDependencies(depVisitedCtrs); err != nil {
+			return fmt.Errorf("starting dependency for container %s: %w", c.ID(), err)
+		}
+	}
 
 	// Because of how Go handles passing slices through functions, a slice cannot grow between function calls
 	// without clunky syntax. Circumnavigate this by translating the map to a slice for buildContainerGraph
@@ -960,6 +1011,89 @@ func (c *Container) getAllDependencies(visited map[string]*Container) error {
 	return nil
 }
 
+// dependsOnSidecars returns whether the container is a regular member of a pod,
+// which is started after and stopped before the sidecars of the pod.
+func (c *Container) dependsOnSidecars() bool {
+	return c.config.Pod != "" && !c.IsInfra() && !c.IsInitCtr() && !c.IsSidecar()
+}
+
+// sidecarDependencies returns the sidecars of the pod of the container which
+// the container implicitly depends on.
+func (c *Container) sidecarDependencies() ([]*Container, error) {
+	if !c.dependsOnSidecars() {
+		return nil, nil
+	}
+	pod, err := c.runtime.state.Pod(c.config.Pod)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving pod %s of container %s: %w", c.config.Pod, c.ID(), err)
+	}
+	podCtrs, err := c.runtime.state.PodContainers(pod)
+	if err != nil {
+		return nil, err
+	}
+	sidecars := make([]*Container, 0)
+	for _, ctr := range podCtrs {
+		if ctr.IsSidecar() {
+			sidecars = append(sidecars, ctr)
+		}
+	}
+	return sidecars, nil
+}
+
+// waitForHealthyDependencies waits for all dependencies of the container that
+// must be healthy before it is started: the healthy dependencies from the
+// config and the sidecars of its pod that have a healthcheck.
+// Does not lock the container.
+func (c *Container) waitForHealthyDependencies(ctx context.Context) error {
+	deps := make([]*Container, 0, len(c.config.HealthyDependencies))
+	for _, dep := range c.config.HealthyDependencies {
+		depCtr, err := c.runtime.state.Container(dep)
+		if err != nil {
+			return fmt.Errorf("retrieving dependency %s of container %s from state: %w", dep, c.ID(), err)
+		}
+		deps = append(deps, depCtr)
+	}
+	sidecars, err := c.sidecarDependencies()
+	if err != nil {
+		return err
+	}
+	for _, sidecar := range sidecars {
+		if sidecar.HasHealthCheck() && !slices.ContainsFunc(deps, func(dep *Container) bool { return dep.ID() == sidecar.ID() }) {
+			deps = append(deps, sidecar)
+		}
+	}
+
+	for _, dep := range deps {
+		if err := c.waitForDependencyHealthy(ctx, dep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForDependencyHealthy waits until the given dependency of the container
+// turns healthy. It errors if the dependency turns unhealthy or stops first.
+// Does not lock the container.
+func (c *Container) waitForDependencyHealthy(ctx context.Context, dep *Container) error {
+	logrus.Debugf("Waiting for dependency %s of container %s to become healthy", dep.ID(), c.ID())
+
+	if _, err := dep.WaitForConditionWithInterval(ctx, DefaultWaitInterval, define.HealthCheckHealthy, define.HealthCheckUnhealthy); err != nil {
+		if errors.Is(err, define.ErrCtrStopped) {
+			return fmt.Errorf("dependency %s of container %s stopped before it became healthy: %w", dep.ID(), c.ID(), define.ErrCtrStateInvalid)
+		}
+		return fmt.Errorf("waiting for dependency %s of container %s to become healthy: %w", dep.ID(), c.ID(), err)
+	}
+
+	status, err := dep.HealthCheckStatus()
+	if err != nil {
+		return fmt.Errorf("retrieving health of dependency %s of container %s: %w", dep.ID(), c.ID(), err)
+	}
+	if status != define.HealthCheckHealthy {
+		return fmt.Errorf("dependency %s of container %s is %s: %w", dep.ID(), c.ID(), status, define.ErrCtrStateInvalid)
+	}
+	return nil
+}
+
 // Check if a container's dependencies are running
 // Returns a []string containing the IDs of dependencies that are not running
 func (c *Container) checkDependenciesRunning() ([]string, error) {
@@ -1257,6 +1391,12 @@ func (c *Container) initAndStart(ctx context.Context) (retErr error) {
 // Intended to be used in pod-related functions.
 func (c *Container) startNoPodLock(ctx context.Context, recursive bool) (finalErr error) {
 	if !c.batched {
+		// Must be done before we lock, waiting for healthy
+		// dependencies can take a long time.
+		if err := c.prepareDependencies(ctx, recursive); err != nil {
+			return err
+		}
+
 		c.lock.Lock()
 		defer c.lock.Unlock()
 
@@ -1275,7 +1415,13 @@ func (c *Container) startNoPodLock(ctx context.Context, recursive bool) (finalEr
 		}
 	}
 
-	if err := c.prepareToStart(ctx, recursive); err != nil {
+	if c.batched {
+		if err := c.prepareDependencies(ctx, recursive); err != nil {
+			return err
+		}
+	}
+
+	if err := c.prepareToStart(ctx); err != nil {
 		return err
 	}
 
@@ -1323,6 +1469,12 @@ func (c *Container) start() error {
 		}
 	}
 
+	if c.config.StorageQuota > 0 && !c.config.StorageQuotaProject {
+		if err := c.createStorageQuotaTimer(); err != nil {
+			return fmt.Errorf("monitor storage quota: %w", err)
+		}
+	}
+
 	c.newContainerEvent(events.Start)
 
 	return c.save()
@@ -2187,6 +2339,16 @@ func (c *Container) cleanup(ctx context.Context) error {
 		}
 	}
 
+	// Remove the storage quota unit/timer if it exists
+	if c.state.StorageQuotaUnitName != "" {
+		if err := c.removeStorageQuotaTimer(ctx); err != nil {
+			logrus.Errorf("Removing timer for container %s storage quota: %v", c.ID(), err)
+		}
+		if err := c.save(); err != nil {
+			lastError = fmt.Errorf("saving container %s state: %w", c.ID(), err)
+		}
+	}
+
 	// Clean up network namespace, if present
 	if err := c.cleanupNetwork(); err != nil {
 		lastError = fmt.Errorf("removing container %s network: %w", c.ID(), err)
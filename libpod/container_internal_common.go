@@ -1298,6 +1298,11 @@ func (c *Container) freezeForCheckpoint(options ContainerCheckpointOptions) func
 	// Use c.pause()/c.unpause() so the paused state is recorded in the
 	// database. If the checkpoint is then interrupted (e.g. by SIGKILL) Podman
 	// still knows the container is frozen and can recover it to a sane state.
+	// Members of a pod checkpoint are frozen by the pod already
+	if options.keepFrozen && c.state.State == define.ContainerStatePaused {
+		return noop
+	}
+
 	if err := c.pause(); err != nil {
 		// Do not hard-fail a previously working checkpoint: warn that
 		// consistency cannot be guaranteed and continue.
@@ -1305,6 +1310,10 @@ func (c *Container) freezeForCheckpoint(options ContainerCheckpointOptions) func
 		return noop
 	}
 
+	if options.keepFrozen {
+		return noop
+	}
+
 	return func() {
 		if err := c.unpause(); err != nil {
 			logrus.Errorf("Thawing container %s after checkpoint: %v", c.ID(), err)
@@ -1330,7 +1339,8 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		return nil, 0, err
 	}
 
-	if c.state.State != define.ContainerStateRunning {
+	frozenByCaller := options.keepFrozen && c.state.State == define.ContainerStatePaused
+	if c.state.State != define.ContainerStateRunning && !frozenByCaller {
 		return nil, 0, fmt.Errorf("%q is not running, cannot checkpoint: %w", c.state.State, define.ErrCtrStateInvalid)
 	}
 
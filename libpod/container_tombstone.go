@@ -0,0 +1,96 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/logs"
+)
+
+// tombstone returns the tombstone recording the container as it is removed.
+// Must be called with the container locked, before its storage is removed,
+// as the logs of file-based log drivers are kept there.
+func (c *Container) tombstone() *define.ContainerTombstone {
+	tombstone := &define.ContainerTombstone{
+		ID:         c.ID(),
+		Name:       c.Name(),
+		Pod:        c.config.Pod,
+		Image:      c.config.RootfsImageName,
+		ImageID:    c.config.RootfsImageID,
+		Labels:     c.config.Labels,
+		OCIRuntime: c.config.OCIRuntime,
+		State:      c.state.State.String(),
+		ExitCode:   c.state.ExitCode,
+		OOMKilled:  c.state.OOMKilled,
+		Created:    c.config.CreatedTime,
+		StartedAt:  c.state.StartedTime,
+		FinishedAt: c.state.FinishedTime,
+	}
+	if c.config.Spec != nil && c.config.Spec.Process != nil {
+		tombstone.Command = c.config.Spec.Process.Args
+	}
+
+	// Journald logs outlive the container and reading them takes the
+	// container lock, so only logs kept in a file are recorded.
+	switch c.LogDriver() {
+	case define.KubernetesLogging, define.JSONLogging, "":
+		lines, err := logs.TailLog(c.LogPath(), define.TombstoneLogLines)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Debugf("Reading logs of container %s for its tombstone: %v", c.ID(), err)
+		}
+		tombstone.Logs = lines
+	}
+
+	return tombstone
+}
+
+// addTombstone adds the tombstone of a removed container to the database, and
+// removes tombstones beyond the retention limits.
+func (r *Runtime) addTombstone(tombstone *define.ContainerTombstone) {
+	if r.tombstoneMaxCount == 0 {
+		return
+	}
+	tombstone.Removed = time.Now()
+	if err := r.state.AddContainerTombstone(tombstone); err != nil {
+		logrus.Errorf("Adding tombstone of container %s: %v", tombstone.ID, err)
+		return
+	}
+	if err := r.state.PruneContainerTombstones(r.tombstoneMaxCount, r.tombstoneMaxAge); err != nil {
+		logrus.Errorf("Pruning container tombstones: %v", err)
+	}
+}
+
+// tombstoneArgs returns the flags passing the tombstone retention limits on to
+// the cleanup processes of containers, which may remove them.
+func (r *Runtime) tombstoneArgs() []string {
+	return []string{
+		fmt.Sprintf("--tombstone-count=%d", r.tombstoneMaxCount),
+		fmt.Sprintf("--tombstone-max-age=%s", r.tombstoneMaxAge),
+	}
+}
+
+// TombstoneRetention returns how many tombstones of removed containers are
+// kept, and for how long.  A count of 0 means tombstones are disabled, an age
+// of 0 that they are kept regardless of age.
+func (r *Runtime) TombstoneRetention() (int, time.Duration) {
+	return r.tombstoneMaxCount, r.tombstoneMaxAge
+}
+
+// ContainerTombstones returns the tombstones of removed containers, most
+// recently removed first.
+func (r *Runtime) ContainerTombstones() ([]*define.ContainerTombstone, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+	tombstones, err := r.state.ContainerTombstones()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving container tombstones: %w", err)
+	}
+	return tombstones, nil
+}
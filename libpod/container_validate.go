@@ -132,6 +132,17 @@ func (c *Container) validate() error {
 		return fmt.Errorf("init containers must be created in a pod: %w", define.ErrInvalidArg)
 	}
 
+	// Sidecars only make sense inside a Pod and are long-running, so they
+	// cannot be init containers.
+	if c.config.Sidecar {
+		if len(c.config.Pod) < 1 {
+			return fmt.Errorf("sidecar containers must be created in a pod: %w", define.ErrInvalidArg)
+		}
+		if len(c.config.InitContainerType) > 0 {
+			return fmt.Errorf("a container cannot be both an init container and a sidecar: %w", define.ErrInvalidArg)
+		}
+	}
+
 	if c.config.SdNotifyMode == define.SdNotifyModeIgnore && len(c.config.SdNotifySocket) > 0 {
 		return fmt.Errorf("cannot set sd-notify socket %q with sd-notify mode %q", c.config.SdNotifySocket, c.config.SdNotifyMode)
 	}
@@ -100,6 +100,15 @@ type InspectContainerConfig struct {
 	SdNotifyMode string `json:"sdNotifyMode,omitempty"`
 	// SdNotifySocket is the NOTIFY_SOCKET in use by/configured for the container.
 	SdNotifySocket string `json:"sdNotifySocket,omitempty"`
+	// StorageQuota is the maximum size in bytes of the writable layer and
+	// the anonymous volumes of the container.
+	StorageQuota uint64 `json:"StorageQuota,omitempty"`
+	// StorageQuotaAction is the action taken when the storage quota is
+	// exceeded and not enforced by project quotas.
+	StorageQuotaAction string `json:"StorageQuotaAction,omitempty"`
+	// StorageQuotaMode is "project" if the storage quota is enforced by
+	// project quotas, or "monitor" if the usage is checked periodically.
+	StorageQuotaMode string `json:"StorageQuotaMode,omitempty"`
 	// ExposedPorts includes ports the container has exposed.
 	ExposedPorts map[string]struct{} `json:"ExposedPorts,omitempty"`
 
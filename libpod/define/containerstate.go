@@ -149,6 +149,11 @@ type ContainerStats struct {
 	PIDs        uint64
 	UpTime      time.Duration
 	Duration    uint64
+	// StorageQuota is the storage quota of the container, and
+	// StorageQuotaUsage the size of its writable layer and anonymous
+	// volumes.  Both are 0 if the container has no storage quota.
+	StorageQuota      uint64
+	StorageQuotaUsage uint64
 }
 
 // Statistics for an individual container network interface
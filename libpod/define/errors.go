@@ -49,6 +49,9 @@ var (
 	ErrCtrExists = errors.New("container already exists")
 	// ErrPodExists indicates a pod with the same name or ID already exists
 	ErrPodExists = errors.New("pod already exists")
+	// ErrIdempotencyKeyReused indicates an idempotency key was supplied
+	// with a request different from the one it was first used with
+	ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
 	// ErrImageExists indicates an image with the same ID already exists
 	ErrImageExists = errors.New("image already exists")
 	// ErrVolumeExists indicates a volume with the same name already exists
@@ -73,6 +76,9 @@ var (
 	ErrExecSessionStateInvalid = errors.New("exec session state improper")
 	// ErrVolumeBeingUsed indicates that a volume is being used by at least one container
 	ErrVolumeBeingUsed = errors.New("volume is being used")
+	// ErrLockHolderAlive indicates that a lock cannot be broken because the
+	// process holding it is still running, or is unknown
+	ErrLockHolderAlive = errors.New("lock holder is still running")
 
 	// ErrRuntimeFinalized indicates that the runtime has already been
 	// created and cannot be modified
@@ -0,0 +1,5 @@
+package define
+
+// GCKeepLabel denotes the container label key to exempt a container from
+// removal by `podman system gc`.
+const GCKeepLabel = "io.podman.gc.keep"
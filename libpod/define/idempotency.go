@@ -0,0 +1,20 @@
+package define
+
+import "time"
+
+// IdempotencyKey is a key supplied by a client with a create request.  It
+// is stored with the created object so a retry of the request returns the
+// object created by the original one instead of creating another.
+type IdempotencyKey struct {
+	// Key is the value supplied by the client
+	Key string `json:"key"`
+	// RequestDigest is the digest of the request, a retry must match it
+	RequestDigest string `json:"requestDigest"`
+	// Expires is the time after which the key is no longer honored
+	Expires time.Time `json:"expires"`
+}
+
+// Expired returns true if the key is no longer honored
+func (k *IdempotencyKey) Expired() bool {
+	return !time.Now().Before(k.Expires)
+}
@@ -14,6 +14,8 @@ type Info struct {
 	Registries map[string]any `json:"registries"`
 	Plugins    Plugins        `json:"plugins"`
 	Version    Version        `json:"version"`
+	// Service is set when the info is served by the API service
+	Service *ServiceInfo `json:"service,omitempty"`
 }
 
 // SecurityInfo describes the libpod host
@@ -166,6 +168,27 @@ type Plugins struct {
 	Authorization []string `json:"authorization"`
 }
 
+// ServiceInfo describes the request limits of the API service
+type ServiceInfo struct {
+	// QueueDepth is the number of requests waiting for an in flight slot
+	QueueDepth int                `json:"queueDepth"`
+	Limits     []ServiceLimitInfo `json:"limits"`
+}
+
+// ServiceLimitInfo describes the limits of a class of requests, applied to
+// each client separately.  Zero limits are unlimited.
+type ServiceLimitInfo struct {
+	Class string `json:"class"`
+	// Rate is the number of requests per second
+	Rate        float64 `json:"rate"`
+	Burst       int     `json:"burst"`
+	MaxInFlight int     `json:"maxInFlight"`
+	// InFlight is the number of requests being processed, for all clients
+	InFlight int `json:"inFlight"`
+	// Queued is the number of requests waiting, for all clients
+	Queued int `json:"queued"`
+}
+
 type CPUUsage struct {
 	UserPercent   float64 `json:"userPercent"`
 	SystemPercent float64 `json:"systemPercent"`
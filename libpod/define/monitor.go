@@ -0,0 +1,58 @@
+package define
+
+import "time"
+
+// SystemUsage is a sample of the resource usage of the host and of the
+// engine, as reported by the system monitor
+type SystemUsage struct {
+	// Time the sample was taken
+	Time       time.Time      `json:"time"`
+	Host       HostUsage      `json:"host"`
+	Containers ContainerUsage `json:"containers"`
+	Storage    StorageUsage   `json:"storage"`
+	Locks      LockUsage      `json:"locks"`
+}
+
+// HostUsage is the memory usage of the host
+type HostUsage struct {
+	CPUs     int   `json:"cpus"`
+	MemTotal int64 `json:"memTotal"`
+	MemFree  int64 `json:"memFree"`
+}
+
+// ContainerUsage is the combined resource usage of all containers
+type ContainerUsage struct {
+	// Total is the number of containers
+	Total int `json:"total"`
+	// States is the number of containers in each state
+	States map[string]int `json:"states"`
+	// CPU is the CPU usage of the running containers since the previous
+	// sample, in percent of one CPU
+	CPU float64 `json:"cpu"`
+	// MemUsage is the memory used by the running containers in bytes
+	MemUsage uint64 `json:"memUsage"`
+	// PIDs is the number of processes of the running containers
+	PIDs uint64 `json:"pids"`
+	// ConmonProcesses is the number of running conmon processes
+	ConmonProcesses int `json:"conmonProcesses"`
+}
+
+// StorageUsage is the usage of the file system holding the graph root
+type StorageUsage struct {
+	GraphRoot          string `json:"graphRoot"`
+	GraphRootAllocated uint64 `json:"graphRootAllocated"`
+	GraphRootUsed      uint64 `json:"graphRootUsed"`
+}
+
+// LockUsage is the usage of the locks of the lock manager
+type LockUsage struct {
+	// Allocated is the number of locks used by containers, pods and
+	// volumes
+	Allocated int `json:"allocated"`
+	// Available is the number of locks which can still be allocated, it is
+	// not set if the lock manager has no limit
+	Available *uint32 `json:"available,omitempty"`
+	// Held is the number of locks currently taken, it is not set if the
+	// lock manager cannot tell
+	Held *int `json:"held,omitempty"`
+}
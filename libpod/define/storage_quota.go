@@ -0,0 +1,20 @@
+package define
+
+import "fmt"
+
+// Actions taken on a container whose writable layer and anonymous volumes
+// exceed its storage quota, when the quota is not enforced by the file system.
+const (
+	StorageQuotaActionPause = "pause"
+	StorageQuotaActionStop  = "stop"
+)
+
+// ValidateStorageQuotaAction validates the specified storage quota action.
+func ValidateStorageQuotaAction(action string) error {
+	switch action {
+	case "", StorageQuotaActionPause, StorageQuotaActionStop:
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid storage quota action %q: must be %s or %s", ErrInvalidArg, action, StorageQuotaActionPause, StorageQuotaActionStop)
+	}
+}
@@ -0,0 +1,52 @@
+package define
+
+import "time"
+
+const (
+	// DefaultTombstoneMaxCount is the default number of removed containers
+	// whose tombstones are kept.
+	DefaultTombstoneMaxCount = 100
+	// DefaultTombstoneMaxAge is the default time tombstones are kept for
+	// after their container was removed.
+	DefaultTombstoneMaxAge = 7 * 24 * time.Hour
+	// TombstoneLogLines is the number of log lines kept in a tombstone.
+	TombstoneLogLines = 50
+)
+
+// ContainerTombstone is kept in the database when a container is removed, so
+// what it ran and how it ended can still be inspected.
+type ContainerTombstone struct {
+	// ID is the ID of the container.
+	ID string `json:"Id"`
+	// Name is the name of the container.
+	Name string `json:"Name"`
+	// Pod is the ID of the pod the container was in, if any.
+	Pod string `json:"Pod,omitempty"`
+	// Image is the name of the image the container was created from.
+	Image string `json:"Image"`
+	// ImageID is the ID of the image the container was created from.
+	ImageID string `json:"ImageID"`
+	// Command is the command the container ran.
+	Command []string `json:"Command"`
+	// Labels are the labels of the container.
+	Labels map[string]string `json:"Labels,omitempty"`
+	// OCIRuntime is the OCI runtime the container used.
+	OCIRuntime string `json:"OCIRuntime"`
+	// State is the state of the container when it was removed.
+	State string `json:"State"`
+	// ExitCode is the exit code of the container's main process.
+	ExitCode int32 `json:"ExitCode"`
+	// OOMKilled is whether the container was killed by the OOM killer.
+	OOMKilled bool `json:"OOMKilled"`
+	// Created is when the container was created.
+	Created time.Time `json:"Created"`
+	// StartedAt is when the container was last started.
+	StartedAt time.Time `json:"StartedAt"`
+	// FinishedAt is when the container last exited.
+	FinishedAt time.Time `json:"FinishedAt"`
+	// Removed is when the container was removed.
+	Removed time.Time `json:"Removed"`
+	// Logs are the last lines the container logged, if its log driver
+	// stores logs with the container.
+	Logs []string `json:"Logs,omitempty"`
+}
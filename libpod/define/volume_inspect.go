@@ -70,3 +70,19 @@ type VolumeReload struct {
 	Removed []string
 	Errors  []error
 }
+
+// VolumePluginInfo describes a volume plugin configured in containers.conf.
+type VolumePluginInfo struct {
+	// Name is the name of the plugin, used as volume driver.
+	Name string `json:"Name"`
+	// SocketPath is the unix socket at which the plugin is accessed.
+	SocketPath string `json:"SocketPath"`
+	// Reachable indicates whether the plugin answered on its socket.
+	Reachable bool `json:"Reachable"`
+	// Error is the reason the plugin is not reachable.
+	Error string `json:"Error,omitempty"`
+	// Implements lists the plugin APIs, e.g. VolumeDriver.
+	Implements []string `json:"Implements"`
+	// Scope of the volumes of the plugin, local or global.
+	Scope string `json:"Scope,omitempty"`
+}
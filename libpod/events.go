@@ -102,7 +102,6 @@ func (c *Container) newContainerExitedEvent(exitCode int32) {
 	e.Name = c.Name()
 	e.Image = c.config.RootfsImageName
 	e.Type = events.Container
-	e.PodID = c.PodID()
 	intExitCode := int(exitCode)
 	e.ContainerExitCode = &intExitCode
 
@@ -110,7 +109,7 @@ func (c *Container) newContainerExitedEvent(exitCode int32) {
 	if c.state.OOMKilled {
 		e.OOMKilled = &c.state.OOMKilled
 	}
-	e.Details = events.Details{Attributes: attrs}
+	e.Details = events.Details{PodID: c.PodID(), Attributes: attrs}
 
 	if err := c.runtime.eventer.Write(e); err != nil {
 		logrus.Errorf("Unable to write container exited event: %q", err)
@@ -124,6 +123,7 @@ func (c *Container) newExecDiedEvent(sessionID string, exitCode int) {
 	e.Name = c.Name()
 	e.Image = c.config.RootfsImageName
 	e.Type = events.Container
+	e.PodID = c.PodID()
 	intExitCode := exitCode
 	e.ContainerExitCode = &intExitCode
 
@@ -173,6 +173,43 @@ func (p *Pod) newPodEvent(status events.Status) {
 	}
 }
 
+// podEventMatcher decides whether an event belongs to a pod. A container is
+// remembered as a member of the pod once one of its events carries the pod
+// ID, so later events without it (e.g., network connect and disconnect) and
+// events of containers that have since been removed are matched as well.
+// It is not safe for concurrent use.
+type podEventMatcher struct {
+	podID   string
+	members map[string]struct{}
+}
+
+func newPodEventMatcher(podID string, members []string) *podEventMatcher {
+	m := &podEventMatcher{
+		podID:   podID,
+		members: make(map[string]struct{}, len(members)),
+	}
+	for _, id := range members {
+		m.members[id] = struct{}{}
+	}
+	return m
+}
+
+func (m *podEventMatcher) matches(e *events.Event) bool {
+	switch e.Type {
+	case events.Pod:
+		return e.ID == m.podID
+	case events.Container, events.Network:
+		if e.PodID == m.podID {
+			m.members[e.ID] = struct{}{}
+			return true
+		}
+		_, ok := m.members[e.ID]
+		return ok
+	default:
+		return false
+	}
+}
+
 // NewSystemEvent creates a new event for libpod as a whole.
 func (r *Runtime) NewSystemEvent(status events.Status) {
 	e := events.NewEvent(status)
@@ -183,6 +220,40 @@ func (r *Runtime) NewSystemEvent(status events.Status) {
 	}
 }
 
+// NewDeniedEvent creates a new event for an API request of client that was
+// denied by the authorization policy.
+func (r *Runtime) NewDeniedEvent(client, method, path, reason string) {
+	e := events.NewEvent(events.Denied)
+	e.Type = events.System
+	e.Name = client
+	e.Error = reason
+	e.Attributes = map[string]string{
+		"method": method,
+		"path":   path,
+	}
+
+	if err := r.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write system event: %q", err)
+	}
+}
+
+// NewGarbageCollectEvent creates a new event for a container or image of the
+// given type removed by `podman system gc` for the given reason.
+func (r *Runtime) NewGarbageCollectEvent(objectType, id, name, reason string) {
+	e := events.NewEvent(events.GarbageCollect)
+	e.Type = events.System
+	e.ID = id
+	e.Name = name
+	e.Attributes = map[string]string{
+		"type":   objectType,
+		"reason": reason,
+	}
+
+	if err := r.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write system event: %q", err)
+	}
+}
+
 // newVolumeEvent creates a new event for a libpod volume
 func (v *Volume) newVolumeEvent(status events.Status) {
 	e := events.NewEvent(status)
@@ -154,6 +154,8 @@ const (
 	Copy Status = "copy"
 	// Create ...
 	Create Status = "create"
+	// Denied indicates an API request was denied by the authorization policy
+	Denied Status = "denied"
 	// Exec ...
 	Exec Status = "exec"
 	// ExecDied indicates that an exec session in a container died.
@@ -162,6 +164,9 @@ const (
 	Exited Status = "died"
 	// Export ...
 	Export Status = "export"
+	// GarbageCollect indicates that `podman system gc` removed a container
+	// or image
+	GarbageCollect Status = "gc"
 	// HealthStatus ...
 	HealthStatus Status = "health_status"
 	// History ...
@@ -212,6 +217,8 @@ const (
 	Start Status = "start"
 	// Stop ...
 	Stop Status = "stop"
+	// StorageQuotaExceeded indicates that a container exceeded its storage quota
+	StorageQuotaExceeded Status = "storage-quota-exceeded"
 	// Sync ...
 	Sync Status = "sync"
 	// Tag ...
@@ -90,6 +90,12 @@ func (e *Event) ToHumanReadable(truncate bool) string {
 		} else {
 			humanFormat = fmt.Sprintf("%s %s %s", e.Time, e.Type, e.Status)
 		}
+		if e.Status == Denied {
+			humanFormat += fmt.Sprintf(" (method=%s, path=%s, error=%s)", e.Attributes["method"], e.Attributes["path"], e.Error)
+		}
+		if e.Status == GarbageCollect {
+			humanFormat += fmt.Sprintf(" (type=%s, id=%s, reason=%s)", e.Attributes["type"], id, e.Attributes["reason"])
+		}
 	case Machine, Volume:
 		humanFormat = fmt.Sprintf("%s %s %s %s", e.Time, e.Type, e.Status, e.Name)
 	case Secret:
@@ -154,6 +160,8 @@ func StringToStatus(name string) (Status, error) {
 		return Copy, nil
 	case Create.String():
 		return Create, nil
+	case Denied.String():
+		return Denied, nil
 	case Exec.String():
 		return Exec, nil
 	case ExecDied.String():
@@ -162,6 +170,8 @@ func StringToStatus(name string) (Status, error) {
 		return Exited, nil
 	case Export.String():
 		return Export, nil
+	case GarbageCollect.String():
+		return GarbageCollect, nil
 	case HealthStatus.String():
 		return HealthStatus, nil
 	case History.String():
@@ -210,6 +220,8 @@ func StringToStatus(name string) (Status, error) {
 		return Start, nil
 	case Stop.String():
 		return Stop, nil
+	case StorageQuotaExceeded.String():
+		return StorageQuotaExceeded, nil
 	case Sync.String():
 		return Sync, nil
 	case Tag.String():
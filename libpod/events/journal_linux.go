@@ -80,6 +80,19 @@ func (e EventJournalD) Write(ee Event) error {
 		if err := addLabelsToJournal(m, ee.Details.Attributes); err != nil {
 			return err
 		}
+	case System:
+		if ee.ID != "" {
+			m["PODMAN_ID"] = ee.ID
+		}
+		if ee.Name != "" {
+			m["PODMAN_NAME"] = ee.Name
+		}
+		if ee.Error != "" {
+			m["ERROR"] = ee.Error
+		}
+		if err := addLabelsToJournal(m, ee.Details.Attributes); err != nil {
+			return err
+		}
 	}
 
 	// starting with commit 7e6e267329 we set LogLevel=notice for the systemd healthcheck unit
@@ -293,6 +306,14 @@ func newEventFromJournalEntry(entry *sdjournal.JournalEntry) (*Event, error) {
 		if val, ok := entry.Fields["ERROR"]; ok {
 			newEvent.Error = val
 		}
+	case System:
+		newEvent.ID = entry.Fields["PODMAN_ID"]
+		if val, ok := entry.Fields["ERROR"]; ok {
+			newEvent.Error = val
+		}
+		if err := getLabelsFromJournal(entry, &newEvent); err != nil {
+			return nil, err
+		}
 	}
 	return &newEvent, nil
 }
@@ -0,0 +1,70 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/podman/v6/libpod/events"
+)
+
+func Test_podEventMatcher(t *testing.T) {
+	matcher := newPodEventMatcher("pod1", []string{"existing"})
+
+	tests := []struct {
+		name  string
+		event events.Event
+		want  bool
+	}{
+		{
+			name:  "event of the pod",
+			event: events.Event{Type: events.Pod, ID: "pod1", Status: events.Create},
+			want:  true,
+		},
+		{
+			name:  "event of another pod",
+			event: events.Event{Type: events.Pod, ID: "pod2", Status: events.Create},
+			want:  false,
+		},
+		{
+			name:  "event of a container in the pod",
+			event: events.Event{Type: events.Container, ID: "ctr1", Status: events.Create, Details: events.Details{PodID: "pod1"}},
+			want:  true,
+		},
+		{
+			name:  "event of a container in another pod",
+			event: events.Event{Type: events.Container, ID: "ctr2", Status: events.Create, Details: events.Details{PodID: "pod2"}},
+			want:  false,
+		},
+		{
+			name:  "event without pod ID of a known member",
+			event: events.Event{Type: events.Network, ID: "ctr1", Status: events.NetworkConnect},
+			want:  true,
+		},
+		{
+			name:  "event without pod ID of a current member",
+			event: events.Event{Type: events.Container, ID: "existing", Status: events.Exited},
+			want:  true,
+		},
+		{
+			name:  "event of a removed member",
+			event: events.Event{Type: events.Container, ID: "ctr1", Status: events.Remove, Details: events.Details{PodID: "pod1"}},
+			want:  true,
+		},
+		{
+			name:  "event without pod ID of another container",
+			event: events.Event{Type: events.Container, ID: "ctr3", Status: events.Start},
+			want:  false,
+		},
+		{
+			name:  "image event",
+			event: events.Event{Type: events.Image, ID: "ctr1", Status: events.Pull},
+			want:  false,
+		},
+	}
+	// The cases depend on each other, as the matcher remembers members.
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matcher.matches(&tt.event), tt.name)
+	}
+}
@@ -624,6 +624,7 @@ func (p *Pod) podWithContainers(ctx context.Context, containers []*Container, po
 			// Convert auto-update labels into kube annotations
 			maps.Copy(podAnnotations, getAutoUpdateAnnotations(ctr.Name(), ctr.Labels()))
 			isInit := ctr.IsInitCtr()
+			isSidecar := ctr.IsSidecar()
 			// Since hostname is only set at pod level, set the hostname to the hostname of the first container we encounter
 			if hostname == "" {
 				// Only set the hostname if it is not set to the truncated container ID, which we do by default if no
@@ -667,7 +668,15 @@ func (p *Pod) podWithContainers(ctx context.Context, containers []*Container, po
 				podInitCtrs = append(podInitCtrs, ctr)
 				continue
 			}
-			podContainers = append(podContainers, ctr)
+			if isSidecar {
+				// Sidecars are native Kubernetes sidecars: init
+				// containers that are always restarted.
+				restartPolicy := v1.ContainerRestartPolicyAlways
+				ctr.RestartPolicy = &restartPolicy
+				podInitCtrs = append(podInitCtrs, ctr)
+			} else {
+				podContainers = append(podContainers, ctr)
+			}
 			// Deduplicate volumes, so if containers in the pod share a volume, it's only
 			// listed in the volumes section once
 			for _, vol := range volumes {
@@ -177,3 +177,55 @@ func (locks *FileLocks) UnlockFileLock(lck uint32) error {
 	l.Unlock()
 	return nil
 }
+
+// TryLockFileLock attempts to lock the given lock without blocking.
+// It returns true if the lock was taken, in which case it must be released
+// with UnlockFileLock, and false if it is held by someone else.
+func (locks *FileLocks) TryLockFileLock(lck uint32) (bool, error) {
+	if !locks.valid {
+		return false, fmt.Errorf("locks have already been closed: %w", syscall.EINVAL)
+	}
+
+	l, err := lockfile.GetLockFile(locks.getLockPath(lck))
+	if err != nil {
+		return false, fmt.Errorf("acquiring lock: %w", err)
+	}
+
+	if err := l.TryLock(); err != nil {
+		if isContended(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("acquiring lock %d: %w", lck, err)
+	}
+	return true, nil
+}
+
+// isContended returns whether an error from trying to take a lock means that
+// the lock is held by someone else.
+func isContended(err error) bool {
+	// fcntl reports a lock held by another process as EAGAIN or EACCES.
+	// A lock held within this process is reported with a plain error
+	// carrying the message of EAGAIN.
+	return errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EWOULDBLOCK) ||
+		errors.Is(err, syscall.EACCES) || err.Error() == syscall.EAGAIN.Error()
+}
+
+// AllocatedLocks returns the IDs of all allocated locks.
+func (locks *FileLocks) AllocatedLocks() ([]uint32, error) {
+	if !locks.valid {
+		return nil, fmt.Errorf("locks have already been closed: %w", syscall.EINVAL)
+	}
+	files, err := os.ReadDir(locks.lockPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %s: %w", locks.lockPath, err)
+	}
+	ids := make([]uint32, 0, len(files))
+	for _, f := range files {
+		id, err := strconv.ParseUint(f.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
@@ -67,3 +67,56 @@ func TestLockAndUnlock(t *testing.T) {
 	err = l.UnlockFileLock(lock)
 	assert.NoError(t, err)
 }
+
+// Test that TryLockFileLock does not block on a held lock
+func TestTryLock(t *testing.T) {
+	d := t.TempDir()
+
+	l, err := CreateFileLock(filepath.Join(d, "locks"))
+	assert.NoError(t, err)
+
+	lock, err := l.AllocateLock()
+	assert.NoError(t, err)
+
+	locked, err := l.TryLockFileLock(lock)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	locked, err = l.TryLockFileLock(lock)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	err = l.UnlockFileLock(lock)
+	assert.NoError(t, err)
+
+	ids, err := l.AllocatedLocks()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{lock}, ids)
+}
+
+func TestTryLockError(t *testing.T) {
+	d := t.TempDir()
+
+	l, err := CreateFileLock(filepath.Join(d, "locks"))
+	assert.NoError(t, err)
+
+	lock, err := l.AllocateLock()
+	assert.NoError(t, err)
+
+	locked, err := l.TryLockFileLock(lock)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	err = l.UnlockFileLock(lock)
+	assert.NoError(t, err)
+
+	// A lock file that cannot be opened is an error, not contention
+	path := l.getLockPath(lock)
+	err = os.Remove(path)
+	assert.NoError(t, err)
+	err = os.Mkdir(path, 0o700)
+	assert.NoError(t, err)
+
+	locked, err = l.TryLockFileLock(lock)
+	assert.Error(t, err)
+	assert.False(t, locked)
+}
@@ -1,13 +1,20 @@
 package lock
 
 import (
-	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/libpod/lock/file"
 )
 
+// fileHoldersPath returns the directory of the holder table for the lock
+// directory at the given path.
+func fileHoldersPath(path string) string {
+	return path + "_holders"
+}
+
 // FileLockManager manages shared memory locks.
 type FileLockManager struct {
-	locks *file.FileLocks
+	locks       *file.FileLocks
+	holders     *holderTable
+	holdersPath string
 }
 
 // NewFileLockManager makes a new FileLockManager at the specified directory.
@@ -19,6 +26,8 @@ func NewFileLockManager(lockPath string) (Manager, error) {
 
 	manager := new(FileLockManager)
 	manager.locks = locks
+	manager.holdersPath = fileHoldersPath(lockPath)
+	manager.holders = newHolderTable(manager.holdersPath)
 
 	return manager, nil
 }
@@ -32,6 +41,8 @@ func OpenFileLockManager(path string) (Manager, error) {
 
 	manager := new(FileLockManager)
 	manager.locks = locks
+	manager.holdersPath = fileHoldersPath(path)
+	manager.holders = newHolderTable(manager.holdersPath)
 
 	return manager, nil
 }
@@ -87,12 +98,57 @@ func (m *FileLockManager) AvailableLocks() (*uint32, error) {
 }
 
 // LocksHeld returns any locks that are presently locked.
-// It is not implemented for the file lock backend.
-// It ought to be possible, but my motivation to dig into c/storage and add
-// trylock semantics to the filelocker implementation for an uncommonly-used
-// lock backend is lacking.
 func (m *FileLockManager) LocksHeld() ([]uint32, error) {
-	return nil, define.ErrNotImplemented
+	ids, err := m.locks.AllocatedLocks()
+	if err != nil {
+		return nil, err
+	}
+	var held []uint32
+	for _, id := range ids {
+		locked, err := m.locks.TryLockFileLock(id)
+		if err != nil {
+			return nil, err
+		}
+		if !locked {
+			held = append(held, id)
+			continue
+		}
+		if err := m.locks.UnlockFileLock(id); err != nil {
+			return nil, err
+		}
+	}
+	return held, nil
+}
+
+// LockHolders returns the processes holding and waiting for locks.
+func (m *FileLockManager) LockHolders() ([]LockInfo, error) {
+	held, err := m.LocksHeld()
+	if err != nil {
+		return nil, err
+	}
+	return m.holders.lockInfo(held)
+}
+
+// BreakLock releases the given lock if its recorded holder has exited.
+// File locks are released by the kernel when their holder exits, so this
+// only clears the stale record.
+func (m *FileLockManager) BreakLock(id uint32) error {
+	return m.holders.breakLock(id, func() (bool, error) {
+		return m.locks.TryLockFileLock(id)
+	}, func() error {
+		return m.locks.UnlockFileLock(id)
+	})
+}
+
+// RecordHolders enables or disables recording lock holders for the processes
+// opening the locks afterwards.
+func (m *FileLockManager) RecordHolders(enable bool) error {
+	return setHolderRecording(m.holdersPath, enable)
+}
+
+// RecordsHolders returns whether recording lock holders is enabled.
+func (m *FileLockManager) RecordsHolders() bool {
+	return holderRecording(m.holdersPath)
 }
 
 // FileLock is an individual shared memory lock.
@@ -108,13 +164,24 @@ func (l *FileLock) ID() uint32 {
 
 // Lock acquires the lock.
 func (l *FileLock) Lock() {
-	if err := l.manager.locks.LockFileLock(l.lockID); err != nil {
+	// Only record ourselves as waiting if the lock is contended.
+	locked, err := l.manager.locks.TryLockFileLock(l.lockID)
+	if err != nil {
 		panic(err.Error())
 	}
+	if !locked {
+		waiter := l.manager.holders.addWaiter(l.lockID)
+		if err := l.manager.locks.LockFileLock(l.lockID); err != nil {
+			panic(err.Error())
+		}
+		l.manager.holders.removeWaiter(waiter)
+	}
+	l.manager.holders.setHolder(l.lockID)
 }
 
 // Unlock releases the lock.
 func (l *FileLock) Unlock() {
+	l.manager.holders.clearHolder(l.lockID)
 	if err := l.manager.locks.UnlockFileLock(l.lockID); err != nil {
 		panic(err.Error())
 	}
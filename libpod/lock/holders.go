@@ -0,0 +1,433 @@
+package lock
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+// LockHolder describes a process that holds, or is waiting for, a lock.
+type LockHolder struct {
+	// PID is the process ID of the holder.
+	PID int
+	// Command is the command line of the holder, possibly truncated.
+	Command string
+	// Since is when the lock was acquired, or when the process started
+	// waiting for it.
+	Since time.Time
+}
+
+// Alive returns whether the process is still running.
+func (h *LockHolder) Alive() bool {
+	return processAlive(h.PID)
+}
+
+// LockInfo describes who holds a lock and who is waiting for it.
+type LockInfo struct {
+	// ID is the ID of the lock.
+	ID uint32
+	// Held is whether the lock is presently locked.
+	Held bool
+	// Holder is the process recorded as holding the lock. It is nil if
+	// the lock is held by a process that did not record itself.
+	Holder *LockHolder
+	// Waiters are the processes blocked acquiring the lock.
+	Waiters []LockHolder
+}
+
+const (
+	// holderRecordSize is the size of a record in the holder table.
+	// A record is the PID (4 bytes), 4 reserved bytes, the acquisition
+	// time in nanoseconds since the epoch (8 bytes), and the NUL-padded
+	// command of the holder.
+	holderRecordSize = 128
+	holderCommandLen = holderRecordSize - 16
+
+	holderTableFile = "holders"
+	waitersDir      = "waiters"
+)
+
+var (
+	// holderCommand is the command recorded for this process.
+	holderCommand = truncateCommand(strings.Join(os.Args, " "))
+	// waiterSeq distinguishes concurrent waiters within this process.
+	waiterSeq atomic.Uint64
+)
+
+func truncateCommand(cmd string) string {
+	if len(cmd) > holderCommandLen {
+		return cmd[:holderCommandLen]
+	}
+	return cmd
+}
+
+// holderTable is a side table recording which process holds each lock and
+// which processes are waiting for one.
+// Holders are stored as fixed-size records indexed by lock ID, so recording
+// a holder is a single write. Waiters are only recorded when a lock is
+// contended, as one file per waiting process.
+// Records are only written by the process holding the lock, so the lock
+// itself protects them.
+// Recording a holder costs a write on every Lock and Unlock, about ten times
+// the cost of an uncontended SHM lock, so it is only done once enabled with
+// setHolderRecording.
+// A nil holderTable records nothing; lock managers use one when recording is
+// not enabled or the table could not be opened, so that failing to track
+// holders never prevents locking.
+type holderTable struct {
+	file       *os.File
+	waitersDir string
+}
+
+// openHolderTable opens the holder table in the given directory, creating
+// it if necessary.
+func openHolderTable(dir string) (*holderTable, error) {
+	waiters := filepath.Join(dir, waitersDir)
+	if err := os.MkdirAll(waiters, 0o700); err != nil {
+		return nil, fmt.Errorf("creating lock holder directory: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, holderTableFile), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening lock holder table: %w", err)
+	}
+	return &holderTable{file: f, waitersDir: waiters}, nil
+}
+
+// newHolderTable opens the holder table in the given directory, returning nil
+// if recording holders is not enabled, or logging and returning nil if
+// opening the table fails.
+func newHolderTable(dir string) *holderTable {
+	if _, err := os.Stat(dir); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			logrus.Debugf("Lock holders will not be recorded: %v", err)
+		}
+		return nil
+	}
+	table, err := openHolderTable(dir)
+	if err != nil {
+		logrus.Debugf("Lock holders will not be recorded: %v", err)
+		return nil
+	}
+	return table
+}
+
+// setHolderRecording enables or disables recording holders in the given
+// directory, for the lock managers opened afterwards. Disabling it removes
+// all records.
+func setHolderRecording(dir string, enable bool) error {
+	if !enable {
+		return os.RemoveAll(dir)
+	}
+	table, err := openHolderTable(dir)
+	if err != nil {
+		return err
+	}
+	return table.file.Close()
+}
+
+// holderRecording returns whether recording holders in the given directory
+// is enabled.
+func holderRecording(dir string) bool {
+	_, err := os.Stat(dir)
+	return err == nil
+}
+
+func encodeHolderRecord(pid int, since time.Time, command string) []byte {
+	record := make([]byte, holderRecordSize)
+	binary.LittleEndian.PutUint32(record[0:4], uint32(pid))
+	binary.LittleEndian.PutUint64(record[8:16], uint64(since.UnixNano()))
+	copy(record[16:], command)
+	return record
+}
+
+func decodeHolderRecord(record []byte) *LockHolder {
+	pid := binary.LittleEndian.Uint32(record[0:4])
+	if pid == 0 {
+		return nil
+	}
+	command, _, _ := strings.Cut(string(record[16:]), "\x00")
+	return &LockHolder{
+		PID:     int(pid),
+		Command: command,
+		Since:   time.Unix(0, int64(binary.LittleEndian.Uint64(record[8:16]))),
+	}
+}
+
+// setHolder records this process as holding the given lock.
+func (t *holderTable) setHolder(id uint32) {
+	if t == nil {
+		return
+	}
+	record := encodeHolderRecord(os.Getpid(), time.Now(), holderCommand)
+	if _, err := t.file.WriteAt(record, int64(id)*holderRecordSize); err != nil {
+		logrus.Debugf("Recording holder of lock %d: %v", id, err)
+	}
+}
+
+// clearHolder removes the holder record of the given lock.
+func (t *holderTable) clearHolder(id uint32) {
+	if t == nil {
+		return
+	}
+	if _, err := t.file.WriteAt(make([]byte, 4), int64(id)*holderRecordSize); err != nil {
+		logrus.Debugf("Clearing holder of lock %d: %v", id, err)
+	}
+}
+
+// holder returns the recorded holder of the given lock, or nil if there is
+// none.
+func (t *holderTable) holder(id uint32) (*LockHolder, error) {
+	if t == nil {
+		return nil, nil
+	}
+	record := make([]byte, holderRecordSize)
+	if _, err := t.file.ReadAt(record, int64(id)*holderRecordSize); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading holder of lock %d: %w", id, err)
+	}
+	return decodeHolderRecord(record), nil
+}
+
+// holders returns all recorded holders, indexed by lock ID.
+func (t *holderTable) holders() (map[uint32]*LockHolder, error) {
+	holders := make(map[uint32]*LockHolder)
+	if t == nil {
+		return holders, nil
+	}
+	data, err := io.ReadAll(io.NewSectionReader(t.file, 0, 1<<62))
+	if err != nil {
+		return nil, fmt.Errorf("reading lock holder table: %w", err)
+	}
+	for i := 0; i+holderRecordSize <= len(data); i += holderRecordSize {
+		if holder := decodeHolderRecord(data[i : i+holderRecordSize]); holder != nil {
+			holders[uint32(i/holderRecordSize)] = holder
+		}
+	}
+	return holders, nil
+}
+
+// addWaiter records this process as waiting for the given lock, and returns
+// a handle to pass to removeWaiter once the lock has been acquired.
+func (t *holderTable) addWaiter(id uint32) string {
+	if t == nil {
+		return ""
+	}
+	name := fmt.Sprintf("%d-%d-%d", os.Getpid(), waiterSeq.Add(1), id)
+	path := filepath.Join(t.waitersDir, name)
+	if err := os.WriteFile(path, encodeHolderRecord(os.Getpid(), time.Now(), holderCommand), 0o600); err != nil {
+		logrus.Debugf("Recording waiter for lock %d: %v", id, err)
+		return ""
+	}
+	return path
+}
+
+// removeWaiter removes a record added by addWaiter.
+func (t *holderTable) removeWaiter(path string) {
+	if path == "" {
+		return
+	}
+	if err := os.Remove(path); err != nil {
+		logrus.Debugf("Removing lock waiter %s: %v", path, err)
+	}
+}
+
+// waiters returns the processes waiting for locks, indexed by lock ID.
+// Records left behind by processes that have exited are removed.
+func (t *holderTable) waiters() (map[uint32][]LockHolder, error) {
+	waiters := make(map[uint32][]LockHolder)
+	if t == nil {
+		return waiters, nil
+	}
+	entries, err := os.ReadDir(t.waitersDir)
+	if err != nil {
+		return nil, fmt.Errorf("reading lock waiters: %w", err)
+	}
+	for _, entry := range entries {
+		// Names are <pid>-<seq>-<lock ID>
+		parts := strings.Split(entry.Name(), "-")
+		if len(parts) != 3 {
+			continue
+		}
+		id, err := strconv.ParseUint(parts[2], 10, 32)
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(t.waitersDir, entry.Name())
+		record, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// The waiter acquired the lock meanwhile
+				continue
+			}
+			return nil, err
+		}
+		if len(record) != holderRecordSize {
+			continue
+		}
+		waiter := decodeHolderRecord(record)
+		if waiter == nil {
+			continue
+		}
+		if !waiter.Alive() {
+			t.removeWaiter(path)
+			continue
+		}
+		waiters[uint32(id)] = append(waiters[uint32(id)], *waiter)
+	}
+	return waiters, nil
+}
+
+// lockInfo combines the locks presently held with the holder table into the
+// information returned by Manager.LockHolders.
+// Locks that are not held are included if processes are waiting for them, or
+// if their recorded holder has exited without releasing its record.
+func (t *holderTable) lockInfo(held []uint32) ([]LockInfo, error) {
+	holders, err := t.holders()
+	if err != nil {
+		return nil, err
+	}
+	waiters, err := t.waiters()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[uint32]*LockInfo)
+	get := func(id uint32) *LockInfo {
+		info, ok := infos[id]
+		if !ok {
+			info = &LockInfo{ID: id}
+			infos[id] = info
+		}
+		return info
+	}
+	for _, id := range held {
+		info := get(id)
+		info.Held = true
+		info.Holder = holders[id]
+	}
+	for id, holder := range holders {
+		if _, ok := infos[id]; !ok && !holder.Alive() {
+			get(id).Holder = holder
+		}
+	}
+	for id, w := range waiters {
+		info := get(id)
+		info.Waiters = w
+		if info.Holder == nil {
+			info.Holder = holders[id]
+		}
+	}
+
+	result := make([]LockInfo, 0, len(infos))
+	for _, info := range infos {
+		result = append(result, *info)
+	}
+	slices.SortFunc(result, func(a, b LockInfo) int {
+		return int(a.ID) - int(b.ID)
+	})
+	return result, nil
+}
+
+// breakLock releases the given lock if the process recorded as holding it has
+// exited. tryLock must acquire the lock without blocking, reporting whether it
+// succeeded, and unlock must release it.
+func (t *holderTable) breakLock(id uint32, tryLock func() (bool, error), unlock func() error) error {
+	holder, err := t.holder(id)
+	if err != nil {
+		return err
+	}
+	if holder != nil && holder.Alive() {
+		return fmt.Errorf("lock %d is held by running process %d (%s): %w", id, holder.PID, holder.Command, define.ErrLockHolderAlive)
+	}
+
+	// Acquiring the lock recovers it from a holder that exited; if that
+	// fails, someone that is still running holds it.
+	locked, err := tryLock()
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return fmt.Errorf("lock %d is held by another process: %w", id, define.ErrLockHolderAlive)
+	}
+	t.clearHolder(id)
+	return unlock()
+}
+
+// LockCycles finds lock-order cycles: sets of locks where each lock is held
+// by a process waiting for the next one, so none of them can be released.
+// Each cycle is returned starting at its lowest lock ID.
+// Holders are only known by PID, and the goroutines of one process, such as
+// the API service, may hold and wait for locks independently. A process is
+// therefore never assumed to wait for itself, and only cycles across
+// processes are found.
+func LockCycles(infos []LockInfo) [][]uint32 {
+	// A process holding lock A and waiting for lock B, which another
+	// process holds, adds an edge A -> B.
+	held := make(map[int][]uint32)
+	for _, info := range infos {
+		if info.Held && info.Holder != nil {
+			held[info.Holder.PID] = append(held[info.Holder.PID], info.ID)
+		}
+	}
+	edges := make(map[uint32][]uint32)
+	for _, info := range infos {
+		if !info.Held || info.Holder == nil {
+			continue
+		}
+		for _, waiter := range info.Waiters {
+			if waiter.PID == info.Holder.PID {
+				continue
+			}
+			for _, id := range held[waiter.PID] {
+				if id != info.ID && !slices.Contains(edges[id], info.ID) {
+					edges[id] = append(edges[id], info.ID)
+				}
+			}
+		}
+	}
+
+	nodes := make([]uint32, 0, len(edges))
+	for id := range edges {
+		nodes = append(nodes, id)
+	}
+	slices.Sort(nodes)
+
+	// Only look for cycles through locks greater than the start, so each
+	// cycle is found once, from its lowest lock.
+	var cycles [][]uint32
+	for _, start := range nodes {
+		path := []uint32{start}
+		onPath := map[uint32]bool{start: true}
+		var visit func(id uint32)
+		visit = func(id uint32) {
+			for _, next := range edges[id] {
+				switch {
+				case next == start:
+					cycles = append(cycles, slices.Clone(path))
+				case next > start && !onPath[next]:
+					onPath[next] = true
+					path = append(path, next)
+					visit(next)
+					path = path[:len(path)-1]
+					delete(onPath, next)
+				}
+			}
+		}
+		visit(start)
+	}
+	return cycles
+}
@@ -0,0 +1,251 @@
+//go:build !windows
+
+package lock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+// A PID that is never running: larger than the kernel's pid_max limit
+const deadPID = 1 << 23
+
+func TestHolderTable(t *testing.T) {
+	table, err := openHolderTable(t.TempDir())
+	require.NoError(t, err)
+
+	holder, err := table.holder(3)
+	require.NoError(t, err)
+	assert.Nil(t, holder)
+
+	table.setHolder(3)
+	holder, err = table.holder(3)
+	require.NoError(t, err)
+	require.NotNil(t, holder)
+	assert.Equal(t, os.Getpid(), holder.PID)
+	assert.Equal(t, holderCommand, holder.Command)
+	assert.WithinDuration(t, time.Now(), holder.Since, time.Minute)
+	assert.True(t, holder.Alive())
+
+	holders, err := table.holders()
+	require.NoError(t, err)
+	assert.Len(t, holders, 1)
+	assert.Contains(t, holders, uint32(3))
+
+	table.clearHolder(3)
+	holders, err = table.holders()
+	require.NoError(t, err)
+	assert.Empty(t, holders)
+}
+
+func TestHolderTableNil(t *testing.T) {
+	var table *holderTable
+	table.setHolder(1)
+	table.clearHolder(1)
+	table.removeWaiter(table.addWaiter(1))
+
+	infos, err := table.lockInfo([]uint32{1})
+	require.NoError(t, err)
+	assert.Equal(t, []LockInfo{{ID: 1, Held: true}}, infos)
+}
+
+func TestHolderTableLockInfo(t *testing.T) {
+	table, err := openHolderTable(t.TempDir())
+	require.NoError(t, err)
+
+	// Lock 1 is held by us, lock 2 has a stale record from a process
+	// that exited, and lock 5 is free but waited on
+	table.setHolder(1)
+	_, err = table.file.WriteAt(encodeHolderRecord(deadPID, time.Now(), "podman stop"), 2*holderRecordSize)
+	require.NoError(t, err)
+	waiter := table.addWaiter(5)
+	require.NotEmpty(t, waiter)
+
+	// Waiters that exited are dropped
+	stale := filepath.Join(table.waitersDir, "8388608-1-6")
+	require.NoError(t, os.WriteFile(stale, encodeHolderRecord(deadPID, time.Now(), "podman rm"), 0o600))
+
+	infos, err := table.lockInfo([]uint32{1})
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	assert.Equal(t, uint32(1), infos[0].ID)
+	assert.True(t, infos[0].Held)
+	require.NotNil(t, infos[0].Holder)
+	assert.Equal(t, os.Getpid(), infos[0].Holder.PID)
+
+	assert.Equal(t, uint32(2), infos[1].ID)
+	assert.False(t, infos[1].Held)
+	require.NotNil(t, infos[1].Holder)
+	assert.Equal(t, "podman stop", infos[1].Holder.Command)
+	assert.False(t, infos[1].Holder.Alive())
+
+	assert.Equal(t, uint32(5), infos[2].ID)
+	assert.Nil(t, infos[2].Holder)
+	require.Len(t, infos[2].Waiters, 1)
+	assert.Equal(t, os.Getpid(), infos[2].Waiters[0].PID)
+
+	assert.NoFileExists(t, stale)
+	table.removeWaiter(waiter)
+	assert.NoFileExists(t, waiter)
+}
+
+// newRecordingFileLockManager returns a file lock manager recording holders
+func newRecordingFileLockManager(t *testing.T) Manager {
+	path := filepath.Join(t.TempDir(), "locks")
+	manager, err := NewFileLockManager(path)
+	require.NoError(t, err)
+	require.NoError(t, manager.RecordHolders(true))
+	manager, err = OpenFileLockManager(path)
+	require.NoError(t, err)
+	return manager
+}
+
+func TestHolderRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks")
+	manager, err := NewFileLockManager(path)
+	require.NoError(t, err)
+
+	// Holders are not recorded by default
+	assert.False(t, manager.RecordsHolders())
+	assert.Nil(t, manager.(*FileLockManager).holders)
+	assert.NoDirExists(t, fileHoldersPath(path))
+
+	// Enabling recording applies to managers opened afterwards
+	require.NoError(t, manager.RecordHolders(true))
+	assert.True(t, manager.RecordsHolders())
+	assert.Nil(t, manager.(*FileLockManager).holders)
+	reopened, err := OpenFileLockManager(path)
+	require.NoError(t, err)
+	assert.NotNil(t, reopened.(*FileLockManager).holders)
+
+	require.NoError(t, manager.RecordHolders(false))
+	assert.False(t, manager.RecordsHolders())
+	assert.NoDirExists(t, fileHoldersPath(path))
+	reopened, err = OpenFileLockManager(path)
+	require.NoError(t, err)
+	assert.Nil(t, reopened.(*FileLockManager).holders)
+}
+
+func TestBreakLock(t *testing.T) {
+	manager := newRecordingFileLockManager(t)
+	fileManager := manager.(*FileLockManager)
+
+	lock, err := manager.AllocateLock()
+	require.NoError(t, err)
+
+	// A lock held by this process is never broken
+	lock.Lock()
+	err = manager.BreakLock(lock.ID())
+	assert.ErrorIs(t, err, define.ErrLockHolderAlive)
+	lock.Unlock()
+
+	// A lock whose holder exited is recovered, and the record cleared
+	_, err = fileManager.holders.file.WriteAt(encodeHolderRecord(deadPID, time.Now(), "podman start"), int64(lock.ID())*holderRecordSize)
+	require.NoError(t, err)
+	infos, err := manager.LockHolders()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.Equal(t, deadPID, infos[0].Holder.PID)
+
+	require.NoError(t, manager.BreakLock(lock.ID()))
+	infos, err = manager.LockHolders()
+	require.NoError(t, err)
+	assert.Empty(t, infos)
+}
+
+func TestFileLockManagerHolders(t *testing.T) {
+	manager := newRecordingFileLockManager(t)
+
+	lock, err := manager.AllocateLock()
+	require.NoError(t, err)
+
+	lock.Lock()
+	held, err := manager.LocksHeld()
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{lock.ID()}, held)
+
+	// A second locker is recorded as waiting
+	acquired := make(chan struct{})
+	go func() {
+		lock.Lock()
+		close(acquired)
+	}()
+	require.Eventually(t, func() bool {
+		infos, err := manager.LockHolders()
+		return err == nil && len(infos) == 1 && len(infos[0].Waiters) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	lock.Unlock()
+	<-acquired
+	infos, err := manager.LockHolders()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.Empty(t, infos[0].Waiters)
+	assert.Equal(t, os.Getpid(), infos[0].Holder.PID)
+
+	lock.Unlock()
+	held, err = manager.LocksHeld()
+	require.NoError(t, err)
+	assert.Empty(t, held)
+}
+
+func TestLockCycles(t *testing.T) {
+	holder := func(pid int) *LockHolder {
+		return &LockHolder{PID: pid}
+	}
+	waiters := func(pids ...int) []LockHolder {
+		var w []LockHolder
+		for _, pid := range pids {
+			w = append(w, LockHolder{PID: pid})
+		}
+		return w
+	}
+
+	// 100 holds 1 and waits for 2, 200 holds 2 and waits for 3,
+	// 300 holds 3 and waits for 1.
+	// 400 holds 4 and waits for 1, but nothing waits on 4.
+	infos := []LockInfo{
+		{ID: 1, Held: true, Holder: holder(100), Waiters: waiters(300, 400)},
+		{ID: 2, Held: true, Holder: holder(200), Waiters: waiters(100)},
+		{ID: 3, Held: true, Holder: holder(300), Waiters: waiters(200)},
+		{ID: 4, Held: true, Holder: holder(400)},
+	}
+	assert.Equal(t, [][]uint32{{1, 2, 3}}, LockCycles(infos))
+
+	// Two processes each waiting for the lock the other holds
+	infos = []LockInfo{
+		{ID: 7, Held: true, Holder: holder(100), Waiters: waiters(200)},
+		{ID: 9, Held: true, Holder: holder(200), Waiters: waiters(100)},
+	}
+	assert.Equal(t, [][]uint32{{7, 9}}, LockCycles(infos))
+
+	// Waiting on a lock without holding any is not a cycle
+	infos = []LockInfo{
+		{ID: 1, Held: true, Holder: holder(100), Waiters: waiters(200, 300)},
+		{ID: 2, Held: true, Holder: nil, Waiters: waiters(100)},
+	}
+	assert.Empty(t, LockCycles(infos))
+
+	// Within a process, such as the API service, one request may hold a
+	// lock another is waiting for without either being stuck
+	infos = []LockInfo{
+		{ID: 1, Held: true, Holder: holder(100), Waiters: waiters(100)},
+		{ID: 2, Held: true, Holder: holder(100), Waiters: waiters(100)},
+	}
+	assert.Empty(t, LockCycles(infos))
+
+	// A process waiting for a lock it holds itself does not join a cycle
+	// across processes
+	infos = []LockInfo{
+		{ID: 1, Held: true, Holder: holder(100), Waiters: waiters(200)},
+		{ID: 2, Held: true, Holder: holder(200), Waiters: waiters(200)},
+	}
+	assert.Empty(t, LockCycles(infos))
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package lock
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// processAlive returns whether the process with the given PID is running.
+func processAlive(pid int) bool {
+	err := unix.Kill(pid, 0)
+	// EPERM means the process exists but belongs to another user
+	return err == nil || errors.Is(err, unix.EPERM)
+}
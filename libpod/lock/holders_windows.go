@@ -0,0 +1,8 @@
+package lock
+
+// processAlive returns whether the process with the given PID is running.
+// This cannot be determined here, so processes are assumed to be running and
+// their locks are never broken.
+func processAlive(_ int) bool {
+	return true
+}
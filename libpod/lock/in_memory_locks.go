@@ -3,7 +3,12 @@ package lock
 import (
 	"errors"
 	"fmt"
+	"os"
+	"slices"
 	"sync"
+	"time"
+
+	"go.podman.io/podman/v6/libpod/define"
 )
 
 // Mutex holds a single mutex and whether it has been allocated.
@@ -11,6 +16,11 @@ type Mutex struct {
 	id        uint32
 	lock      sync.Mutex
 	allocated bool
+
+	// state protects holder and waiters
+	state   sync.Mutex
+	holder  *LockHolder
+	waiters []*LockHolder
 }
 
 // ID retrieves the ID of the mutex
@@ -18,13 +28,34 @@ func (m *Mutex) ID() uint32 {
 	return m.id
 }
 
+func newLockHolder() *LockHolder {
+	return &LockHolder{PID: os.Getpid(), Command: holderCommand, Since: time.Now()}
+}
+
 // Lock locks the mutex
 func (m *Mutex) Lock() {
-	m.lock.Lock()
+	if !m.lock.TryLock() {
+		waiter := newLockHolder()
+		m.state.Lock()
+		m.waiters = append(m.waiters, waiter)
+		m.state.Unlock()
+
+		m.lock.Lock()
+
+		m.state.Lock()
+		m.waiters = slices.DeleteFunc(m.waiters, func(w *LockHolder) bool { return w == waiter })
+		m.state.Unlock()
+	}
+	m.state.Lock()
+	m.holder = newLockHolder()
+	m.state.Unlock()
 }
 
 // Unlock unlocks the mutex
 func (m *Mutex) Unlock() {
+	m.state.Lock()
+	m.holder = nil
+	m.state.Unlock()
 	m.lock.Unlock()
 }
 
@@ -145,3 +176,50 @@ func (m *InMemoryManager) LocksHeld() ([]uint32, error) {
 
 	return locks, nil
 }
+
+// LockHolders returns the holders of, and waiters for, every lock held or
+// waited on.
+// All holders are this process, as the locks are not multiprocess.
+func (m *InMemoryManager) LockHolders() ([]LockInfo, error) {
+	var infos []LockInfo
+
+	for _, lock := range m.locks {
+		lock.state.Lock()
+		if lock.holder != nil || len(lock.waiters) > 0 {
+			info := LockInfo{ID: lock.id, Held: lock.holder != nil, Holder: lock.holder}
+			for _, w := range lock.waiters {
+				info.Waiters = append(info.Waiters, *w)
+			}
+			infos = append(infos, info)
+		}
+		lock.state.Unlock()
+	}
+
+	return infos, nil
+}
+
+// RecordHolders is a no-op, the holders of in-memory locks are always
+// recorded.
+func (m *InMemoryManager) RecordHolders(_ bool) error {
+	return nil
+}
+
+// RecordsHolders returns true, the holders of in-memory locks are always
+// recorded.
+func (m *InMemoryManager) RecordsHolders() bool {
+	return true
+}
+
+// BreakLock is a no-op if the lock is free, and otherwise fails, as the
+// holder is always this process.
+func (m *InMemoryManager) BreakLock(id uint32) error {
+	if id >= m.numLocks {
+		return fmt.Errorf("given lock ID %d is too large - this manager only supports lock indexes up to %d", id, m.numLocks-1)
+	}
+	lock := m.locks[id]
+	if !lock.lock.TryLock() {
+		return fmt.Errorf("lock %d is held by running process %d: %w", id, os.Getpid(), define.ErrLockHolderAlive)
+	}
+	lock.lock.Unlock()
+	return nil
+}
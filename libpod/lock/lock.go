@@ -55,6 +55,23 @@ type Manager interface {
 	// This may not be supported by some drivers, depending on the exact
 	// backend implementation in use.
 	LocksHeld() ([]uint32, error)
+	// LockHolders returns the processes holding and waiting for locks.
+	// Every lock that is held is included, as are locks with waiters and
+	// locks whose recorded holder exited without releasing its record.
+	LockHolders() ([]LockInfo, error)
+	// BreakLock releases the given lock if the process recorded as holding
+	// it has exited, and clears the record.
+	// Locks held by a running process, or by a process that did not record
+	// itself, are never broken; define.ErrLockHolderAlive is returned.
+	BreakLock(id uint32) error
+	// RecordHolders enables or disables recording the processes holding
+	// and waiting for locks, for the processes opening the locks
+	// afterwards. Recording costs a write on every Lock and Unlock, so it
+	// is disabled by default; LockHolders only reports the processes that
+	// recorded themselves.
+	RecordHolders(enable bool) error
+	// RecordsHolders returns whether recording lock holders is enabled.
+	RecordsHolders() bool
 }
 
 // Locker is similar to sync.Locker, but provides a method for freeing the lock
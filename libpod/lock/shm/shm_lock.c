@@ -646,3 +646,55 @@ int32_t try_lock(shm_struct_t *shm, uint32_t sem_index) {
 
   return 1;
 }
+
+// Attempt to lock a given semaphore without blocking.
+// Unlike try_lock, the semaphore is NOT released if it was taken; the caller
+// must unlock it with unlock_semaphore.
+// Returns negative errno on failure.
+// On success, returns 1 if the lock was taken, and 0 if it is held by someone
+// else.
+int32_t trylock_semaphore(shm_struct_t *shm, uint32_t sem_index) {
+  int bitmap_index, index_in_bitmap, ret_code;
+
+  if (shm == NULL) {
+    return -1 * EINVAL;
+  }
+
+  if (sem_index >= shm->num_locks) {
+    return -1 * EINVAL;
+  }
+
+  bitmap_index = sem_index / BITMAP_SIZE;
+  index_in_bitmap = sem_index % BITMAP_SIZE;
+
+  ret_code = take_mutex(&(shm->locks[bitmap_index].locks[index_in_bitmap]), true);
+  if (ret_code == EBUSY) {
+    return 0;
+  } else if (ret_code != 0) {
+    return -1 * ret_code;
+  }
+
+  return 1;
+}
+
+// Lock the segment lock of an SHM segment.
+// This excludes allocations and deallocations in the segment, and is used to
+// serialize adding further segments across processes.
+// Returns 0 on success, or negative errno on failure.
+int32_t lock_shm_segment(shm_struct_t *shm) {
+  if (shm == NULL) {
+    return -1 * EINVAL;
+  }
+
+  return -1 * take_mutex(&(shm->segment_lock), false);
+}
+
+// Unlock the segment lock of an SHM segment.
+// Returns 0 on success, or negative errno on failure.
+int32_t unlock_shm_segment(shm_struct_t *shm) {
+  if (shm == NULL) {
+    return -1 * EINVAL;
+  }
+
+  return -1 * release_mutex(&(shm->segment_lock));
+}
@@ -27,6 +27,9 @@ import (
 // this number.
 var BitmapSize = uint32(C.bitmap_size_c)
 
+// ErrNoFreeLocks indicates that every lock in an SHM segment is allocated.
+var ErrNoFreeLocks = errors.New("no free locks")
+
 // SHMLocks is a struct enabling POSIX semaphore locking in a shared memory
 // segment.
 type SHMLocks struct {
@@ -139,7 +142,7 @@ func (locks *SHMLocks) AllocateSemaphore() (uint32, error) {
 			// that there's no room in the SHM inn for this lock, this tends to send normal people
 			// down the path of checking disk-space which is not actually their problem.
 			// Give a clue that it's actually due to num_locks filling up.
-			errFull := fmt.Errorf("allocation failed; exceeded num_locks (%d)", locks.maxLocks)
+			errFull := fmt.Errorf("allocation failed; exceeded num_locks (%d): %w", locks.maxLocks, ErrNoFreeLocks)
 			return uint32(retCode), errFull
 		}
 		return uint32(retCode), syscall.Errno(-1 * retCode)
@@ -231,6 +234,35 @@ func (locks *SHMLocks) LockSemaphore(sem uint32) error {
 	return nil
 }
 
+// TryLockSemaphore attempts to lock the given semaphore without blocking.
+// It returns true if the semaphore was locked, in which case it must be
+// released with UnlockSemaphore, and false if it is held by someone else.
+func (locks *SHMLocks) TryLockSemaphore(sem uint32) (bool, error) {
+	if !locks.valid {
+		return false, fmt.Errorf("locks have already been closed: %w", syscall.EINVAL)
+	}
+
+	if sem > locks.maxLocks {
+		return false, fmt.Errorf("given semaphore %d is higher than maximum locks count %d: %w", sem, locks.maxLocks, syscall.EINVAL)
+	}
+
+	// For pthread mutexes, we have to guarantee lock and unlock happen in
+	// the same thread.
+	runtime.LockOSThread()
+
+	retCode := C.trylock_semaphore(locks.lockStruct, C.uint32_t(sem))
+	if retCode <= 0 {
+		runtime.UnlockOSThread()
+		if retCode < 0 {
+			// Negative errno returned
+			return false, syscall.Errno(-1 * retCode)
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
 // UnlockSemaphore unlocks the given semaphore.
 // Unlocking a semaphore that is already unlocked with return EBUSY.
 // There is no requirement that the given semaphore be allocated.
@@ -262,6 +294,44 @@ func (locks *SHMLocks) UnlockSemaphore(sem uint32) error {
 	return nil
 }
 
+// LockSegment locks the segment lock of the shared-memory segment, excluding
+// allocations and deallocations in it until UnlockSegment is called.
+func (locks *SHMLocks) LockSegment() error {
+	if !locks.valid {
+		return fmt.Errorf("locks have already been closed: %w", syscall.EINVAL)
+	}
+
+	// For pthread mutexes, we have to guarantee lock and unlock happen in
+	// the same thread.
+	runtime.LockOSThread()
+
+	retCode := C.lock_shm_segment(locks.lockStruct)
+	if retCode < 0 {
+		runtime.UnlockOSThread()
+		// Negative errno returned
+		return syscall.Errno(-1 * retCode)
+	}
+
+	return nil
+}
+
+// UnlockSegment unlocks the segment lock taken by LockSegment.
+func (locks *SHMLocks) UnlockSegment() error {
+	if !locks.valid {
+		return fmt.Errorf("locks have already been closed: %w", syscall.EINVAL)
+	}
+
+	retCode := C.unlock_shm_segment(locks.lockStruct)
+	if retCode < 0 {
+		// Negative errno returned
+		return syscall.Errno(-1 * retCode)
+	}
+
+	runtime.UnlockOSThread()
+
+	return nil
+}
+
 // GetFreeLocks gets the number of locks available to be allocated.
 func (locks *SHMLocks) GetFreeLocks() (uint32, error) {
 	if !locks.valid {
@@ -302,7 +372,9 @@ func (locks *SHMLocks) GetTakenLocks() ([]uint32, error) {
 	return usedLocks, nil
 }
 
-func unlinkSHMLock(path string) error {
+// UnlinkSHMLock removes the shared-memory segment at the given path. Processes
+// that have it open may keep using it.
+func UnlinkSHMLock(path string) error {
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 
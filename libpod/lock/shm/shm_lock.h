@@ -43,5 +43,8 @@ int32_t lock_semaphore(shm_struct_t *shm, uint32_t sem_index);
 int32_t unlock_semaphore(shm_struct_t *shm, uint32_t sem_index);
 int64_t available_locks(shm_struct_t *shm);
 int32_t try_lock(shm_struct_t *shm, uint32_t sem_index);
+int32_t trylock_semaphore(shm_struct_t *shm, uint32_t sem_index);
+int32_t lock_shm_segment(shm_struct_t *shm);
+int32_t unlock_shm_segment(shm_struct_t *shm);
 
 #endif
@@ -3,9 +3,14 @@
 package shm
 
 import (
+	"errors"
+
 	"github.com/sirupsen/logrus"
 )
 
+// ErrNoFreeLocks indicates that every lock in an SHM segment is allocated.
+var ErrNoFreeLocks = errors.New("no free locks")
+
 // SHMLocks is a struct enabling POSIX semaphore locking in a shared memory
 // segment.
 type SHMLocks struct{}
@@ -89,6 +94,14 @@ func (locks *SHMLocks) LockSemaphore(sem uint32) error {
 	return nil
 }
 
+// TryLockSemaphore attempts to lock the given semaphore without blocking.
+// It returns true if the semaphore was locked, in which case it must be
+// released with UnlockSemaphore, and false if it is held by someone else.
+func (locks *SHMLocks) TryLockSemaphore(sem uint32) (bool, error) {
+	logrus.Error("Locks are not supported without cgo")
+	return true, nil
+}
+
 // UnlockSemaphore unlocks the given semaphore.
 // Unlocking a semaphore that is already unlocked with return EBUSY.
 // There is no requirement that the given semaphore be allocated.
@@ -100,6 +113,26 @@ func (locks *SHMLocks) UnlockSemaphore(sem uint32) error {
 	return nil
 }
 
+// LockSegment locks the segment lock of the shared-memory segment, excluding
+// allocations and deallocations in it until UnlockSegment is called.
+func (locks *SHMLocks) LockSegment() error {
+	logrus.Error("Locks are not supported without cgo")
+	return nil
+}
+
+// UnlockSegment unlocks the segment lock taken by LockSegment.
+func (locks *SHMLocks) UnlockSegment() error {
+	logrus.Error("Locks are not supported without cgo")
+	return nil
+}
+
+// UnlinkSHMLock removes the shared-memory segment at the given path. Processes
+// that have it open may keep using it.
+func UnlinkSHMLock(path string) error {
+	logrus.Error("Locks are not supported without cgo")
+	return nil
+}
+
 // GetFreeLocks gets the number of locks available to be allocated.
 func (locks *SHMLocks) GetFreeLocks() (uint32, error) {
 	logrus.Error("Locks are not supported without cgo")
@@ -28,7 +28,7 @@ const lockPath = "/libpod_test"
 // We need a test main to ensure that the SHM is created before the tests run
 func TestMain(m *testing.M) {
 	// Remove prior /libpod_test
-	if err := unlinkSHMLock(lockPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+	if err := UnlinkSHMLock(lockPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
 		fmt.Fprintf(os.Stderr, "Error cleaning SHM for tests: %v\n", err)
 		os.Exit(-1)
 	}
@@ -79,7 +79,7 @@ func runLockTest(t *testing.T, testFunc func(*testing.T, *SHMLocks)) {
 // Test that creating an SHM with a bad size rounds up to a good size
 func TestCreateNewSHMBadSizeRoundsUp(t *testing.T) {
 	// Remove prior /test1
-	if err := unlinkSHMLock("/test1"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+	if err := UnlinkSHMLock("/test1"); err != nil && !errors.Is(err, fs.ErrNotExist) {
 		t.Fatalf("Error cleaning SHM for tests: %v\n", err)
 	}
 	// Odd number, not a power of 2, should never be a word size on a system
@@ -300,3 +300,23 @@ func TestLockAndUnlockTwoSemaphore(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// Test that TryLockSemaphore takes a free lock and does not block on a held one
+func TestTryLockSemaphore(t *testing.T) {
+	runLockTest(t, func(t *testing.T, locks *SHMLocks) {
+		locked, err := locks.TryLockSemaphore(7)
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		locked, err = locks.TryLockSemaphore(7)
+		require.NoError(t, err)
+		assert.False(t, locked)
+
+		err = locks.UnlockSemaphore(7)
+		assert.NoError(t, err)
+
+		// The failed attempt must not have left the lock held
+		err = locks.UnlockSemaphore(7)
+		assert.Error(t, err)
+	})
+}
@@ -3,15 +3,89 @@
 package lock
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"syscall"
 
+	"github.com/sirupsen/logrus"
 	"go.podman.io/podman/v6/libpod/lock/shm"
 )
 
+// maxSHMSegments is the maximum number of SHM segments a manager will grow to.
+const maxSHMSegments = 256
+
+// shmHoldersPath returns the directory of the holder table for the SHM
+// segment at the given path.
+func shmHoldersPath(path string) string {
+	return filepath.Join("/dev/shm", path+"_holders")
+}
+
+// shmSegmentExists returns whether the SHM segment at the given path may
+// exist, without opening it.
+func shmSegmentExists(path string) bool {
+	_, err := os.Stat(filepath.Join("/dev/shm", path))
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// shmSegmentPath returns the path of the given SHM segment of a manager.
+// The first segment is at the manager's path, further segments have their
+// index appended.
+func shmSegmentPath(path string, index int) string {
+	if index == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+// RemoveSHMLocks removes the SHM segments of the lock manager at the given
+// path, including any added after it ran out of locks.
+func RemoveSHMLocks(path string) error {
+	if err := shm.UnlinkSHMLock(path); err != nil {
+		return err
+	}
+	removeSHMSegments(path, 1)
+	return nil
+}
+
+// removeSHMSegments removes the segments of the manager at the given path,
+// starting at the given index.
+func removeSHMSegments(path string, start int) {
+	for i := start; i < maxSHMSegments; i++ {
+		if err := shm.UnlinkSHMLock(shmSegmentPath(path, i)); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				logrus.Warnf("Removing SHM lock segment %s: %v", shmSegmentPath(path, i), err)
+			}
+			return
+		}
+	}
+}
+
 // SHMLockManager manages shared memory locks.
+// Locks are held in one or more SHM segments of the same size. When every
+// lock is allocated, another segment is added, so the number of locks grows
+// as needed. Lock IDs are numbered consecutively across segments.
 type SHMLockManager struct {
-	locks *shm.SHMLocks
+	path string
+	// segmentSize is the number of locks in each segment.
+	segmentSize uint32
+	// segments are the segments this process has opened. Other processes
+	// may have added more; they are opened as they are needed, probing
+	// only for segments past the last one known.
+	segments     []*shm.SHMLocks
+	segmentsLock sync.Mutex
+	holders      *holderTable
+}
+
+func newSHMLockManager(path string, locks *shm.SHMLocks) *SHMLockManager {
+	manager := new(SHMLockManager)
+	manager.path = path
+	manager.segmentSize = locks.GetMaxLocks()
+	manager.segments = []*shm.SHMLocks{locks}
+	manager.holders = newHolderTable(shmHoldersPath(path))
+	return manager
 }
 
 // NewSHMLockManager makes a new SHMLockManager with the given number of locks.
@@ -23,10 +97,10 @@ func NewSHMLockManager(path string, numLocks uint32) (Manager, error) {
 		return nil, err
 	}
 
-	manager := new(SHMLockManager)
-	manager.locks = locks
+	// Any further segments are left over from an earlier set of locks
+	removeSHMSegments(path, 1)
 
-	return manager, nil
+	return newSHMLockManager(path, locks), nil
 }
 
 // OpenSHMLockManager opens an existing SHMLockManager with the given number of
@@ -37,84 +111,251 @@ func OpenSHMLockManager(path string, numLocks uint32) (Manager, error) {
 		return nil, err
 	}
 
-	manager := new(SHMLockManager)
-	manager.locks = locks
+	manager := newSHMLockManager(path, locks)
+	if err := manager.openSegments(0); err != nil {
+		return nil, err
+	}
 
 	return manager, nil
 }
 
-// AllocateLock allocates a new lock from the manager.
-func (m *SHMLockManager) AllocateLock() (Locker, error) {
-	semIndex, err := m.locks.AllocateSemaphore()
-	if err != nil {
+// openSegments opens segments added by other processes, creating further
+// ones until there are at least minSegments.
+// New segments are only created or opened while holding the segment lock of
+// the first segment, so no process opens a segment that is being set up.
+// Must be called with segmentsLock held, or before the manager is shared.
+func (m *SHMLockManager) openSegments(minSegments int) error {
+	if minSegments > maxSHMSegments {
+		return fmt.Errorf("cannot grow locks beyond %d segments of %d locks: %w", maxSHMSegments, m.segmentSize, syscall.ENOSPC)
+	}
+
+	first := m.segments[0]
+	if err := first.LockSegment(); err != nil {
+		return err
+	}
+	defer func() {
+		if err := first.UnlockSegment(); err != nil {
+			logrus.Errorf("Unlocking SHM lock segment: %v", err)
+		}
+	}()
+
+	for i := len(m.segments); i < maxSHMSegments; i++ {
+		path := shmSegmentPath(m.path, i)
+		locks, err := shm.OpenSHMLock(path, m.segmentSize)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			if i >= minSegments {
+				break
+			}
+			locks, err = shm.CreateSHMLock(path, m.segmentSize)
+			if err != nil {
+				return fmt.Errorf("adding SHM lock segment: %w", err)
+			}
+			logrus.Infof("Added SHM lock segment %s with %d locks", path, m.segmentSize)
+		}
+		m.segments = append(m.segments, locks)
+	}
+
+	return nil
+}
+
+// lockSegment returns the segment holding the lock with the given ID and the
+// index of the lock in it, adding segments up to it if needed.
+func (m *SHMLockManager) lockSegment(id uint32) (*shm.SHMLocks, uint32, error) {
+	if m.segmentSize == 0 {
+		return nil, 0, fmt.Errorf("lock ID %d is too large - no locks are available: %w", id, syscall.EINVAL)
+	}
+	index := int(id / m.segmentSize)
+
+	m.segmentsLock.Lock()
+	defer m.segmentsLock.Unlock()
+
+	if index >= len(m.segments) {
+		if err := m.openSegments(index + 1); err != nil {
+			return nil, 0, fmt.Errorf("lock ID %d is too large: %w", id, err)
+		}
+	}
+	return m.segments[index], id % m.segmentSize, nil
+}
+
+// knownSegments returns the segments this process has opened.
+func (m *SHMLockManager) knownSegments() []*shm.SHMLocks {
+	m.segmentsLock.Lock()
+	defer m.segmentsLock.Unlock()
+	return m.segments
+}
+
+// allSegments returns every segment, including those added by other
+// processes. The segments already opened are kept, so it only looks for a
+// segment past the last known one.
+func (m *SHMLockManager) allSegments() ([]*shm.SHMLocks, error) {
+	m.segmentsLock.Lock()
+	defer m.segmentsLock.Unlock()
+
+	if !shmSegmentExists(shmSegmentPath(m.path, len(m.segments))) {
+		return m.segments, nil
+	}
+	if err := m.openSegments(0); err != nil {
 		return nil, err
 	}
+	return m.segments, nil
+}
 
+func (m *SHMLockManager) newLock(id uint32, segment *shm.SHMLocks, index uint32) *SHMLock {
 	lock := new(SHMLock)
-	lock.lockID = semIndex
+	lock.lockID = id
 	lock.manager = m
+	lock.segment = segment
+	lock.index = index
+	return lock
+}
+
+// AllocateLock allocates a new lock from the manager.
+// If every lock in the known segments is allocated, segments added by other
+// processes are opened, or another segment is added.
+func (m *SHMLockManager) AllocateLock() (Locker, error) {
+	for {
+		segments := m.knownSegments()
+		for i, segment := range segments {
+			semIndex, err := segment.AllocateSemaphore()
+			if err == nil {
+				return m.newLock(uint32(i)*m.segmentSize+semIndex, segment, semIndex), nil
+			}
+			if !errors.Is(err, shm.ErrNoFreeLocks) {
+				return nil, err
+			}
+		}
 
-	return lock, nil
+		m.segmentsLock.Lock()
+		err := m.openSegments(len(segments) + 1)
+		m.segmentsLock.Unlock()
+		if err != nil {
+			return nil, fmt.Errorf("allocating lock: %w", err)
+		}
+	}
 }
 
 // AllocateAndRetrieveLock allocates the lock with the given ID and returns it.
 // If the lock is already allocated, error.
 func (m *SHMLockManager) AllocateAndRetrieveLock(id uint32) (Locker, error) {
-	lock := new(SHMLock)
-	lock.lockID = id
-	lock.manager = m
-
-	if id >= m.locks.GetMaxLocks() {
-		return nil, fmt.Errorf("lock ID %d is too large - max lock size is %d: %w",
-			id, m.locks.GetMaxLocks()-1, syscall.EINVAL)
+	segment, index, err := m.lockSegment(id)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := m.locks.AllocateGivenSemaphore(id); err != nil {
+	if err := segment.AllocateGivenSemaphore(index); err != nil {
 		return nil, err
 	}
 
-	return lock, nil
+	return m.newLock(id, segment, index), nil
 }
 
 // RetrieveLock retrieves a lock from the manager given its ID.
 func (m *SHMLockManager) RetrieveLock(id uint32) (Locker, error) {
-	lock := new(SHMLock)
-	lock.lockID = id
-	lock.manager = m
-
-	if id >= m.locks.GetMaxLocks() {
-		return nil, fmt.Errorf("lock ID %d is too large - max lock size is %d: %w",
-			id, m.locks.GetMaxLocks()-1, syscall.EINVAL)
+	segment, index, err := m.lockSegment(id)
+	if err != nil {
+		return nil, err
 	}
 
-	return lock, nil
+	return m.newLock(id, segment, index), nil
 }
 
 // FreeAllLocks frees all locks in the manager.
 // This function is DANGEROUS. Please read the full comment in locks.go before
 // trying to use it.
 func (m *SHMLockManager) FreeAllLocks() error {
-	return m.locks.DeallocateAllSemaphores()
+	segments, err := m.allSegments()
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		if err := segment.DeallocateAllSemaphores(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// AvailableLocks returns the number of free locks in the manager.
+// AvailableLocks returns the number of free locks in the manager, across all
+// segments.
 func (m *SHMLockManager) AvailableLocks() (*uint32, error) {
-	avail, err := m.locks.GetFreeLocks()
+	segments, err := m.allSegments()
 	if err != nil {
 		return nil, err
 	}
+	var avail uint32
+	for _, segment := range segments {
+		free, err := segment.GetFreeLocks()
+		if err != nil {
+			return nil, err
+		}
+		avail += free
+	}
 
 	return &avail, nil
 }
 
 func (m *SHMLockManager) LocksHeld() ([]uint32, error) {
-	return m.locks.GetTakenLocks()
+	segments, err := m.allSegments()
+	if err != nil {
+		return nil, err
+	}
+	var held []uint32
+	for i, segment := range segments {
+		taken, err := segment.GetTakenLocks()
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range taken {
+			held = append(held, uint32(i)*m.segmentSize+index)
+		}
+	}
+	return held, nil
+}
+
+// LockHolders returns the processes holding and waiting for locks.
+func (m *SHMLockManager) LockHolders() ([]LockInfo, error) {
+	held, err := m.LocksHeld()
+	if err != nil {
+		return nil, err
+	}
+	return m.holders.lockInfo(held)
+}
+
+// BreakLock releases the given lock if its recorded holder has exited.
+func (m *SHMLockManager) BreakLock(id uint32) error {
+	segment, index, err := m.lockSegment(id)
+	if err != nil {
+		return err
+	}
+	return m.holders.breakLock(id, func() (bool, error) {
+		return segment.TryLockSemaphore(index)
+	}, func() error {
+		return segment.UnlockSemaphore(index)
+	})
+}
+
+// RecordHolders enables or disables recording lock holders for the processes
+// opening the locks afterwards.
+func (m *SHMLockManager) RecordHolders(enable bool) error {
+	return setHolderRecording(shmHoldersPath(m.path), enable)
+}
+
+// RecordsHolders returns whether recording lock holders is enabled.
+func (m *SHMLockManager) RecordsHolders() bool {
+	return holderRecording(shmHoldersPath(m.path))
 }
 
 // SHMLock is an individual shared memory lock.
 type SHMLock struct {
 	lockID  uint32
 	manager *SHMLockManager
+	// segment is the SHM segment holding the lock, and index the lock's
+	// index within it.
+	segment *shm.SHMLocks
+	index   uint32
 }
 
 // ID returns the ID of the lock.
@@ -124,19 +365,31 @@ func (l *SHMLock) ID() uint32 {
 
 // Lock acquires the lock.
 func (l *SHMLock) Lock() {
-	if err := l.manager.locks.LockSemaphore(l.lockID); err != nil {
+	// Only record ourselves as waiting if the lock is contended, so the
+	// common case does not touch the filesystem.
+	locked, err := l.segment.TryLockSemaphore(l.index)
+	if err != nil {
 		panic(err.Error())
 	}
+	if !locked {
+		waiter := l.manager.holders.addWaiter(l.lockID)
+		if err := l.segment.LockSemaphore(l.index); err != nil {
+			panic(err.Error())
+		}
+		l.manager.holders.removeWaiter(waiter)
+	}
+	l.manager.holders.setHolder(l.lockID)
 }
 
 // Unlock releases the lock.
 func (l *SHMLock) Unlock() {
-	if err := l.manager.locks.UnlockSemaphore(l.lockID); err != nil {
+	l.manager.holders.clearHolder(l.lockID)
+	if err := l.segment.UnlockSemaphore(l.index); err != nil {
 		panic(err.Error())
 	}
 }
 
 // Free releases the lock, allowing it to be reused.
 func (l *SHMLock) Free() error {
-	return l.manager.locks.DeallocateSemaphore(l.lockID)
+	return l.segment.DeallocateSemaphore(l.index)
 }
@@ -0,0 +1,122 @@
+//go:build linux && cgo
+
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/libpod/lock/shm"
+)
+
+func TestSHMLockManagerGrows(t *testing.T) {
+	path := fmt.Sprintf("/libpod_grow_test_%d", os.Getpid())
+	if err := RemoveSHMLocks(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Error cleaning SHM for tests: %v", err)
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, RemoveSHMLocks(path))
+		os.RemoveAll(shmHoldersPath(path))
+	})
+
+	manager, err := NewSHMLockManager(path, shm.BitmapSize)
+	require.NoError(t, err)
+
+	// Exhaust the first segment, and allocate one more
+	ids := make(map[uint32]bool)
+	for range shm.BitmapSize + 1 {
+		lock, err := manager.AllocateLock()
+		require.NoError(t, err)
+		assert.False(t, ids[lock.ID()], "lock %d allocated twice", lock.ID())
+		ids[lock.ID()] = true
+	}
+	assert.Contains(t, ids, shm.BitmapSize)
+
+	avail, err := manager.AvailableLocks()
+	require.NoError(t, err)
+	assert.Equal(t, shm.BitmapSize-1, *avail)
+
+	// Another process sees the added segment
+	other, err := OpenSHMLockManager(path, shm.BitmapSize)
+	require.NoError(t, err)
+	avail, err = other.AvailableLocks()
+	require.NoError(t, err)
+	assert.Equal(t, shm.BitmapSize-1, *avail)
+
+	lock, err := other.RetrieveLock(shm.BitmapSize)
+	require.NoError(t, err)
+	lock.Lock()
+	held, err := manager.LocksHeld()
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{shm.BitmapSize}, held)
+	lock.Unlock()
+
+	_, err = other.AllocateAndRetrieveLock(shm.BitmapSize)
+	assert.Error(t, err)
+	require.NoError(t, lock.Free())
+	_, err = other.AllocateAndRetrieveLock(shm.BitmapSize)
+	require.NoError(t, err)
+
+	// Retrieving a lock past the last segment adds segments up to it, as
+	// locks are repopulated after a reboot
+	lock, err = manager.AllocateAndRetrieveLock(3*shm.BitmapSize + 1)
+	require.NoError(t, err)
+	// The other process keeps the segments it knows until it needs more
+	assert.Len(t, other.(*SHMLockManager).knownSegments(), 2)
+	avail, err = other.AvailableLocks()
+	require.NoError(t, err)
+	assert.Equal(t, 3*shm.BitmapSize-2, *avail)
+	require.NoError(t, lock.Free())
+
+	require.NoError(t, manager.FreeAllLocks())
+	avail, err = manager.AvailableLocks()
+	require.NoError(t, err)
+	assert.Equal(t, 4*shm.BitmapSize, *avail)
+
+	// A new set of locks starts with a single segment again
+	require.NoError(t, shm.UnlinkSHMLock(path))
+	manager, err = NewSHMLockManager(path, shm.BitmapSize)
+	require.NoError(t, err)
+	avail, err = manager.AvailableLocks()
+	require.NoError(t, err)
+	assert.Equal(t, shm.BitmapSize, *avail)
+}
+
+// BenchmarkSHMLock measures an uncontended Lock and Unlock, with and without
+// recording the holder
+func BenchmarkSHMLock(b *testing.B) {
+	path := fmt.Sprintf("/libpod_bench_test_%d", os.Getpid())
+	if err := RemoveSHMLocks(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		b.Fatalf("Error cleaning SHM for tests: %v", err)
+	}
+	b.Cleanup(func() {
+		assert.NoError(b, RemoveSHMLocks(path))
+		os.RemoveAll(shmHoldersPath(path))
+	})
+
+	manager, err := NewSHMLockManager(path, shm.BitmapSize)
+	require.NoError(b, err)
+	lock, err := manager.AllocateLock()
+	require.NoError(b, err)
+	require.NoError(b, manager.RecordHolders(true))
+	holders := newHolderTable(shmHoldersPath(path))
+	require.NotNil(b, holders)
+
+	for _, tc := range []struct {
+		name    string
+		holders *holderTable
+	}{{"holders", holders}, {"noholders", nil}} {
+		b.Run(tc.name, func(b *testing.B) {
+			manager.(*SHMLockManager).holders = tc.holders
+			for b.Loop() {
+				lock.Lock()
+				lock.Unlock()
+			}
+		})
+	}
+}
@@ -18,6 +18,11 @@ func OpenSHMLockManager(_ string, _ uint32) (Manager, error) {
 	return nil, fmt.Errorf("not supported")
 }
 
+// RemoveSHMLocks is not supported on this platform
+func RemoveSHMLocks(_ string) error {
+	return fmt.Errorf("not supported")
+}
+
 // AllocateLock is not supported on this platform
 func (m *SHMLockManager) AllocateLock() (Locker, error) {
 	return nil, fmt.Errorf("not supported")
@@ -42,3 +47,23 @@ func (m *SHMLockManager) AvailableLocks() (*uint32, error) {
 func (m *SHMLockManager) LocksHeld() ([]uint32, error) {
 	return nil, fmt.Errorf("not supported")
 }
+
+// LockHolders is not supported on this platform
+func (m *SHMLockManager) LockHolders() ([]LockInfo, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+// BreakLock is not supported on this platform
+func (m *SHMLockManager) BreakLock(_ uint32) error {
+	return fmt.Errorf("not supported")
+}
+
+// RecordHolders is not supported on this platform
+func (m *SHMLockManager) RecordHolders(_ bool) error {
+	return fmt.Errorf("not supported")
+}
+
+// RecordsHolders is not supported on this platform
+func (m *SHMLockManager) RecordsHolders() bool {
+	return false
+}
@@ -83,6 +83,29 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 	return t, logTail, err
 }
 
+// TailLog returns the last lines of the log file at the given path, with
+// partial lines joined.
+func TailLog(path string, tail int) ([]string, error) {
+	logLines, err := getTailLog(path, tail)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]string, 0, len(logLines))
+	partial := ""
+	for _, line := range logLines {
+		if line.Partial() {
+			partial += line.Msg
+			continue
+		}
+		lines = append(lines, partial+line.Msg)
+		partial = ""
+	}
+	if partial != "" {
+		lines = append(lines, partial)
+	}
+	return lines, nil
+}
+
 func getTailLog(path string, tail int) ([]*LogLine, error) {
 	var (
 		nllCounter int
@@ -0,0 +1,147 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/storage/pkg/system"
+	"golang.org/x/sys/unix"
+)
+
+// SystemUsage samples the resource usage of the host and of the engine.
+// previous holds the statistics of each container at the prior sample, which
+// the CPU usage is computed against, and is updated for the next sample.
+func (r *Runtime) SystemUsage(previous map[string]*define.ContainerStats) (*define.SystemUsage, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	usage := &define.SystemUsage{Time: time.Now()}
+
+	mi, err := system.ReadMemInfo()
+	if err != nil {
+		return nil, fmt.Errorf("reading memory info: %w", err)
+	}
+	usage.Host = define.HostUsage{CPUs: runtime.NumCPU(), MemTotal: mi.MemTotal, MemFree: mi.MemFree}
+
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return nil, err
+	}
+	usage.Containers.Total = len(ctrs)
+	usage.Containers.States = make(map[string]int)
+	seen := make(map[string]bool, len(ctrs))
+	for _, ctr := range ctrs {
+		state, conmonPID, err := ctr.monitorState()
+		if err != nil {
+			// The container may have been removed since listing it
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				usage.Containers.Total--
+				continue
+			}
+			return nil, err
+		}
+		usage.Containers.States[state.String()]++
+		if conmonPID > 0 && processAlive(conmonPID) {
+			usage.Containers.ConmonProcesses++
+		}
+		if state != define.ContainerStateRunning && state != define.ContainerStatePaused || ctr.config.NoCgroups {
+			continue
+		}
+
+		stats, err := ctr.GetContainerStats(previous[ctr.ID()])
+		if err != nil {
+			// The container may have exited since checking its state
+			logrus.Debugf("Skipping stats of container %s: %v", ctr.ID(), err)
+			continue
+		}
+		seen[ctr.ID()] = true
+		previous[ctr.ID()] = stats
+		usage.Containers.CPU += stats.CPU
+		usage.Containers.MemUsage += stats.MemUsage
+		usage.Containers.PIDs += stats.PIDs
+	}
+	for id := range previous {
+		if !seen[id] {
+			delete(previous, id)
+		}
+	}
+
+	storage, err := r.StorageUsage()
+	if err != nil {
+		return nil, err
+	}
+	usage.Storage = *storage
+
+	if usage.Locks, err = r.lockUsage(usage.Containers.Total); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// StorageUsage returns the usage of the file system holding the graph root.
+func (r *Runtime) StorageUsage() (*define.StorageUsage, error) {
+	var grStats syscall.Statfs_t
+	if err := syscall.Statfs(r.store.GraphRoot(), &grStats); err != nil {
+		return nil, fmt.Errorf("unable to collect graph root usage for %q: %w", r.store.GraphRoot(), err)
+	}
+	bsize := uint64(grStats.Bsize) //nolint:unconvert,nolintlint // Bsize is not always uint64 on Linux.
+	allocated := bsize * grStats.Blocks
+	return &define.StorageUsage{
+		GraphRoot:          r.store.GraphRoot(),
+		GraphRootAllocated: allocated,
+		GraphRootUsed:      allocated - (bsize * grStats.Bfree),
+	}, nil
+}
+
+// monitorState returns the state of the container and the PID of its conmon
+func (c *Container) monitorState() (define.ContainerStatus, int, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return define.ContainerStateUnknown, 0, err
+		}
+	}
+	return c.state.State, c.state.ConmonPID, nil
+}
+
+// lockUsage returns the usage of the locks of the lock manager
+func (r *Runtime) lockUsage(ctrs int) (define.LockUsage, error) {
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return define.LockUsage{}, err
+	}
+	volumes, err := r.state.AllVolumes()
+	if err != nil {
+		return define.LockUsage{}, err
+	}
+	usage := define.LockUsage{Allocated: ctrs + len(pods) + len(volumes)}
+
+	if usage.Available, err = r.lockManager.AvailableLocks(); err != nil {
+		return define.LockUsage{}, fmt.Errorf("retrieving available locks: %w", err)
+	}
+	held, err := r.lockManager.LocksHeld()
+	switch {
+	case err == nil:
+		n := len(held)
+		usage.Held = &n
+	case !errors.Is(err, define.ErrNotImplemented):
+		return define.LockUsage{}, fmt.Errorf("retrieving held locks: %w", err)
+	}
+	return usage, nil
+}
+
+// processAlive returns true if the process exists
+func processAlive(pid int) bool {
+	err := unix.Kill(pid, 0)
+	return err == nil || errors.Is(err, unix.EPERM)
+}
@@ -1063,6 +1063,7 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 	if err != nil {
 		return 0, err
 	}
+	exitCommand = append(exitCommand, ctr.runtime.tombstoneArgs()...)
 	exitCommand = append(exitCommand, ctr.config.ID)
 
 	args = append(args, "--exit-command", exitCommand[0])
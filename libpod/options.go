@@ -459,6 +459,26 @@ func WithNoBoltError() RuntimeOption {
 	}
 }
 
+// WithTombstoneRetention sets how many tombstones of removed containers are
+// kept, and for how long. A count of 0 disables tombstones; an age of 0 keeps
+// them regardless of age.
+func WithTombstoneRetention(count int, age time.Duration) RuntimeOption {
+	return func(rt *Runtime) error {
+		if rt.valid {
+			return define.ErrRuntimeFinalized
+		}
+		if count < 0 {
+			return fmt.Errorf("tombstone count must not be negative: %w", define.ErrInvalidArg)
+		}
+		if age < 0 {
+			return fmt.Errorf("tombstone age must not be negative: %w", define.ErrInvalidArg)
+		}
+		rt.tombstoneMaxCount = count
+		rt.tombstoneMaxAge = age
+		return nil
+	}
+}
+
 // Container Creation Options
 
 // WithMaxLogSize sets the maximum size of container logs.
@@ -940,6 +960,37 @@ func WithDependencyCtrs(ctrs []*Container) CtrCreateOption {
 	}
 }
 
+// WithHealthyDependencyCtrs sets dependency containers of the given container
+// that must be healthy before this container is started. All of them must have
+// a healthcheck. They are added to the dependency containers if not yet present.
+func WithHealthyDependencyCtrs(ctrs []*Container) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		deps := make([]string, 0, len(ctrs))
+
+		for _, dep := range ctrs {
+			if err := checkDependencyContainer(dep, ctr); err != nil {
+				return err
+			}
+			if !dep.HasHealthCheck() {
+				return fmt.Errorf("container %s has no healthcheck and cannot be waited on to become healthy: %w", dep.ID(), define.ErrInvalidArg)
+			}
+
+			deps = append(deps, dep.ID())
+			if !slices.Contains(ctr.config.Dependencies, dep.ID()) {
+				ctr.config.Dependencies = append(ctr.config.Dependencies, dep.ID())
+			}
+		}
+
+		ctr.config.HealthyDependencies = deps
+
+		return nil
+	}
+}
+
 // WithNetNS indicates that the container should be given a new network
 // namespace with a minimal configuration.
 // An optional array of port mappings can be provided.
@@ -1864,6 +1915,29 @@ func WithInitCtrType(containerType string) CtrCreateOption {
 	}
 }
 
+// WithSidecar indicates the container is a sidecar of its pod.
+func WithSidecar() CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.Sidecar = true
+		return nil
+	}
+}
+
+// WithIdempotencyKey stores the idempotency key supplied with the request
+// creating the container.
+func WithIdempotencyKey(key *define.IdempotencyKey) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.IdempotencyKey = key
+		return nil
+	}
+}
+
 // WithHostDevice adds the original host src to the config
 func WithHostDevice(dev []specs.LinuxDevice) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1951,6 +2025,18 @@ func WithPodExitPolicy(policy string) PodCreateOption {
 	}
 }
 
+// WithPodIdempotencyKey stores the idempotency key supplied with the request
+// creating the pod.
+func WithPodIdempotencyKey(key *define.IdempotencyKey) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+		pod.config.IdempotencyKey = key
+		return nil
+	}
+}
+
 // WithPodRestartPolicy sets the restart policy of the pod.
 func WithPodRestartPolicy(policy string) PodCreateOption {
 	return func(pod *Pod) error {
@@ -2189,6 +2275,31 @@ func WithPodResources(resources specs.LinuxResources) PodCreateOption {
 	}
 }
 
+// WithStorageQuota limits the combined size of the writable layer and the
+// anonymous volumes of the container. The action, "pause" or "stop", is taken
+// when the quota is exceeded and the file system cannot enforce it.
+func WithStorageQuota(size uint64, action string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		if size == 0 {
+			return fmt.Errorf("storage quota must be greater than 0: %w", define.ErrInvalidArg)
+		}
+		if err := define.ValidateStorageQuotaAction(action); err != nil {
+			return err
+		}
+		if action == "" {
+			action = define.StorageQuotaActionStop
+		}
+
+		ctr.config.StorageQuota = size
+		ctr.config.StorageQuotaAction = action
+
+		return nil
+	}
+}
+
 // WithVolatile sets the volatile flag for the container storage.
 // The option can potentially cause data loss when used on a container that must survive a machine reboot.
 func WithVolatile() CtrCreateOption {
@@ -31,18 +31,23 @@ var json = jsoniter.ConfigCompatibleWithStandardLibrary
 // These are well-established paths that should not change unless the plugin API
 // version changes.
 var (
-	activatePath    = "/Plugin.Activate"
-	createPath      = "/VolumeDriver.Create"
-	getPath         = "/VolumeDriver.Get"
-	listPath        = "/VolumeDriver.List"
-	removePath      = "/VolumeDriver.Remove"
-	hostVirtualPath = "/VolumeDriver.Path"
-	mountPath       = "/VolumeDriver.Mount"
-	unmountPath     = "/VolumeDriver.Unmount"
+	activatePath     = "/Plugin.Activate"
+	capabilitiesPath = "/VolumeDriver.Capabilities"
+	createPath       = "/VolumeDriver.Create"
+	getPath          = "/VolumeDriver.Get"
+	listPath         = "/VolumeDriver.List"
+	removePath       = "/VolumeDriver.Remove"
+	hostVirtualPath  = "/VolumeDriver.Path"
+	mountPath        = "/VolumeDriver.Mount"
+	unmountPath      = "/VolumeDriver.Unmount"
 )
 
 const (
 	volumePluginType = "VolumeDriver"
+
+	// defaultVolumeScope is the scope of volumes of plugins not reporting
+	// their capabilities
+	defaultVolumeScope = "local"
 )
 
 var (
@@ -64,6 +69,8 @@ type VolumePlugin struct {
 	SocketPath string
 	// Client is the HTTP client we use to connect to the plugin.
 	Client *http.Client
+	// Implements lists the plugin APIs reported on activation.
+	Implements []string
 }
 
 // This is the response from the activate endpoint of the API.
@@ -110,6 +117,7 @@ func validatePlugin(newPlugin *VolumePlugin) error {
 	if !slices.Contains(respStruct.Implements, volumePluginType) {
 		return fmt.Errorf("plugin %s does not implement volume plugin, instead provides %s: %w", newPlugin.Name, strings.Join(respStruct.Implements, ", "), ErrNotVolumePlugin)
 	}
+	newPlugin.Implements = respStruct.Implements
 
 	if plugins == nil {
 		plugins = make(map[string]*VolumePlugin)
@@ -262,6 +270,44 @@ func (p *VolumePlugin) handleErrorResponse(resp *http.Response, endpoint, volNam
 	return nil
 }
 
+// Capabilities gets the capabilities of the plugin.  Plugins that do not
+// implement the capabilities endpoint are assumed to create local volumes.
+func (p *VolumePlugin) Capabilities() (*volume.Capability, error) {
+	if err := p.verifyReachable(); err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("Getting capabilities of plugin %s", p.Name)
+
+	resp, err := p.sendRequest(nil, capabilitiesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &volume.Capability{Scope: defaultVolumeScope}, nil
+	}
+	if err := p.handleErrorResponse(resp, capabilitiesPath, ""); err != nil {
+		return nil, err
+	}
+
+	capRespBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body from volume plugin %s: %w", p.Name, err)
+	}
+
+	capResp := new(volume.CapabilitiesResponse)
+	if err := json.Unmarshal(capRespBytes, capResp); err != nil {
+		return nil, fmt.Errorf("unmarshalling volume plugin %s capabilities response: %w", p.Name, err)
+	}
+	if capResp.Capabilities.Scope == "" {
+		capResp.Capabilities.Scope = defaultVolumeScope
+	}
+
+	return &capResp.Capabilities, nil
+}
+
 // CreateVolume creates a volume in the plugin.
 func (p *VolumePlugin) CreateVolume(req *volume.CreateRequest) error {
 	if req == nil {
@@ -95,6 +95,10 @@ type PodConfig struct {
 
 	// ResourceLimits hold the pod level resource limits
 	ResourceLimits specs.LinuxResources
+
+	// IdempotencyKey is the key supplied with the request that created the
+	// pod, if any
+	IdempotencyKey *define.IdempotencyKey `json:"idempotencyKey,omitempty"`
 }
 
 // podState represents a pod's state
@@ -6,14 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/config"
 	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/podman/v6/pkg/domain/entities"
 	"go.podman.io/podman/v6/pkg/parallel"
 )
 
@@ -236,7 +241,8 @@ func (p *Pod) stopIfOnlyInfraRemains(ctx context.Context, ignoreID string) error
 	}
 
 	for _, ctr := range allCtrs {
-		if ctr.ID() == infraID || ctr.ID() == ignoreID {
+		// Sidecars do not keep the pod running on their own.
+		if ctr.ID() == infraID || ctr.ID() == ignoreID || ctr.IsSidecar() {
 			continue
 		}
 
@@ -554,6 +560,516 @@ func (p *Pod) Kill(ctx context.Context, signal uint) (map[string]error, error) {
 	return nil, nil
 }
 
+// Update updates the configuration of the pod.
+// The pod cgroup resource limits, the restart policy of the pod and its
+// member containers, and the pod labels can be updated. At least one of them
+// must be set in updateOptions.
+// If restartRetries is not nil, restartPolicy must be set and must be
+// "on-failure".
+// Resource limits are merged into the existing limits of the pod and applied
+// to the pod cgroup immediately; a pod without its own cgroup cannot have its
+// resources updated.
+func (p *Pod) Update(ctx context.Context, updateOptions *entities.PodUpdateOptions) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+
+	if err := p.updatePod(); err != nil {
+		return err
+	}
+
+	if updateOptions.Resources == nil && updateOptions.RestartPolicy == nil && len(updateOptions.Labels) == 0 && len(updateOptions.UnsetLabels) == 0 {
+		return fmt.Errorf("must provide at least one of resources, restartPolicy and labels to update a pod: %w", define.ErrInvalidArg)
+	}
+	if updateOptions.RestartRetries != nil && updateOptions.RestartPolicy == nil {
+		return fmt.Errorf("must provide restart policy if updating restart retries: %w", define.ErrInvalidArg)
+	}
+
+	newConfig := new(PodConfig)
+	if err := JSONDeepCopy(p.config, newConfig); err != nil {
+		return err
+	}
+
+	if updateOptions.RestartPolicy != nil {
+		if err := define.ValidateRestartPolicy(*updateOptions.RestartPolicy); err != nil {
+			return err
+		}
+		if updateOptions.RestartRetries != nil && *updateOptions.RestartPolicy != define.RestartPolicyOnFailure {
+			return fmt.Errorf("cannot set restart policy retries unless policy is on-failure: %w", define.ErrInvalidArg)
+		}
+		newConfig.RestartPolicy = *updateOptions.RestartPolicy
+		newConfig.RestartRetries = updateOptions.RestartRetries
+	}
+
+	if updateOptions.Resources != nil {
+		if !p.config.UsePodCgroup || p.state.CgroupPath == "" {
+			return fmt.Errorf("pod %s does not have its own cgroup, cannot update resources: %w", p.ID(), define.ErrInvalidArg)
+		}
+		resourcesToUpdate, err := json.Marshal(updateOptions.Resources)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(resourcesToUpdate, &newConfig.ResourceLimits); err != nil {
+			return err
+		}
+	}
+
+	if len(updateOptions.Labels) > 0 || len(updateOptions.UnsetLabels) > 0 {
+		if newConfig.Labels == nil {
+			newConfig.Labels = make(map[string]string)
+		}
+		maps.Copy(newConfig.Labels, updateOptions.Labels)
+		for _, label := range updateOptions.UnsetLabels {
+			delete(newConfig.Labels, label)
+		}
+	}
+
+	// Apply the new limits before saving them, so the database never
+	// describes limits which are not in effect.
+	if updateOptions.Resources != nil {
+		if err := p.updatePodCgroup(&newConfig.ResourceLimits); err != nil {
+			p.restoreCgroupAfterUpdate(&newConfig.ResourceLimits)
+			return fmt.Errorf("updating cgroup of pod %s: %w", p.ID(), err)
+		}
+	}
+
+	if err := p.runtime.state.RewritePodConfig(p, newConfig); err != nil {
+		if updateOptions.Resources != nil {
+			p.restoreCgroupAfterUpdate(&newConfig.ResourceLimits)
+		}
+		return err
+	}
+	oldConfig := p.config
+	p.config = newConfig
+
+	if updateOptions.RestartPolicy != nil {
+		if err := p.updateMembersRestartPolicy(updateOptions); err != nil {
+			// Undo the whole update, so the pod is left as it was
+			if rbErr := p.runtime.state.RewritePodConfig(p, oldConfig); rbErr != nil {
+				logrus.Errorf("Restoring configuration of pod %s: %v", p.ID(), rbErr)
+			} else {
+				p.config = oldConfig
+			}
+			if updateOptions.Resources != nil {
+				p.restoreCgroupAfterUpdate(&newConfig.ResourceLimits)
+			}
+			return err
+		}
+	}
+
+	p.newPodEvent(events.Update)
+	logrus.Debugf("updated pod %s", p.ID())
+	return nil
+}
+
+// restoreCgroupAfterUpdate restores the cgroup limits in the current pod
+// config after a failed update to updated.  Errors are only logged, as the
+// update has failed already.
+func (p *Pod) restoreCgroupAfterUpdate(updated *specs.LinuxResources) {
+	if err := p.restorePodCgroup(&p.config.ResourceLimits, updated); err != nil {
+		logrus.Errorf("Restoring cgroup limits of pod %s: %v", p.ID(), err)
+	}
+}
+
+// memberRestartPolicy is the restart policy a pod member had before an update.
+type memberRestartPolicy struct {
+	ctr     *Container
+	policy  string
+	retries uint
+}
+
+// updateMembersRestartPolicy applies the restart policy of the update to all
+// containers in the pod except init containers.  If a container cannot be
+// updated, the containers updated before it get their previous restart
+// policy back.
+func (p *Pod) updateMembersRestartPolicy(updateOptions *entities.PodUpdateOptions) error {
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return err
+	}
+	var updated []memberRestartPolicy
+	for _, ctr := range allCtrs {
+		// Init containers never follow the pod restart policy.
+		if ctr.IsInitCtr() {
+			continue
+		}
+		oldPolicy := ctr.RestartPolicy()
+		oldRetries := ctr.RestartRetries()
+		ctrUpdate := &entities.ContainerUpdateOptions{
+			RestartPolicy:                   updateOptions.RestartPolicy,
+			RestartRetries:                  updateOptions.RestartRetries,
+			ChangedHealthCheckConfiguration: &define.UpdateHealthCheckConfig{},
+		}
+		if err := ctr.Update(ctrUpdate); err != nil {
+			for i := len(updated) - 1; i >= 0; i-- {
+				prev := updated[i]
+				if rbErr := prev.ctr.Update(&entities.ContainerUpdateOptions{
+					RestartPolicy:                   &prev.policy,
+					RestartRetries:                  &prev.retries,
+					ChangedHealthCheckConfiguration: &define.UpdateHealthCheckConfig{},
+				}); rbErr != nil {
+					logrus.Errorf("Restoring restart policy of container %s: %v", prev.ctr.ID(), rbErr)
+				}
+			}
+			return fmt.Errorf("updating restart policy of container %s: %w", ctr.ID(), err)
+		}
+		updated = append(updated, memberRestartPolicy{ctr: ctr, policy: oldPolicy, retries: oldRetries})
+	}
+	return nil
+}
+
+// Checkpoint checkpoints all running containers in the pod, except the infra
+// container, which is left running so the pod keeps its namespaces.
+// All members are frozen before the first container is dumped, are dumped
+// while frozen and are only thawed once all of them have been dumped, so the
+// checkpoints of the members are taken at the same instant.
+// If exportDir is set, the checkpoint of every container is exported to
+// <exportDir>/<container ID>.tar instead of being kept locally.
+// An error and a map[string]error are returned, following the semantics of
+// Start.
+func (p *Pod) Checkpoint(ctx context.Context, options ContainerCheckpointOptions, exportDir string) (map[string]error, error) {
+	if options.PreCheckPoint || options.WithPrevious || options.CreateImage != "" {
+		return nil, fmt.Errorf("pre-checkpoints and checkpoint images are not supported for pods: %w", define.ErrInvalidArg)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return nil, define.ErrPodRemoved
+	}
+
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrs := make([]*Container, 0, len(allCtrs))
+	for _, ctr := range allCtrs {
+		if ctr.IsInfra() {
+			continue
+		}
+		ctr.lock.Lock()
+		defer ctr.lock.Unlock()
+
+		if err := ctr.syncContainer(); err != nil {
+			return nil, err
+		}
+		if ctr.state.State != define.ContainerStateRunning {
+			continue
+		}
+		if len(ctr.config.Dependencies) > 0 && exportDir != "" {
+			return nil, fmt.Errorf("cannot export checkpoints of containers with dependencies: %s: %w", ctr.ID(), define.ErrInvalidArg)
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	if len(ctrs) == 0 {
+		return nil, fmt.Errorf("pod %s has no running containers to checkpoint: %w", p.ID(), define.ErrCtrStateInvalid)
+	}
+
+	// Whatever happens, do not leave members frozen behind.
+	defer func() {
+		for _, ctr := range ctrs {
+			if ctr.state.State != define.ContainerStatePaused {
+				continue
+			}
+			if err := ctr.unpause(); err != nil {
+				logrus.Errorf("Thawing container %s after pod checkpoint: %v", ctr.ID(), err)
+			}
+		}
+	}()
+
+	for _, ctr := range ctrs {
+		if err := ctr.pause(); err != nil {
+			return nil, fmt.Errorf("freezing container %s for pod checkpoint: %w", ctr.ID(), err)
+		}
+	}
+
+	ctrErrors := make(map[string]error)
+	for _, ctr := range ctrs {
+		ctrOptions := options
+		ctrOptions.keepFrozen = true
+		if exportDir != "" {
+			ctrOptions.TargetFile = filepath.Join(exportDir, ctr.ID()+".tar")
+			if err := ctr.prepareCheckpointExport(); err != nil {
+				ctrErrors[ctr.ID()] = err
+				continue
+			}
+		}
+		if _, _, err := ctr.checkpoint(ctx, ctrOptions); err != nil {
+			ctrErrors[ctr.ID()] = err
+		}
+	}
+
+	p.newPodEvent(events.Checkpoint)
+
+	if len(ctrErrors) > 0 {
+		return ctrErrors, fmt.Errorf("checkpointing some containers: %w", define.ErrPodPartialFail)
+	}
+	return nil, nil
+}
+
+// Restore restores all checkpointed containers in the pod. The infra
+// container is started first if it is not running, so the restored containers
+// join the namespaces of the pod.
+// An error and a map[string]error are returned, following the semantics of
+// Start.
+func (p *Pod) Restore(ctx context.Context, options ContainerCheckpointOptions) (map[string]error, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return nil, define.ErrPodRemoved
+	}
+
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.HasInfraContainer() {
+		infra, err := p.infraContainer()
+		if err != nil {
+			return nil, err
+		}
+		state, err := infra.State()
+		if err != nil {
+			return nil, err
+		}
+		if state != define.ContainerStateRunning {
+			// The pod lock is held already
+			if err := infra.startNoPodLock(ctx, false); err != nil {
+				return nil, fmt.Errorf("starting infra container of pod %s: %w", p.ID(), err)
+			}
+		}
+	}
+
+	ctrErrors := make(map[string]error)
+	restored := 0
+	for _, ctr := range allCtrs {
+		if ctr.IsInfra() {
+			continue
+		}
+		ctr.lock.Lock()
+		err := ctr.syncContainer()
+		checkpointed := ctr.state.Checkpointed
+		ctr.lock.Unlock()
+		if err != nil {
+			ctrErrors[ctr.ID()] = err
+			continue
+		}
+		if !checkpointed {
+			continue
+		}
+		restored++
+		if _, _, err := ctr.Restore(ctx, options); err != nil {
+			ctrErrors[ctr.ID()] = err
+		}
+	}
+	if restored == 0 && len(ctrErrors) == 0 {
+		return nil, fmt.Errorf("pod %s has no checkpointed containers to restore: %w", p.ID(), define.ErrCtrStateInvalid)
+	}
+
+	p.newPodEvent(events.Restore)
+
+	if len(ctrErrors) > 0 {
+		return ctrErrors, fmt.Errorf("restoring some containers: %w", define.ErrPodPartialFail)
+	}
+	return nil, nil
+}
+
+// PodWaitOptions are the options for waiting on the containers of a pod.
+type PodWaitOptions struct {
+	// Conditions the containers are waited for. Defaults to "exited".
+	Conditions []string
+	// Interval at which the state of the containers is polled.
+	Interval time.Duration
+	// Any returns as soon as one container meets a condition instead of
+	// waiting for all of them.
+	Any bool
+	// ExitCodePropagation determines how the exit codes of the containers
+	// are aggregated. If unset, the exit-code propagation of the pod's
+	// service container is used, if any, and "any" otherwise.
+	ExitCodePropagation define.KubeExitCodePropagation
+}
+
+// Wait blocks until the containers of the pod, except for the infra and init
+// containers, meet one of the given conditions. For health conditions,
+// containers without a health check are considered to be healthy once they
+// run.
+// When waiting for the containers to exit, the aggregated exit code of the
+// containers is returned following the exit-code propagation: 0 for "none",
+// the first non-zero exit code for "any", and the last exit code if all
+// containers failed for "all". If the exit policy of the pod is "stop", Wait
+// also waits for the infra container to exit, so the pod is stopped on return.
+// Otherwise -1 is returned as exit code.
+func (p *Pod) Wait(ctx context.Context, options PodWaitOptions) (int32, error) {
+	conditions := options.Conditions
+	if len(conditions) == 0 {
+		conditions = []string{define.ContainerStateExited.String()}
+	}
+	waitForExit := false
+	for _, condition := range conditions {
+		if condition == define.ContainerStateExited.String() || condition == define.ContainerStateStopped.String() {
+			waitForExit = true
+		}
+	}
+	interval := options.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	p.lock.Lock()
+	if !p.valid {
+		p.lock.Unlock()
+		return -1, define.ErrPodRemoved
+	}
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		p.lock.Unlock()
+		return -1, err
+	}
+	ecp := options.ExitCodePropagation
+	if ecp == define.KubeExitCodePropagationInvalid {
+		ecp = define.KubeExitCodePropagationAny
+		if p.hasServiceContainer() {
+			if serviceCtr, err := p.serviceContainer(); err == nil {
+				ecp = serviceCtr.config.KubeExitCodePropagation
+			}
+		}
+	}
+	var infra *Container
+	if waitForExit && !options.Any && p.config.ExitPolicy == config.PodExitPolicyStop && p.HasInfraContainer() {
+		infra, err = p.infraContainer()
+		if err != nil {
+			p.lock.Unlock()
+			return -1, err
+		}
+	}
+	p.lock.Unlock()
+
+	ctrs := make([]*Container, 0, len(allCtrs))
+	for _, ctr := range allCtrs {
+		if ctr.IsInfra() || ctr.IsInitCtr() || ctr.IsSidecar() {
+			continue
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	if len(ctrs) == 0 {
+		return -1, fmt.Errorf("pod %s has no containers to wait for: %w", p.ID(), define.ErrNoSuchCtr)
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	resultChan := make(chan waitResult, len(ctrs))
+	for _, ctr := range ctrs {
+		ctrConditions := conditions
+		if !ctr.HasHealthCheck() {
+			ctrConditions = withoutHealthConditions(conditions)
+		}
+		go func() {
+			code, err := ctr.WaitForConditionWithInterval(ctx, interval, ctrConditions...)
+			if err != nil {
+				err = fmt.Errorf("waiting for container %s: %w", ctr.ID(), err)
+			}
+			resultChan <- waitResult{code, err}
+		}()
+	}
+
+	codes := make([]int32, 0, len(ctrs))
+	var errs []error
+	for range ctrs {
+		result := <-resultChan
+		if result.err != nil {
+			if !options.Any {
+				return -1, result.err
+			}
+			errs = append(errs, result.err)
+			continue
+		}
+		codes = append(codes, result.code)
+		if options.Any {
+			break
+		}
+	}
+	if len(codes) == 0 {
+		return -1, errors.Join(errs...)
+	}
+
+	if infra != nil {
+		if _, err := infra.WaitForExit(ctx, interval); err != nil && !errors.Is(err, define.ErrCtrRemoved) {
+			return -1, fmt.Errorf("waiting for infra container of pod %s: %w", p.ID(), err)
+		}
+	}
+
+	if !waitForExit {
+		return -1, nil
+	}
+	return aggregateExitCodes(codes, ecp), nil
+}
+
+// withoutHealthConditions replaces the health conditions in the given list by
+// "running", for containers which have no health check.
+func withoutHealthConditions(conditions []string) []string {
+	filtered := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		switch condition {
+		case define.HealthCheckHealthy:
+			filtered = append(filtered, define.ContainerStateRunning.String())
+		case define.HealthCheckUnhealthy:
+			// A container without health check never becomes unhealthy.
+		default:
+			filtered = append(filtered, condition)
+		}
+	}
+	if len(filtered) == 0 {
+		// Only wait for the container to exit, so it does not block forever.
+		filtered = append(filtered, define.ContainerStateExited.String())
+	}
+	return filtered
+}
+
+// aggregateExitCodes aggregates the exit codes of the containers of a pod, in
+// the order they met their condition, following the exit-code propagation.
+// Containers which met a condition other than exiting report -1 and are
+// ignored.  If no container exited, -1 is returned.
+func aggregateExitCodes(codes []int32, ecp define.KubeExitCodePropagation) int32 {
+	var firstFailed, lastFailed int32
+	failed, exited := 0, 0
+	for _, code := range codes {
+		if code < 0 {
+			continue
+		}
+		exited++
+		if code == 0 {
+			continue
+		}
+		if failed == 0 {
+			firstFailed = code
+		}
+		lastFailed = code
+		failed++
+	}
+	if exited == 0 {
+		return -1
+	}
+	switch ecp {
+	case define.KubeExitCodePropagationAny:
+		return firstFailed
+	case define.KubeExitCodePropagationAll:
+		if failed == exited {
+			return lastFailed
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
 // Status gets the status of all containers in the pod.
 // Returns a map of Container ID to Container Status.
 func (p *Pod) Status() (map[string]define.ContainerStatus, error) {
@@ -750,3 +1266,49 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 
 	return &inspectData, nil
 }
+
+// Events reads the events of the pod and of all containers that are or ever
+// were a member of it, including containers that have been removed since.
+// The filters of the read options are applied on top of that. Like
+// Runtime.Events, it returns once reading has started and closes the event
+// channel of the options when done.
+func (p *Pod) Events(ctx context.Context, options events.ReadOptions) error {
+	members, err := func() ([]string, error) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		if !p.valid {
+			return nil, define.ErrPodRemoved
+		}
+		return p.runtime.state.PodContainersByID(p)
+	}()
+	if err != nil {
+		return err
+	}
+
+	matcher := newPodEventMatcher(p.ID(), members)
+	eventChannel := options.EventChannel
+	podEventChannel := make(chan events.ReadResult)
+	options.EventChannel = podEventChannel
+	if err := p.runtime.eventer.Read(ctx, options); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(eventChannel)
+		for evt := range podEventChannel {
+			if evt.Error == nil && !matcher.matches(evt.Event) {
+				continue
+			}
+			select {
+			case eventChannel <- evt:
+			case <-ctx.Done():
+				// Let the reader run to completion.
+				for range podEventChannel {
+				}
+				return
+			}
+		}
+	}()
+	return nil
+}
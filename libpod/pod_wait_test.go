@@ -0,0 +1,79 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+func Test_aggregateExitCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		codes []int32
+		ecp   define.KubeExitCodePropagation
+		want  int32
+	}{
+		{
+			name:  "NoneIgnoresFailures",
+			codes: []int32{0, 1, 2},
+			ecp:   define.KubeExitCodePropagationNone,
+			want:  0,
+		},
+		{
+			name:  "AnyAllSucceeded",
+			codes: []int32{0, 0},
+			ecp:   define.KubeExitCodePropagationAny,
+			want:  0,
+		},
+		{
+			name:  "AnyReturnsFirstFailure",
+			codes: []int32{0, 3, 4},
+			ecp:   define.KubeExitCodePropagationAny,
+			want:  3,
+		},
+		{
+			name:  "AllSomeFailed",
+			codes: []int32{0, 3, 4},
+			ecp:   define.KubeExitCodePropagationAll,
+			want:  0,
+		},
+		{
+			name:  "AllFailedReturnsLastFailure",
+			codes: []int32{3, 4},
+			ecp:   define.KubeExitCodePropagationAll,
+			want:  4,
+		},
+		{
+			name:  "AnyIgnoresRunning",
+			codes: []int32{-1, 0, -1},
+			ecp:   define.KubeExitCodePropagationAny,
+			want:  0,
+		},
+		{
+			name:  "AllIgnoresRunning",
+			codes: []int32{-1, 3, 4},
+			ecp:   define.KubeExitCodePropagationAll,
+			want:  4,
+		},
+		{
+			name:  "NoneExited",
+			codes: []int32{-1, -1},
+			ecp:   define.KubeExitCodePropagationAll,
+			want:  -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, aggregateExitCodes(tt.codes, tt.ecp))
+		})
+	}
+}
+
+func Test_withoutHealthConditions(t *testing.T) {
+	assert.Equal(t, []string{"running"}, withoutHealthConditions([]string{define.HealthCheckHealthy}))
+	assert.Equal(t, []string{"exited"}, withoutHealthConditions([]string{define.HealthCheckUnhealthy}))
+	assert.Equal(t, []string{"exited", "running"}, withoutHealthConditions([]string{"exited", define.HealthCheckHealthy}))
+}
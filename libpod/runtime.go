@@ -14,6 +14,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	jsoniter "github.com/json-iterator/go"
@@ -115,6 +116,13 @@ type Runtime struct {
 	// with an existing Bolt database otherwise.
 	noBoltError bool
 
+	// tombstoneMaxCount is the number of removed containers whose
+	// tombstones are kept. Zero disables tombstones.
+	tombstoneMaxCount int
+	// tombstoneMaxAge is how long tombstones are kept after their
+	// container was removed. Zero keeps them regardless of age.
+	tombstoneMaxAge time.Duration
+
 	// valid indicates whether the runtime is ready to use.
 	// valid is set to true when a runtime is returned from GetRuntime(),
 	// and remains true until the runtime is shut down (rendering its
@@ -188,6 +196,8 @@ func newRuntimeFromConfig(ctx context.Context, conf *config.Config, options ...R
 	}
 
 	runtime.config = conf
+	runtime.tombstoneMaxCount = define.DefaultTombstoneMaxCount
+	runtime.tombstoneMaxAge = define.DefaultTombstoneMaxAge
 
 	if err := SetXdgDirs(); err != nil {
 		return nil, err
@@ -267,7 +277,7 @@ func getLockManager(runtime *Runtime) (lock.Manager, error) {
 				// ERANGE indicates a lock numbering mismatch.
 				// Since we're renumbering, this is not fatal.
 				// Remove the earlier set of locks and recreate.
-				if err := os.Remove(filepath.Join("/dev/shm", lockPath)); err != nil {
+				if err := lock.RemoveSHMLocks(lockPath); err != nil {
 					return nil, fmt.Errorf("removing libpod locks file %s: %w", lockPath, err)
 				}
 
@@ -1247,18 +1257,15 @@ func (r *Runtime) SetRemoteURI(uri string) {
 }
 
 // Get information on potential lock conflicts.
-// Returns a map of lock number to object(s) using the lock, formatted as
-// "container <id>" or "volume <id>" or "pod <id>", and an array of locks that
-// are currently being held, formatted as []uint32.
-// If the map returned is not empty, you should immediately renumber locks on
-// the runtime, because you have a deadlock waiting to happen.
-func (r *Runtime) LockConflicts() (map[uint32][]string, []uint32, error) {
+// lockUsers returns a map of lock number to the object(s) using the lock,
+// formatted as "container <id>" or "volume <id>" or "pod <id>".
+func (r *Runtime) lockUsers() (map[uint32][]string, error) {
 	// Make an internal map to store what lock is associated with what
 	locksInUse := make(map[uint32][]string)
 
 	ctrs, err := r.state.AllContainers(false)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	for _, ctr := range ctrs {
 		lockNum := ctr.lock.ID()
@@ -1268,7 +1275,7 @@ func (r *Runtime) LockConflicts() (map[uint32][]string, []uint32, error) {
 
 	pods, err := r.state.AllPods()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	for _, pod := range pods {
 		lockNum := pod.lock.ID()
@@ -1278,7 +1285,7 @@ func (r *Runtime) LockConflicts() (map[uint32][]string, []uint32, error) {
 
 	volumes, err := r.state.AllVolumes()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	for _, vol := range volumes {
 		lockNum := vol.lock.ID()
@@ -1286,6 +1293,101 @@ func (r *Runtime) LockConflicts() (map[uint32][]string, []uint32, error) {
 		locksInUse[lockNum] = append(locksInUse[lockNum], volString)
 	}
 
+	return locksInUse, nil
+}
+
+// LockHolders returns the processes holding and waiting for locks, along with
+// the objects using each lock, and any lock-order cycles between them.
+// Each cycle is a list of lock numbers where every lock is held by a process
+// waiting for the next, and the last by a process waiting for the first.
+func (r *Runtime) LockHolders() ([]entities.LockHolderReport, [][]uint32, error) {
+	infos, err := r.lockManager.LockHolders()
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := r.lockUsers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reports := make([]entities.LockHolderReport, 0, len(infos))
+	for _, info := range infos {
+		report := entities.LockHolderReport{
+			LockID: info.ID,
+			Held:   info.Held,
+			Users:  users[info.ID],
+		}
+		if info.Holder != nil {
+			report.Holder = lockHolderReport(info.Holder)
+		}
+		for _, w := range info.Waiters {
+			report.Waiters = append(report.Waiters, *lockHolderReport(&w))
+		}
+		reports = append(reports, report)
+	}
+	return reports, lock.LockCycles(infos), nil
+}
+
+// RecordLockHolders enables or disables recording the processes holding and
+// waiting for locks. It applies to the processes started afterwards, and lasts
+// until the locks are lost on reboot.
+func (r *Runtime) RecordLockHolders(enable bool) error {
+	return r.lockManager.RecordHolders(enable)
+}
+
+// RecordsLockHolders returns whether the processes holding and waiting for
+// locks are recorded.
+func (r *Runtime) RecordsLockHolders() bool {
+	return r.lockManager.RecordsHolders()
+}
+
+func lockHolderReport(holder *lock.LockHolder) *entities.LockHolder {
+	return &entities.LockHolder{
+		PID:     holder.PID,
+		Command: holder.Command,
+		Since:   holder.Since,
+		Alive:   holder.Alive(),
+	}
+}
+
+// BreakLocks releases every lock whose recorded holder has exited, and
+// returns the numbers of the locks released.
+// Locks held by running processes are left alone.
+func (r *Runtime) BreakLocks() ([]uint32, error) {
+	infos, err := r.lockManager.LockHolders()
+	if err != nil {
+		return nil, err
+	}
+
+	var broken []uint32
+	for _, info := range infos {
+		if info.Holder == nil || info.Holder.Alive() {
+			continue
+		}
+		if err := r.lockManager.BreakLock(info.ID); err != nil {
+			if errors.Is(err, define.ErrLockHolderAlive) {
+				logrus.Warnf("Not breaking lock: %v", err)
+				continue
+			}
+			return broken, fmt.Errorf("breaking lock %d: %w", info.ID, err)
+		}
+		logrus.Infof("Broke lock %d held by exited process %d (%s)", info.ID, info.Holder.PID, info.Holder.Command)
+		broken = append(broken, info.ID)
+	}
+	return broken, nil
+}
+
+// Returns a map of lock number to object(s) using the lock, formatted as
+// "container <id>" or "volume <id>" or "pod <id>", and an array of locks that
+// are currently being held, formatted as []uint32.
+// If the map returned is not empty, you should immediately renumber locks on
+// the runtime, because you have a deadlock waiting to happen.
+func (r *Runtime) LockConflicts() (map[uint32][]string, []uint32, error) {
+	locksInUse, err := r.lockUsers()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Now go through and find any entries with >1 item associated
 	toReturn := make(map[uint32][]string)
 	for lockNum, objects := range locksInUse {
@@ -1323,6 +1425,16 @@ func containerGoneErr(err error) bool {
 //
 // Note: This is not safe operation and should be executed only when no builds are in progress. It can interfere with builds in progress.
 func (r *Runtime) PruneBuildContainers() ([]*reports.PruneReport, error) {
+	return r.buildContainers(true)
+}
+
+// BuildContainers lists the build containers PruneBuildContainers would
+// remove, along with their sizes, without removing them.
+func (r *Runtime) BuildContainers() ([]*reports.PruneReport, error) {
+	return r.buildContainers(false)
+}
+
+func (r *Runtime) buildContainers(remove bool) ([]*reports.PruneReport, error) {
 	stageContainersPruneReports := []*reports.PruneReport{}
 
 	containers, err := r.store.Containers()
@@ -1357,6 +1469,10 @@ func (r *Runtime) PruneBuildContainers() ([]*reports.PruneReport, error) {
 		}
 		report.Size = uint64(size)
 
+		if !remove {
+			stageContainersPruneReports = append(stageContainersPruneReports, report)
+			continue
+		}
 		if err := r.store.DeleteContainer(container.ID); err != nil {
 			// Pruning wants the container gone. If something else removed it
 			// first that is the result we wanted, so do not report it.
@@ -1370,9 +1486,25 @@ func (r *Runtime) PruneBuildContainers() ([]*reports.PruneReport, error) {
 	return stageContainersPruneReports, nil
 }
 
-// SystemCheck checks our storage for consistency, and depending on the options
-// specified, will attempt to remove anything which fails consistency checks.
-func (r *Runtime) SystemCheck(_ context.Context, options entities.SystemCheckOptions) (entities.SystemCheckReport, error) {
+// SystemCheck checks our storage and database for consistency, and depending
+// on the options specified, will attempt to repair or remove anything which
+// fails consistency checks.
+func (r *Runtime) SystemCheck(ctx context.Context, options entities.SystemCheckOptions) (entities.SystemCheckReport, error) {
+	report, storageErr := r.checkStorage(options)
+
+	issues, err := r.checkState(ctx, options)
+	report.Libpod = issues
+	if len(issues) > 0 {
+		report.Errors = true
+	}
+
+	return report, errors.Join(storageErr, err)
+}
+
+// checkStorage checks containers/storage for consistency, and depending on the
+// options specified, will attempt to remove anything which fails consistency
+// checks.
+func (r *Runtime) checkStorage(options entities.SystemCheckOptions) (entities.SystemCheckReport, error) {
 	what := storage.CheckEverything()
 	if options.Quick {
 		// Turn off checking layer digests and layer contents to do quick check.
@@ -0,0 +1,428 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/lock"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/storage"
+	"go.podman.io/storage/pkg/fileutils"
+	"go.podman.io/storage/pkg/idtools"
+)
+
+// Identifiers of the checks run on the libpod database by SystemCheck.
+const (
+	checkContainerStorage   = "container-storage-missing"
+	checkDanglingDependency = "dangling-dependency"
+	checkDeadExecSession    = "exec-session-dead"
+	checkDuplicateLock      = "duplicate-lock"
+	checkMissingNetwork     = "network-missing"
+	checkPodInfra           = "pod-infra-missing"
+	checkVolumeMountpoint   = "volume-mountpoint-missing"
+)
+
+// Types of the objects checks report issues for.
+const (
+	checkTypeContainer = "container"
+	checkTypePod       = "pod"
+	checkTypeVolume    = "volume"
+)
+
+// stateChecker collects the inconsistencies found in the libpod database,
+// repairing them as it goes if asked to.
+type stateChecker struct {
+	repair      bool
+	repairLossy bool
+	issues      []entities.SystemCheckIssue
+}
+
+// found records an inconsistency. If repairs were requested, repair is called
+// to fix it; lossy repairs, which remove the object, are only made if lossy
+// repairs were requested.
+func (s *stateChecker) found(issue entities.SystemCheckIssue, repair func() error) {
+	logrus.Debugf("System check: %s %s: %s", issue.Type, issue.ID, issue.Message)
+	if repair != nil && (s.repair || s.repairLossy) && (!issue.RepairLossy || s.repairLossy) {
+		if err := repair(); err != nil {
+			issue.RepairError = err.Error()
+		} else {
+			issue.Repaired = true
+		}
+	}
+	s.issues = append(s.issues, issue)
+}
+
+// checkState checks the libpod database for objects that are inconsistent
+// with each other or with the system, and depending on the options, repairs
+// them.
+func (r *Runtime) checkState(ctx context.Context, options entities.SystemCheckOptions) ([]entities.SystemCheckIssue, error) {
+	s := &stateChecker{repair: options.Repair, repairLossy: options.RepairLossy}
+
+	// Locks are checked first, so objects removed by later repairs are
+	// not given new locks.
+	if err := r.checkDuplicateLocks(s); err != nil {
+		return s.issues, err
+	}
+
+	ctrs, err := r.state.AllContainers(true)
+	if err != nil {
+		return s.issues, err
+	}
+	ctrIDs := make(map[string]bool, len(ctrs))
+	for _, ctr := range ctrs {
+		ctrIDs[ctr.ID()] = true
+	}
+	exists := func(id string) bool {
+		if ctrIDs[id] {
+			return true
+		}
+		ok, _ := r.state.HasContainer(id)
+		return ok
+	}
+
+	for _, ctr := range ctrs {
+		removed, err := r.checkContainer(ctx, s, ctr, exists)
+		if err != nil {
+			return s.issues, err
+		}
+		if removed {
+			delete(ctrIDs, ctr.ID())
+		}
+	}
+
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return s.issues, err
+	}
+	for _, pod := range pods {
+		if err := r.checkPod(s, pod, exists); err != nil {
+			return s.issues, err
+		}
+	}
+
+	volumes, err := r.state.AllVolumes()
+	if err != nil {
+		return s.issues, err
+	}
+	for _, vol := range volumes {
+		r.checkVolume(s, vol)
+	}
+
+	return s.issues, nil
+}
+
+// checkDuplicateLocks finds objects sharing a lock, and gives all but the
+// first of them a new lock.
+func (r *Runtime) checkDuplicateLocks(s *stateChecker) error {
+	type lockUser struct {
+		issue   entities.SystemCheckIssue
+		realloc func(lock lock.Locker) error
+	}
+	users := make(map[uint32][]lockUser)
+	var lockIDs []uint32
+	add := func(id uint32, user lockUser) {
+		if _, ok := users[id]; !ok {
+			lockIDs = append(lockIDs, id)
+		}
+		users[id] = append(users[id], user)
+	}
+
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		add(ctr.config.LockID, lockUser{
+			issue: entities.SystemCheckIssue{Type: checkTypeContainer, ID: ctr.ID(), Name: ctr.Name()},
+			realloc: func(l lock.Locker) error {
+				ctr.config.LockID = l.ID()
+				ctr.lock = l
+				return r.state.RewriteContainerConfig(ctr, ctr.config)
+			},
+		})
+	}
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		add(pod.config.LockID, lockUser{
+			issue: entities.SystemCheckIssue{Type: checkTypePod, ID: pod.ID(), Name: pod.Name()},
+			realloc: func(l lock.Locker) error {
+				pod.config.LockID = l.ID()
+				pod.lock = l
+				return r.state.RewritePodConfig(pod, pod.config)
+			},
+		})
+	}
+	volumes, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range volumes {
+		add(vol.config.LockID, lockUser{
+			issue: entities.SystemCheckIssue{Type: checkTypeVolume, ID: vol.Name(), Name: vol.Name()},
+			realloc: func(l lock.Locker) error {
+				vol.config.LockID = l.ID()
+				vol.lock = l
+				return r.state.RewriteVolumeConfig(vol, vol.config)
+			},
+		})
+	}
+
+	slices.Sort(lockIDs)
+	for _, id := range lockIDs {
+		lockUsers := users[id]
+		if len(lockUsers) < 2 {
+			continue
+		}
+		first := lockUsers[0].issue
+		for _, user := range lockUsers[1:] {
+			issue := user.issue
+			issue.Check = checkDuplicateLock
+			issue.Message = fmt.Sprintf("lock %d is also used by %s %s", id, first.Type, first.ID)
+			s.found(issue, func() error {
+				l, err := r.lockManager.AllocateLock()
+				if err != nil {
+					return fmt.Errorf("allocating lock: %w", err)
+				}
+				if err := user.realloc(l); err != nil {
+					if err2 := l.Free(); err2 != nil {
+						logrus.Errorf("Freeing lock %d: %v", l.ID(), err2)
+					}
+					return err
+				}
+				return nil
+			})
+		}
+	}
+	return nil
+}
+
+// checkContainer checks a container, and returns whether it was removed as a
+// repair.
+func (r *Runtime) checkContainer(ctx context.Context, s *stateChecker, ctr *Container, exists func(id string) bool) (bool, error) {
+	issue := func(check, format string, args ...any) entities.SystemCheckIssue {
+		return entities.SystemCheckIssue{
+			Check:   check,
+			Type:    checkTypeContainer,
+			ID:      ctr.ID(),
+			Name:    ctr.Name(),
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+	removed := false
+	evict := func() error {
+		if _, err := r.evictContainer(ctx, ctr.ID(), false); err != nil {
+			if ok, _ := r.state.HasContainer(ctr.ID()); ok {
+				return err
+			}
+			logrus.Debugf("Removing container %s: %v", ctr.ID(), err)
+		}
+		removed = true
+		return nil
+	}
+
+	// Containers with a root filesystem of their own do not use
+	// containers/storage.
+	if ctr.config.Rootfs == "" {
+		if _, err := r.store.Container(ctr.ID()); err != nil {
+			if !errors.Is(err, storage.ErrContainerUnknown) {
+				return false, fmt.Errorf("looking up storage of container %s: %w", ctr.ID(), err)
+			}
+			missing := issue(checkContainerStorage, "the container's storage has been removed")
+			missing.RepairLossy = true
+			s.found(missing, evict)
+			if removed {
+				return true, nil
+			}
+		}
+	}
+
+	deps := ctr.Dependencies()
+	slices.Sort(deps)
+	for _, dep := range deps {
+		if exists(dep) {
+			continue
+		}
+		dangling := issue(checkDanglingDependency, "the container depends on container %s, which does not exist", dep)
+		if slices.Contains(ctr.config.Dependencies, dep) && !ctr.namespaceDependency(dep) {
+			s.found(dangling, func() error {
+				ctr.config.Dependencies = slices.DeleteFunc(ctr.config.Dependencies, func(id string) bool {
+					return id == dep
+				})
+				return r.state.RewriteContainerConfig(ctr, ctr.config)
+			})
+			continue
+		}
+		// The container joins a namespace of the missing container,
+		// so it cannot run anymore.
+		dangling.Message = fmt.Sprintf("the container joins a namespace of container %s, which does not exist", dep)
+		dangling.RepairLossy = true
+		s.found(dangling, evict)
+		if removed {
+			return true, nil
+		}
+	}
+
+	sessionIDs := make([]string, 0, len(ctr.state.ExecSessions))
+	for id, session := range ctr.state.ExecSessions {
+		if session.State == define.ExecStateRunning {
+			sessionIDs = append(sessionIDs, id)
+		}
+	}
+	slices.Sort(sessionIDs)
+	for _, id := range sessionIDs {
+		alive, err := ctr.ociRuntime.ExecUpdateStatus(ctr, id)
+		if err != nil {
+			logrus.Debugf("Checking container %s exec session %s: %v", ctr.ID(), id, err)
+			continue
+		}
+		if alive {
+			continue
+		}
+		s.found(issue(checkDeadExecSession, "exec session %s is running according to the database, but its process %d has exited", id, ctr.state.ExecSessions[id].PID), func() error {
+			ctr.lock.Lock()
+			defer ctr.lock.Unlock()
+			if err := ctr.syncContainer(); err != nil {
+				return err
+			}
+			// Reaps every session whose process has exited.
+			_, err := ctr.getActiveExecSessions()
+			return err
+		})
+	}
+
+	if r.network == nil {
+		return false, nil
+	}
+	networks, err := r.state.GetNetworks(ctr)
+	if err != nil {
+		return false, fmt.Errorf("retrieving networks of container %s: %w", ctr.ID(), err)
+	}
+	names := make([]string, 0, len(networks))
+	for _, network := range networks {
+		names = append(names, network.Name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if _, err := r.network.NetworkInspect(name); err == nil || !errors.Is(err, nettypes.ErrNoSuchNetwork) {
+			continue
+		}
+		s.found(issue(checkMissingNetwork, "the container is connected to network %s, which does not exist", name), func() error {
+			ctr.lock.Lock()
+			defer ctr.lock.Unlock()
+			if err := ctr.syncContainer(); err != nil {
+				return err
+			}
+			if ctr.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+				return fmt.Errorf("the container is running, stop it to disconnect it from network %s: %w", name, define.ErrCtrStateInvalid)
+			}
+			return r.state.NetworkDisconnect(ctr, name)
+		})
+	}
+
+	return false, nil
+}
+
+// namespaceDependency returns whether the container joins a namespace of the
+// given container.
+func (c *Container) namespaceDependency(id string) bool {
+	return slices.Contains([]string{
+		c.config.IPCNsCtr,
+		c.config.MountNsCtr,
+		c.config.NetNsCtr,
+		c.config.PIDNsCtr,
+		c.config.UserNsCtr,
+		c.config.UTSNsCtr,
+		c.config.CgroupNsCtr,
+	}, id)
+}
+
+// checkPod checks that the infra container of a pod exists.
+func (r *Runtime) checkPod(s *stateChecker, pod *Pod, exists func(id string) bool) error {
+	if err := r.state.UpdatePod(pod); err != nil {
+		if errors.Is(err, define.ErrNoSuchPod) {
+			return nil
+		}
+		return fmt.Errorf("retrieving state of pod %s: %w", pod.ID(), err)
+	}
+	infraID := pod.state.InfraContainerID
+	if infraID == "" || exists(infraID) {
+		return nil
+	}
+
+	s.found(entities.SystemCheckIssue{
+		Check:   checkPodInfra,
+		Type:    checkTypePod,
+		ID:      pod.ID(),
+		Name:    pod.Name(),
+		Message: fmt.Sprintf("the pod's infra container %s does not exist", infraID),
+	}, func() error {
+		pod.lock.Lock()
+		defer pod.lock.Unlock()
+		if err := pod.updatePod(); err != nil {
+			return err
+		}
+		// The pod no longer has an infra container, so it must not
+		// expect one either
+		if pod.config.HasInfra {
+			pod.config.HasInfra = false
+			if err := r.state.RewritePodConfig(pod, pod.config); err != nil {
+				pod.config.HasInfra = true
+				return err
+			}
+		}
+		pod.state.InfraContainerID = ""
+		return pod.save()
+	})
+	return nil
+}
+
+// checkVolume checks that the mountpoint of a local volume exists.
+func (r *Runtime) checkVolume(s *stateChecker, vol *Volume) {
+	if vol.UsesVolumeDriver() || vol.config.Driver == define.VolumeDriverImage || vol.config.MountPoint == "" {
+		return
+	}
+	mountPoint := vol.config.MountPoint
+	if err := fileutils.Exists(mountPoint); !errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+
+	s.found(entities.SystemCheckIssue{
+		Check:   checkVolumeMountpoint,
+		Type:    checkTypeVolume,
+		ID:      vol.Name(),
+		Name:    vol.Name(),
+		Message: fmt.Sprintf("the volume's mountpoint %s does not exist", mountPoint),
+	}, func() error {
+		vol.lock.Lock()
+		defer vol.lock.Unlock()
+
+		// The contents of the volume are lost; recreate it empty.
+		volPathRoot := filepath.Dir(mountPoint)
+		if err := os.MkdirAll(volPathRoot, 0o700); err != nil {
+			return fmt.Errorf("creating volume directory %q: %w", volPathRoot, err)
+		}
+		if err := idtools.SafeChown(volPathRoot, vol.config.UID, vol.config.GID); err != nil {
+			return fmt.Errorf("chowning volume directory %q to %d:%d: %w", volPathRoot, vol.config.UID, vol.config.GID, err)
+		}
+		if err := os.MkdirAll(mountPoint, 0o755); err != nil {
+			return fmt.Errorf("creating volume directory %q: %w", mountPoint, err)
+		}
+		if err := idtools.SafeChown(mountPoint, vol.config.UID, vol.config.GID); err != nil {
+			return fmt.Errorf("chowning volume directory %q to %d:%d: %w", mountPoint, vol.config.UID, vol.config.GID, err)
+		}
+		return LabelVolumePath(mountPoint, vol.config.MountLabel)
+	})
+}
@@ -0,0 +1,336 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/podman/v6/libpod/lock"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/namespaces"
+	"go.podman.io/storage"
+)
+
+// checkOCIRuntime is an OCI runtime whose exec sessions are running unless
+// listed as exited.
+type checkOCIRuntime struct {
+	OCIRuntime
+	exited map[string]bool
+}
+
+func (r *checkOCIRuntime) ExecUpdateStatus(_ *Container, sessionID string) (bool, error) {
+	return !r.exited[sessionID], nil
+}
+
+func (r *checkOCIRuntime) ExitFilePath(ctr *Container) (string, error) {
+	return filepath.Join(ctr.config.StaticDir, "exit"), nil
+}
+
+// checkNetwork is a network backend that only has the given networks.
+type checkNetwork struct {
+	nettypes.ContainerNetwork
+	networks []string
+}
+
+func (n *checkNetwork) NetworkInspect(name string) (nettypes.Network, error) {
+	for _, network := range n.networks {
+		if network == name {
+			return nettypes.Network{Name: name}, nil
+		}
+	}
+	return nettypes.Network{}, nettypes.ErrNoSuchNetwork
+}
+
+// checkStore is a storage store without any containers.
+type checkStore struct {
+	storage.Store
+}
+
+func (s *checkStore) Container(_ string) (*storage.Container, error) {
+	return nil, storage.ErrContainerUnknown
+}
+
+func getCheckRuntime(t *testing.T) (*Runtime, *checkOCIRuntime) {
+	t.Helper()
+	lockManager, err := lock.NewInMemoryManager(16)
+	require.NoError(t, err)
+	eventer, err := events.NewEventer(events.EventerOptions{EventerType: string(events.Null)})
+	require.NoError(t, err)
+	ociRuntime := &checkOCIRuntime{exited: make(map[string]bool)}
+
+	runtime := new(Runtime)
+	runtime.config = new(config.Config)
+	runtime.lockManager = lockManager
+	runtime.eventer = eventer
+	runtime.defaultOCIRuntime = ociRuntime
+	runtime.storageConfig.GraphRoot = t.TempDir()
+	runtime.storageSet.StaticDirSet = true
+
+	state, err := NewSqliteState(runtime)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		state.Close()
+	})
+	runtime.state = state
+	runtime.valid = true
+	return runtime, ociRuntime
+}
+
+// getCheckContainer returns a stopped container with a root filesystem of its
+// own, so its storage is not checked.
+func getCheckContainer(t *testing.T, r *Runtime, n string) *Container {
+	t.Helper()
+	ctr, err := getTestCtrN(n, r.lockManager)
+	require.NoError(t, err)
+	ctr.config.Rootfs = "/does/not/exist"
+	ctr.config.StaticDir = t.TempDir()
+	ctr.state.State = define.ContainerStateStopped
+	ctr.state.ExecSessions = nil
+	return ctr
+}
+
+func TestCheckStateDuplicateLock(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	ctr1 := getCheckContainer(t, r, "1")
+	require.NoError(t, r.state.AddContainer(ctr1))
+	ctr2 := getCheckContainer(t, r, "2")
+	ctr2.config.LockID = ctr1.config.LockID
+	ctr2.lock = ctr1.lock
+	require.NoError(t, r.state.AddContainer(ctr2))
+
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkDuplicateLock, issues[0].Check)
+	assert.Equal(t, checkTypeContainer, issues[0].Type)
+	assert.False(t, issues[0].Repaired)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Repaired, issues[0].RepairError)
+
+	retrieved1, err := r.state.Container(ctr1.ID())
+	require.NoError(t, err)
+	retrieved2, err := r.state.Container(ctr2.ID())
+	require.NoError(t, err)
+	assert.NotEqual(t, retrieved1.config.LockID, retrieved2.config.LockID)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestCheckStateDanglingDependency(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	ctr := getCheckContainer(t, r, "1")
+	require.NoError(t, r.state.AddContainer(ctr))
+	missing := "2222222222222222222222222222222222222222222222222222222222222222"
+	ctr.config.Dependencies = []string{missing}
+	require.NoError(t, r.state.RewriteContainerConfig(ctr, ctr.config))
+
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkDanglingDependency, issues[0].Check)
+	assert.Equal(t, ctr.ID(), issues[0].ID)
+	assert.Contains(t, issues[0].Message, missing)
+	assert.False(t, issues[0].RepairLossy)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Repaired, issues[0].RepairError)
+
+	retrieved, err := r.state.Container(ctr.ID())
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.config.Dependencies)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestCheckStateDanglingNamespaceDependency(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	ctr := getCheckContainer(t, r, "1")
+	require.NoError(t, r.state.AddContainer(ctr))
+	missing := "2222222222222222222222222222222222222222222222222222222222222222"
+	ctr.config.NetNsCtr = missing
+	require.NoError(t, r.state.RewriteContainerConfig(ctr, ctr.config))
+
+	// The container cannot run anymore, so repairing it removes it, which
+	// is only done with RepairLossy
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkDanglingDependency, issues[0].Check)
+	assert.Contains(t, issues[0].Message, "joins a namespace of container "+missing)
+	assert.True(t, issues[0].RepairLossy)
+	assert.False(t, issues[0].Repaired)
+
+	exists, err := r.state.HasContainer(ctr.ID())
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestCheckStateDeadExecSession(t *testing.T) {
+	r, ociRuntime := getCheckRuntime(t)
+	ctr := getCheckContainer(t, r, "1")
+	ctr.state.State = define.ContainerStateRunning
+	ctr.state.ExecSessions = map[string]*ExecSession{
+		"dead": {Id: "dead", ContainerId: ctr.ID(), State: define.ExecStateRunning, PID: 9876},
+		"live": {Id: "live", ContainerId: ctr.ID(), State: define.ExecStateRunning, PID: 9877},
+	}
+	require.NoError(t, r.state.AddContainer(ctr))
+	ociRuntime.exited["dead"] = true
+
+	// conmon leaves the exit code of the session behind
+	exitDir := ctr.execExitFileDir("dead")
+	require.NoError(t, os.MkdirAll(exitDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(exitDir, ctr.ID()), []byte("3"), 0o600))
+
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkDeadExecSession, issues[0].Check)
+	assert.Contains(t, issues[0].Message, "exec session dead ")
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Repaired, issues[0].RepairError)
+
+	retrieved, err := r.state.Container(ctr.ID())
+	require.NoError(t, err)
+	require.NoError(t, r.state.UpdateContainer(retrieved))
+	require.Contains(t, retrieved.state.ExecSessions, "dead")
+	assert.Equal(t, define.ExecStateStopped, retrieved.state.ExecSessions["dead"].State)
+	assert.Equal(t, 3, retrieved.state.ExecSessions["dead"].ExitCode)
+	assert.NoDirExists(t, ctr.execBundlePath("dead"))
+	require.Contains(t, retrieved.state.ExecSessions, "live")
+	assert.Equal(t, define.ExecStateRunning, retrieved.state.ExecSessions["live"].State)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestCheckStateMissingNetwork(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	r.network = &checkNetwork{networks: []string{"present"}}
+	ctr := getCheckContainer(t, r, "1")
+	ctr.config.NetMode = namespaces.NetworkMode("bridge")
+	ctr.config.Networks = []nettypes.NamedPerNetworkOptions{
+		{Name: "present", PerNetworkOptions: nettypes.PerNetworkOptions{InterfaceName: "eth0"}},
+		{Name: "removed", PerNetworkOptions: nettypes.PerNetworkOptions{InterfaceName: "eth1"}},
+	}
+	require.NoError(t, r.state.AddContainer(ctr))
+
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkMissingNetwork, issues[0].Check)
+	assert.Contains(t, issues[0].Message, "network removed,")
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Repaired, issues[0].RepairError)
+
+	networks, err := r.state.GetNetworks(ctr)
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "present", networks[0].Name)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestCheckStateMissingNetworkRunning(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	r.network = &checkNetwork{}
+	ctr := getCheckContainer(t, r, "1")
+	ctr.state.State = define.ContainerStateRunning
+	ctr.config.NetMode = namespaces.NetworkMode("bridge")
+	ctr.config.Networks = []nettypes.NamedPerNetworkOptions{
+		{Name: "removed", PerNetworkOptions: nettypes.PerNetworkOptions{InterfaceName: "eth0"}},
+	}
+	require.NoError(t, r.state.AddContainer(ctr))
+
+	// A running container is left connected
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.False(t, issues[0].Repaired)
+	assert.Contains(t, issues[0].RepairError, "the container is running")
+
+	networks, err := r.state.GetNetworks(ctr)
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "removed", networks[0].Name)
+}
+
+func TestCheckStatePodInfra(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	pod, err := getTestPod1(r.lockManager)
+	require.NoError(t, err)
+	require.NoError(t, r.state.AddPod(pod))
+	pod.config.HasInfra = true
+	require.NoError(t, r.state.RewritePodConfig(pod, pod.config))
+	pod.state.InfraContainerID = "2222222222222222222222222222222222222222222222222222222222222222"
+	require.NoError(t, r.state.SavePod(pod))
+
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkPodInfra, issues[0].Check)
+	assert.Equal(t, checkTypePod, issues[0].Type)
+	assert.Equal(t, pod.ID(), issues[0].ID)
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Repaired, issues[0].RepairError)
+
+	retrieved, err := r.state.Pod(pod.ID())
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.state.InfraContainerID)
+	assert.False(t, retrieved.HasInfraContainer())
+
+	issues, err = r.checkState(context.Background(), entities.SystemCheckOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestCheckStateContainerStorage(t *testing.T) {
+	r, _ := getCheckRuntime(t)
+	r.store = &checkStore{}
+	ctr := getCheckContainer(t, r, "1")
+	ctr.config.Rootfs = ""
+	require.NoError(t, r.state.AddContainer(ctr))
+
+	// Repairing removes the container, which is only done with
+	// RepairLossy
+	issues, err := r.checkState(context.Background(), entities.SystemCheckOptions{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, checkContainerStorage, issues[0].Check)
+	assert.Equal(t, ctr.ID(), issues[0].ID)
+	assert.True(t, issues[0].RepairLossy)
+	assert.False(t, issues[0].Repaired)
+
+	exists, err := r.state.HasContainer(ctr.ID())
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
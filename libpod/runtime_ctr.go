@@ -485,6 +485,10 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		}
 	}()
 
+	if err := ctr.setupStorageQuota(); err != nil {
+		return nil, err
+	}
+
 	ctr.config.SecretsPath = filepath.Join(ctr.config.StaticDir, "secrets")
 	err = os.MkdirAll(ctr.config.SecretsPath, 0o755)
 	if err != nil {
@@ -991,6 +995,9 @@ func (r *Runtime) removeContainer(ctx context.Context, c *Container, opts ctrRmO
 		reportErrorf("removing exec sessions: %w", err)
 	}
 
+	// Record the container before its state and logs are gone.
+	tombstone := c.tombstone()
+
 	// Set ContainerStateRemoving as an intermediate state (we may get
 	// killed at any time) and save the container.
 	c.state.State = define.ContainerStateRemoving
@@ -1009,6 +1016,8 @@ func (r *Runtime) removeContainer(ctx context.Context, c *Container, opts ctrRmO
 	// Remove the container from the state
 	if err := r.state.RemoveContainer(c); err != nil {
 		reportErrorf("removing container %s from database: %w", c.ID(), err)
+	} else {
+		r.addTombstone(tombstone)
 	}
 
 	removedCtrs[c.ID()] = nil
@@ -1240,6 +1249,27 @@ func (r *Runtime) LookupContainerID(idOrName string) (string, error) {
 	return r.state.LookupContainerID(idOrName)
 }
 
+// LookupContainerByIdempotencyKey returns the container created by an
+// earlier request with the same idempotency key.  ErrNoSuchCtr is returned
+// if no container holds an unexpired key, ErrIdempotencyKeyReused if the
+// key was supplied with a different request.
+func (r *Runtime) LookupContainerByIdempotencyKey(key *define.IdempotencyKey) (*Container, error) {
+	ctrs, err := r.GetContainers(false, func(c *Container) bool {
+		k := c.config.IdempotencyKey
+		return k != nil && k.Key == key.Key && !k.Expired()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(ctrs) == 0 {
+		return nil, fmt.Errorf("no container with idempotency key %q: %w", key.Key, define.ErrNoSuchCtr)
+	}
+	if ctrs[0].config.IdempotencyKey.RequestDigest != key.RequestDigest {
+		return nil, fmt.Errorf("idempotency key %q: %w", key.Key, define.ErrIdempotencyKeyReused)
+	}
+	return ctrs[0], nil
+}
+
 // GetContainers retrieves all containers from the state.
 // If `loadState` is set, the containers' state will be loaded as well.
 // Filters can be provided which will determine what containers are included in
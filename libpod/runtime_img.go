@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	buildahDefine "go.podman.io/buildah/define"
@@ -103,6 +104,56 @@ func (r *Runtime) IsExternalContainerCallback(_ context.Context) libimage.IsExte
 	}
 }
 
+// ImageLayerSizes returns the sizes of the layers making up the image with the
+// given ID, keyed by layer ID.  Layers are shared between images and their
+// containers, which allows for attributing the disk space they use.
+func (r *Runtime) ImageLayerSizes(imageID string) (map[string]int64, error) {
+	img, err := r.store.Image(imageID)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int64)
+	for layerID := img.TopLayer; layerID != ""; {
+		layer, err := r.store.Layer(layerID)
+		if err != nil {
+			return nil, fmt.Errorf("looking up layer %s of image %s: %w", layerID, imageID, err)
+		}
+		size := layer.UncompressedSize
+		// The recorded size is only known to be valid with a digest.
+		if layer.UncompressedDigest == "" && (layer.TOCDigest == "" || size < 0) {
+			size, err = r.store.DiffSize(layer.Parent, layer.ID)
+			if err != nil {
+				return nil, fmt.Errorf("computing size of layer %s: %w", layer.ID, err)
+			}
+		}
+		sizes[layer.ID] = size
+		layerID = layer.Parent
+	}
+	return sizes, nil
+}
+
+// ImageStoredTime returns when the image with the given ID was pulled, built
+// or loaded into local storage.  That is the later of the time its top layer
+// was stored and the creation date of the image, as the layer may have been
+// stored for another image before.
+func (r *Runtime) ImageStoredTime(imageID string) (time.Time, error) {
+	img, err := r.store.Image(imageID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	stored := img.Created
+	if img.TopLayer != "" {
+		layer, err := r.store.Layer(img.TopLayer)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("looking up layer %s of image %s: %w", img.TopLayer, imageID, err)
+		}
+		if layer.Created.After(stored) {
+			stored = layer.Created
+		}
+	}
+	return stored, nil
+}
+
 // newImageBuildCompleteEvent creates a new event based on completion of a built image
 func (r *Runtime) newImageBuildCompleteEvent(idOrName string) {
 	e := events.NewEvent(events.Build)
@@ -76,6 +76,27 @@ func (r *Runtime) LookupPod(idOrName string) (*Pod, error) {
 	return r.state.LookupPod(idOrName)
 }
 
+// LookupPodByIdempotencyKey returns the pod created by an earlier request
+// with the same idempotency key.  ErrNoSuchPod is returned if no pod holds
+// an unexpired key, ErrIdempotencyKeyReused if the key was supplied with a
+// different request.
+func (r *Runtime) LookupPodByIdempotencyKey(key *define.IdempotencyKey) (*Pod, error) {
+	pods, err := r.Pods(func(p *Pod) bool {
+		k := p.config.IdempotencyKey
+		return k != nil && k.Key == key.Key && !k.Expired()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no pod with idempotency key %q: %w", key.Key, define.ErrNoSuchPod)
+	}
+	if pods[0].config.IdempotencyKey.RequestDigest != key.RequestDigest {
+		return nil, fmt.Errorf("idempotency key %q: %w", key.Key, define.ErrIdempotencyKeyReused)
+	}
+	return pods[0], nil
+}
+
 // Pods retrieves all pods
 // Filters can be provided which will determine which pods are included in the
 // output. Multiple filters are handled by ANDing their output, so only pods
@@ -10,6 +10,14 @@ func (r *Runtime) platformMakePod(_ *Pod, _ *spec.LinuxResources) (string, error
 	return "", nil
 }
 
+func (p *Pod) updatePodCgroup(_ *spec.LinuxResources) error {
+	return nil
+}
+
+func (p *Pod) restorePodCgroup(_, _ *spec.LinuxResources) error {
+	return nil
+}
+
 func (p *Pod) removePodCgroup() error {
 	return nil
 }
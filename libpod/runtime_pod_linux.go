@@ -5,8 +5,11 @@ package libpod
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -15,6 +18,7 @@ import (
 	"go.podman.io/common/pkg/config"
 	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/pkg/rootless"
+	"go.podman.io/storage/pkg/fileutils"
 )
 
 func (r *Runtime) platformMakePod(pod *Pod, resourceLimits *spec.LinuxResources) (string, error) {
@@ -90,6 +94,161 @@ func (r *Runtime) platformMakePod(pod *Pod, resourceLimits *spec.LinuxResources)
 	return cgroupParent, nil
 }
 
+// updatePodCgroup applies the given resource limits to the pod cgroup.
+func (p *Pod) updatePodCgroup(resources *spec.LinuxResources) error {
+	if p.state.CgroupPath == "" {
+		return nil
+	}
+	// cgroupfs + rootless = permission denied when writing the limits,
+	// the cgroup was never configured at creation either.
+	if p.runtime.config.Engine.CgroupManager == config.CgroupfsCgroupsManager && rootless.IsRootless() {
+		return nil
+	}
+
+	res, err := GetLimits(resources)
+	if err != nil {
+		return err
+	}
+	res.SkipDevices = true
+
+	cgc, err := cgroups.Load(p.state.CgroupPath)
+	if err != nil {
+		return err
+	}
+	return cgc.Update(&res)
+}
+
+// restorePodCgroup restores the limits of the pod cgroup to old after they
+// were changed to updated.  Applying old alone leaves the limits it does not
+// set untouched, so those which were set by the update are reset to the kernel
+// defaults.
+func (p *Pod) restorePodCgroup(old, updated *spec.LinuxResources) error {
+	if err := p.updatePodCgroup(old); err != nil {
+		return err
+	}
+	if p.state.CgroupPath == "" {
+		return nil
+	}
+	if p.runtime.config.Engine.CgroupManager == config.CgroupfsCgroupsManager && rootless.IsRootless() {
+		return nil
+	}
+
+	cgroupDir := filepath.Join("/sys/fs/cgroup", p.state.CgroupPath)
+	for _, w := range unsetLimitWrites(old, updated) {
+		file := w.file
+		// Weights are set through BFQ where it is available, like runc does
+		if file == "io.weight" && fileutils.Exists(filepath.Join(cgroupDir, "io.bfq.weight")) == nil {
+			file = "io.bfq.weight"
+		}
+		if err := os.WriteFile(filepath.Join(cgroupDir, file), []byte(w.value), 0); err != nil {
+			return fmt.Errorf("resetting %s of pod cgroup: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// cgroupWrite is a value to be written to a file of a cgroup.
+type cgroupWrite struct {
+	file  string
+	value string
+}
+
+// unsetLimitWrites returns the cgroup v2 writes which reset the limits set in
+// updated, but not in old, to their defaults.
+func unsetLimitWrites(old, updated *spec.LinuxResources) []cgroupWrite {
+	if old == nil {
+		old = &spec.LinuxResources{}
+	}
+	if updated == nil {
+		return nil
+	}
+	var writes []cgroupWrite
+
+	var oldMem spec.LinuxMemory
+	if old.Memory != nil {
+		oldMem = *old.Memory
+	}
+	if m := updated.Memory; m != nil {
+		if m.Limit != nil && oldMem.Limit == nil {
+			writes = append(writes, cgroupWrite{"memory.max", "max"})
+		}
+		if m.Reservation != nil && oldMem.Reservation == nil {
+			writes = append(writes, cgroupWrite{"memory.low", "0"})
+		}
+		if m.Swap != nil && oldMem.Swap == nil {
+			writes = append(writes, cgroupWrite{"memory.swap.max", "max"})
+		}
+	}
+
+	var oldCPU spec.LinuxCPU
+	if old.CPU != nil {
+		oldCPU = *old.CPU
+	}
+	if c := updated.CPU; c != nil {
+		if (c.Quota != nil && oldCPU.Quota == nil) || (c.Period != nil && oldCPU.Period == nil) {
+			quota, period := "max", "100000"
+			if oldCPU.Quota != nil && *oldCPU.Quota > 0 {
+				quota = strconv.FormatInt(*oldCPU.Quota, 10)
+			}
+			if oldCPU.Period != nil && *oldCPU.Period > 0 {
+				period = strconv.FormatUint(*oldCPU.Period, 10)
+			}
+			writes = append(writes, cgroupWrite{"cpu.max", quota + " " + period})
+		}
+		if c.Shares != nil && oldCPU.Shares == nil {
+			writes = append(writes, cgroupWrite{"cpu.weight", "100"})
+		}
+		// An empty cpuset inherits the one of the parent
+		if c.Cpus != "" && oldCPU.Cpus == "" {
+			writes = append(writes, cgroupWrite{"cpuset.cpus", ""})
+		}
+		if c.Mems != "" && oldCPU.Mems == "" {
+			writes = append(writes, cgroupWrite{"cpuset.mems", ""})
+		}
+	}
+
+	if updated.Pids != nil && updated.Pids.Limit != nil && (old.Pids == nil || old.Pids.Limit == nil) {
+		writes = append(writes, cgroupWrite{"pids.max", "max"})
+	}
+
+	if b := updated.BlockIO; b != nil {
+		var oldIO spec.LinuxBlockIO
+		if old.BlockIO != nil {
+			oldIO = *old.BlockIO
+		}
+		if b.Weight != nil && oldIO.Weight == nil {
+			writes = append(writes, cgroupWrite{"io.weight", "default 100"})
+		}
+		for _, dev := range b.WeightDevice {
+			if !slices.ContainsFunc(oldIO.WeightDevice, func(d spec.LinuxWeightDevice) bool {
+				return d.Major == dev.Major && d.Minor == dev.Minor
+			}) {
+				writes = append(writes, cgroupWrite{"io.weight", fmt.Sprintf("%d:%d default", dev.Major, dev.Minor)})
+			}
+		}
+		throttles := []struct {
+			key          string
+			updated, old []spec.LinuxThrottleDevice
+		}{
+			{"rbps", b.ThrottleReadBpsDevice, oldIO.ThrottleReadBpsDevice},
+			{"wbps", b.ThrottleWriteBpsDevice, oldIO.ThrottleWriteBpsDevice},
+			{"riops", b.ThrottleReadIOPSDevice, oldIO.ThrottleReadIOPSDevice},
+			{"wiops", b.ThrottleWriteIOPSDevice, oldIO.ThrottleWriteIOPSDevice},
+		}
+		for _, t := range throttles {
+			for _, dev := range t.updated {
+				if !slices.ContainsFunc(t.old, func(d spec.LinuxThrottleDevice) bool {
+					return d.Major == dev.Major && d.Minor == dev.Minor
+				}) {
+					writes = append(writes, cgroupWrite{"io.max", fmt.Sprintf("%d:%d %s=max", dev.Major, dev.Minor, t.key)})
+				}
+			}
+		}
+	}
+
+	return writes
+}
+
 func (p *Pod) removePodCgroup() error {
 	// Remove pod cgroup, if present
 	if p.state.CgroupPath == "" {
@@ -0,0 +1,57 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"testing"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnsetLimitWrites(t *testing.T) {
+	quota := int64(50000)
+	limit := int64(512 * 1024 * 1024)
+	pids := int64(100)
+	weight := uint16(300)
+
+	old := &spec.LinuxResources{
+		CPU: &spec.LinuxCPU{Quota: &quota},
+		BlockIO: &spec.LinuxBlockIO{
+			ThrottleReadBpsDevice: []spec.LinuxThrottleDevice{{LinuxBlockIODevice: spec.LinuxBlockIODevice{Major: 8, Minor: 0}, Rate: 1024}},
+		},
+	}
+	updated := &spec.LinuxResources{
+		Memory: &spec.LinuxMemory{Limit: &limit},
+		CPU:    &spec.LinuxCPU{Quota: &quota, Cpus: "0-1"},
+		Pids:   &spec.LinuxPids{Limit: &pids},
+		BlockIO: &spec.LinuxBlockIO{
+			Weight: &weight,
+			ThrottleReadBpsDevice: []spec.LinuxThrottleDevice{
+				{LinuxBlockIODevice: spec.LinuxBlockIODevice{Major: 8, Minor: 0}, Rate: 2048},
+				{LinuxBlockIODevice: spec.LinuxBlockIODevice{Major: 8, Minor: 16}, Rate: 2048},
+			},
+		},
+	}
+
+	// Limits which were set before are restored by applying them again,
+	// only those the update added are reset
+	assert.Equal(t, []cgroupWrite{
+		{"memory.max", "max"},
+		{"cpuset.cpus", ""},
+		{"pids.max", "max"},
+		{"io.weight", "default 100"},
+		{"io.max", "8:16 rbps=max"},
+	}, unsetLimitWrites(old, updated))
+
+	// A period set by the update keeps the previous quota
+	period := uint64(200000)
+	updated = &spec.LinuxResources{CPU: &spec.LinuxCPU{Quota: &quota, Period: &period}}
+	assert.Equal(t, []cgroupWrite{{"cpu.max", "50000 100000"}}, unsetLimitWrites(old, updated))
+
+	// Without any previous limits everything is reset
+	updated = &spec.LinuxResources{CPU: &spec.LinuxCPU{Quota: &quota, Period: &period}}
+	assert.Equal(t, []cgroupWrite{{"cpu.max", "max 100000"}}, unsetLimitWrites(nil, updated))
+
+	assert.Empty(t, unsetLimitWrites(old, old))
+}
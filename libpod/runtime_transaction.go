@@ -0,0 +1,182 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/secrets"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+// Kinds of the objects recorded by a Transaction
+const (
+	transactionContainer     = "container"
+	transactionPod           = "pod"
+	transactionVolume        = "volume"
+	transactionSecret        = "secret"
+	transactionRemovedSecret = "removed secret"
+)
+
+// Transaction records the containers, pods, volumes and secrets created by a
+// high-level operation such as playing a kube YAML, so that they can all be
+// removed again if the operation fails halfway.  Objects which existed before
+// and were merely reused must not be added, and neither must containers in a
+// pod which is added, since they are removed together with it.  Secrets which
+// are replaced can be recorded with their contents to be restored.  A
+// Transaction is not safe for concurrent use.
+type Transaction struct {
+	runtime *Runtime
+	objects []transactionObject
+}
+
+type transactionObject struct {
+	kind string
+	id   string
+	// secret and secretData hold a removed secret
+	secret     *secrets.Secret
+	secretData []byte
+}
+
+// NewTransaction returns an empty transaction.
+func (r *Runtime) NewTransaction() *Transaction {
+	return &Transaction{runtime: r}
+}
+
+// AddContainer records that ctr was created by the transaction.
+func (t *Transaction) AddContainer(ctr *Container) {
+	t.objects = append(t.objects, transactionObject{kind: transactionContainer, id: ctr.ID()})
+}
+
+// AddPod records that pod was created by the transaction.
+func (t *Transaction) AddPod(pod *Pod) {
+	t.objects = append(t.objects, transactionObject{kind: transactionPod, id: pod.ID()})
+}
+
+// AddVolume records that vol was created by the transaction.
+func (t *Transaction) AddVolume(vol *Volume) {
+	t.objects = append(t.objects, transactionObject{kind: transactionVolume, id: vol.Name()})
+}
+
+// AddSecret records that the secret with the given ID was created by the
+// transaction.
+func (t *Transaction) AddSecret(id string) {
+	t.objects = append(t.objects, transactionObject{kind: transactionSecret, id: id})
+}
+
+// AddRemovedSecret records that secret, with the given data, was removed by
+// the transaction, usually to be replaced by a secret of the same name, which
+// should be added after it.  The secret is stored again on rollback, with a
+// new ID.
+func (t *Transaction) AddRemovedSecret(secret *secrets.Secret, data []byte) {
+	t.objects = append(t.objects, transactionObject{kind: transactionRemovedSecret, id: secret.Name, secret: secret, secretData: data})
+}
+
+// Commit ends the transaction, keeping all objects created by it.
+func (t *Transaction) Commit() {
+	t.objects = nil
+}
+
+// Rollback ends the transaction, removing all objects created by it in the
+// reverse order of their creation, and restoring the secrets it removed.
+// Objects which were already removed, for instance containers together with
+// their pod, are skipped.  Rollback tries to remove all objects even if some
+// of them cannot be removed, and returns the errors of all failed removals.
+func (t *Transaction) Rollback(ctx context.Context) error {
+	var errs []error
+	for i := len(t.objects) - 1; i >= 0; i-- {
+		obj := t.objects[i]
+		if obj.kind == transactionRemovedSecret {
+			logrus.Debugf("Rolling back removal of secret %s", obj.id)
+			if err := t.restoreSecret(obj); err != nil {
+				errs = append(errs, fmt.Errorf("restoring secret %s: %w", obj.id, err))
+			}
+			continue
+		}
+		logrus.Debugf("Rolling back creation of %s %s", obj.kind, obj.id)
+		if err := t.remove(ctx, obj); err != nil {
+			errs = append(errs, fmt.Errorf("removing %s %s: %w", obj.kind, obj.id, err))
+		}
+	}
+	t.objects = nil
+	return errors.Join(errs...)
+}
+
+func (t *Transaction) remove(ctx context.Context, obj transactionObject) error {
+	r := t.runtime
+	timeout := uint(0)
+	switch obj.kind {
+	case transactionContainer:
+		ctr, err := r.LookupContainer(obj.id)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				return nil
+			}
+			return err
+		}
+		err = r.RemoveContainer(ctx, ctr, true, true, &timeout)
+		if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+			return nil
+		}
+		return err
+	case transactionPod:
+		pod, err := r.LookupPod(obj.id)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchPod) {
+				return nil
+			}
+			return err
+		}
+		ctrErrs, err := r.RemovePod(ctx, pod, true, true, &timeout)
+		if errors.Is(err, define.ErrNoSuchPod) || errors.Is(err, define.ErrPodRemoved) {
+			return nil
+		}
+		for id, ctrErr := range ctrErrs {
+			if ctrErr != nil {
+				err = errors.Join(err, fmt.Errorf("removing container %s: %w", id, ctrErr))
+			}
+		}
+		return err
+	case transactionVolume:
+		vol, err := r.LookupVolume(obj.id)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchVolume) {
+				return nil
+			}
+			return err
+		}
+		err = r.RemoveVolume(ctx, vol, true, &timeout)
+		if errors.Is(err, define.ErrNoSuchVolume) || errors.Is(err, define.ErrVolumeRemoved) {
+			return nil
+		}
+		return err
+	case transactionSecret:
+		manager, err := r.SecretsManager()
+		if err != nil {
+			return err
+		}
+		if _, err := manager.Delete(obj.id); err != nil && !errors.Is(err, secrets.ErrNoSuchSecret) {
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown object kind %q", obj.kind)
+}
+
+// restoreSecret stores a removed secret again, with its previous data,
+// driver, labels and metadata.
+func (t *Transaction) restoreSecret(obj transactionObject) error {
+	manager, err := t.runtime.SecretsManager()
+	if err != nil {
+		return err
+	}
+	_, err = manager.Store(obj.secret.Name, obj.secretData, obj.secret.Driver, secrets.StoreOptions{
+		DriverOpts: obj.secret.DriverOptions,
+		Metadata:   obj.secret.Metadata,
+		Labels:     obj.secret.Labels,
+	})
+	return err
+}
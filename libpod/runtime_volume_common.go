@@ -6,8 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -330,6 +332,50 @@ func (r *Runtime) UpdateVolumePlugins(ctx context.Context) *define.VolumeReload
 	}
 }
 
+// VolumePlugins returns the status of all volume plugins configured in
+// containers.conf, sorted by name.
+func (r *Runtime) VolumePlugins() []*define.VolumePluginInfo {
+	names := slices.Sorted(maps.Keys(r.config.Engine.VolumePlugins))
+	infos := make([]*define.VolumePluginInfo, 0, len(names))
+	for _, name := range names {
+		infos = append(infos, r.volumePluginInfo(name, r.config.Engine.VolumePlugins[name]))
+	}
+	return infos
+}
+
+// VolumePlugin returns the status of the volume plugin with the given name.
+func (r *Runtime) VolumePlugin(name string) (*define.VolumePluginInfo, error) {
+	socket, ok := r.config.Engine.VolumePlugins[name]
+	if !ok {
+		return nil, fmt.Errorf("no volume plugin with name %s available: %w", name, define.ErrMissingPlugin)
+	}
+	return r.volumePluginInfo(name, socket), nil
+}
+
+// volumePluginInfo contacts the plugin to find out whether it is reachable
+// and what it implements.
+func (r *Runtime) volumePluginInfo(name, socket string) *define.VolumePluginInfo {
+	info := &define.VolumePluginInfo{
+		Name:       name,
+		SocketPath: socket,
+		Implements: []string{},
+	}
+	driver, err := volplugin.GetVolumePlugin(name, socket, nil, r.config)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	capabilities, err := driver.Capabilities()
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Reachable = true
+	info.Implements = driver.Implements
+	info.Scope = capabilities.Scope
+	return info
+}
+
 // makeVolumeInPluginIfNotExist makes a volume in the given volume plugin if it
 // does not already exist.
 func makeVolumeInPluginIfNotExist(name string, options map[string]string, plugin *volplugin.VolumePlugin) error {
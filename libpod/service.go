@@ -118,8 +118,8 @@ func (c *Container) canStopServiceContainer() (*serviceContainerReport, error) {
 				return nil, err
 			}
 			for _, pc := range podCtrs {
-				if pc.IsInfra() {
-					continue // ignore infra containers
+				if pc.IsInfra() || pc.IsSidecar() {
+					continue // ignore infra containers and sidecars
 				}
 				exitCode, err := c.runtime.state.GetContainerExitCode(pc.ID())
 				if err != nil {
@@ -1074,6 +1074,114 @@ func (s *SQLiteState) PruneContainerExitCodes() (defErr error) {
 	return nil
 }
 
+// AddContainerTombstone adds the tombstone of a removed container to the
+// database.
+func (s *SQLiteState) AddContainerTombstone(tombstone *define.ContainerTombstone) (defErr error) {
+	if len(tombstone.ID) == 0 {
+		return define.ErrEmptyID
+	}
+
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	tombstoneJSON, err := json.Marshal(tombstone)
+	if err != nil {
+		return fmt.Errorf("marshalling tombstone of container %s: %w", tombstone.ID, err)
+	}
+
+	tx, err := s.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction to add tombstone: %w", err)
+	}
+	defer func() {
+		if defErr != nil {
+			if err := tx.Rollback(); err != nil {
+				logrus.Errorf("Rolling back transaction to add tombstone: %v", err)
+			}
+		}
+	}()
+
+	if _, err := tx.Exec("INSERT OR REPLACE INTO ContainerTombstone VALUES (?, ?, ?);", tombstone.ID, tombstone.Removed.UnixNano(), string(tombstoneJSON)); err != nil {
+		return fmt.Errorf("adding container %s tombstone: %w", tombstone.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction to add tombstone: %w", err)
+	}
+
+	return nil
+}
+
+// ContainerTombstones returns the tombstones of removed containers, most
+// recently removed first.
+func (s *SQLiteState) ContainerTombstones() ([]*define.ContainerTombstone, error) {
+	if !s.valid {
+		return nil, define.ErrDBClosed
+	}
+
+	rows, err := s.conn.Query("SELECT JSON FROM ContainerTombstone ORDER BY Removed DESC;")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving container tombstones from database: %w", err)
+	}
+	defer rows.Close()
+
+	var tombstones []*define.ContainerTombstone
+	for rows.Next() {
+		var rawJSON string
+		if err := rows.Scan(&rawJSON); err != nil {
+			return nil, fmt.Errorf("scanning container tombstone from database: %w", err)
+		}
+		tombstone := new(define.ContainerTombstone)
+		if err := json.Unmarshal([]byte(rawJSON), tombstone); err != nil {
+			return nil, fmt.Errorf("unmarshalling container tombstone: %w", err)
+		}
+		tombstones = append(tombstones, tombstone)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tombstones, nil
+}
+
+// PruneContainerTombstones removes tombstones beyond the newest maxCount,
+// and those of containers removed longer than maxAge ago.
+func (s *SQLiteState) PruneContainerTombstones(maxCount int, maxAge time.Duration) (defErr error) {
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	tx, err := s.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction to prune tombstones: %w", err)
+	}
+	defer func() {
+		if defErr != nil {
+			if err := tx.Rollback(); err != nil {
+				logrus.Errorf("Rolling back transaction to prune tombstones: %v", err)
+			}
+		}
+	}()
+
+	if maxAge > 0 {
+		cutoff := time.Now().Add(-maxAge).UnixNano()
+		if _, err := tx.Exec("DELETE FROM ContainerTombstone WHERE Removed < ?;", cutoff); err != nil {
+			return fmt.Errorf("removing tombstones older than %s: %w", maxAge, err)
+		}
+	}
+
+	if _, err := tx.Exec("DELETE FROM ContainerTombstone WHERE ID NOT IN (SELECT ID FROM ContainerTombstone ORDER BY Removed DESC LIMIT ?);", max(maxCount, 0)); err != nil {
+		return fmt.Errorf("removing tombstones beyond the newest %d: %w", maxCount, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction to prune tombstones: %w", err)
+	}
+
+	return nil
+}
+
 // AddExecSession adds an exec session to the state.
 func (s *SQLiteState) AddExecSession(ctr *Container, session *ExecSession) (defErr error) {
 	if !s.valid {
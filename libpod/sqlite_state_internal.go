@@ -62,6 +62,13 @@ func initSQLiteDB(conn *sql.DB) (defErr error) {
 		if err := createSQLiteTables(tx); err != nil {
 			return err
 		}
+	} else {
+		// The tombstone table was added without a schema change, as
+		// versions without it simply ignore it. Databases created by
+		// those versions get it here.
+		if _, err := tx.Exec(containerTombstoneTable); err != nil {
+			return fmt.Errorf("creating table ContainerTombstone: %w", err)
+		}
 	}
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
@@ -116,6 +123,14 @@ func migrateSchemaIfNecessary(tx *sql.Tx) (bool, error) {
 	return false, nil
 }
 
+// containerTombstoneTable holds the tombstones of removed containers.
+const containerTombstoneTable = `
+        CREATE TABLE IF NOT EXISTS ContainerTombstone(
+                ID      TEXT    PRIMARY KEY NOT NULL,
+                Removed INTEGER NOT NULL,
+                JSON    TEXT    NOT NULL
+        );`
+
 // Initialize all required tables for the SQLite state
 func createSQLiteTables(tx *sql.Tx) error {
 	// Technically we could split the "CREATE TABLE IF NOT EXISTS" and ");"
@@ -237,6 +252,7 @@ func createSQLiteTables(tx *sql.Tx) error {
 		"ContainerDependency":  containerDependency,
 		"ContainerVolume":      containerVolume,
 		"ContainerExitCode":    containerExitCode,
+		"ContainerTombstone":   containerTombstoneTable,
 		"PodConfig":            podConfig,
 		"PodState":             podState,
 		"VolumeConfig":         volumeConfig,
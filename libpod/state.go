@@ -2,7 +2,12 @@
 
 package libpod
 
-import "go.podman.io/common/libnetwork/types"
+import (
+	"time"
+
+	"go.podman.io/common/libnetwork/types"
+	"go.podman.io/podman/v6/libpod/define"
+)
 
 // State is a storage backend for libpod's current state.
 // A State is only initialized once per instance of libpod.
@@ -103,6 +108,16 @@ type State interface { //nolint:interfacebloat
 	// Remove exit codes older than 5 minutes.
 	PruneContainerExitCodes() error
 
+	// Add a tombstone for a removed container.
+	AddContainerTombstone(tombstone *define.ContainerTombstone) error
+	// Return the tombstones of removed containers, most recently removed
+	// first.
+	ContainerTombstones() ([]*define.ContainerTombstone, error)
+	// Remove tombstones beyond the newest maxCount, and those of
+	// containers removed longer than maxAge ago. A maxAge of 0 keeps
+	// tombstones regardless of age.
+	PruneContainerTombstones(maxCount int, maxAge time.Duration) error
+
 	// Add creates a reference to an exec session in the database.
 	// The container the exec session is attached to will be recorded.
 	// The container state will not be modified.
@@ -2444,3 +2444,53 @@ func TestRemoveVolumeNotInDB(t *testing.T) {
 		require.ErrorIs(t, err, define.ErrNoSuchVolume)
 	})
 }
+
+func TestContainerTombstones(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, _ lock.Manager) {
+		tombstones, err := state.ContainerTombstones()
+		require.NoError(t, err)
+		assert.Empty(t, tombstones)
+
+		now := time.Now()
+		for i, id := range []string{"old", "ctr1", "ctr2", "ctr3"} {
+			removed := now.Add(time.Duration(i) * time.Second)
+			if id == "old" {
+				removed = now.Add(-time.Hour)
+			}
+			err := state.AddContainerTombstone(&define.ContainerTombstone{
+				ID:       id,
+				Name:     id + "name",
+				ExitCode: int32(i),
+				Removed:  removed,
+				Logs:     []string{"line1", "line2"},
+			})
+			require.NoError(t, err)
+		}
+
+		err = state.AddContainerTombstone(&define.ContainerTombstone{})
+		assert.ErrorIs(t, err, define.ErrEmptyID)
+
+		tombstones, err = state.ContainerTombstones()
+		require.NoError(t, err)
+		require.Len(t, tombstones, 4)
+		assert.Equal(t, "ctr3", tombstones[0].ID)
+		assert.Equal(t, "ctr3name", tombstones[0].Name)
+		assert.Equal(t, int32(3), tombstones[0].ExitCode)
+		assert.Equal(t, []string{"line1", "line2"}, tombstones[0].Logs)
+		assert.Equal(t, "old", tombstones[3].ID)
+
+		// Tombstones older than the maximum age are removed
+		require.NoError(t, state.PruneContainerTombstones(10, time.Minute))
+		tombstones, err = state.ContainerTombstones()
+		require.NoError(t, err)
+		assert.Len(t, tombstones, 3)
+
+		// Only the newest are kept
+		require.NoError(t, state.PruneContainerTombstones(2, 0))
+		tombstones, err = state.ContainerTombstones()
+		require.NoError(t, err)
+		require.Len(t, tombstones, 2)
+		assert.Equal(t, "ctr3", tombstones[0].ID)
+		assert.Equal(t, "ctr2", tombstones[1].ID)
+	})
+}
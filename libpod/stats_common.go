@@ -50,6 +50,15 @@ func (c *Container) GetContainerStats(previousStats *define.ContainerStats) (*de
 	if err := c.getPlatformContainerStats(stats, previousStats); err != nil {
 		return nil, err
 	}
+
+	if c.config.StorageQuota > 0 {
+		usage, err := c.storageQuotaUsage()
+		if err != nil {
+			return nil, err
+		}
+		stats.StorageQuota = c.config.StorageQuota
+		stats.StorageQuotaUsage = usage
+	}
 	return stats, nil
 }
 
@@ -0,0 +1,182 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/driver"
+	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/storage/drivers/quota"
+)
+
+// storageQuotaCheckInterval is how often the storage usage of a running
+// container is checked when its quota is not enforced by project quotas.
+const storageQuotaCheckInterval = 10 * time.Second
+
+// StorageQuota returns the maximum size in bytes of the writable layer and
+// the anonymous volumes of the container, or 0 if it has no storage quota.
+func (c *Container) StorageQuota() uint64 {
+	return c.config.StorageQuota
+}
+
+// StorageQuotaUsage returns the combined size in bytes of the writable layer
+// and the anonymous volumes of the container, which count against its
+// storage quota.
+func (c *Container) StorageQuotaUsage() (uint64, error) {
+	return c.storageQuotaUsage()
+}
+
+func (c *Container) storageQuotaUsage() (uint64, error) {
+	size, err := c.rwSize()
+	if err != nil {
+		return 0, fmt.Errorf("getting size of container %s writable layer: %w", c.ID(), err)
+	}
+	usage := uint64(max(size, 0))
+
+	for _, namedVol := range c.config.NamedVolumes {
+		vol, err := c.runtime.state.Volume(namedVol.Name)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchVolume) {
+				continue
+			}
+			return 0, err
+		}
+		if !vol.Anonymous() || vol.UsesVolumeDriver() {
+			continue
+		}
+		volSize, err := vol.Size()
+		if err != nil {
+			return 0, fmt.Errorf("getting size of volume %s: %w", vol.Name(), err)
+		}
+		usage += volSize
+	}
+	return usage, nil
+}
+
+// setupStorageQuota sets a project quota on the writable layer of the newly
+// created container, which must still be empty.  The quota is enforced by the
+// file system only if that works and the container has no anonymous volumes:
+// a project quota limits a single directory, so the combined usage of the
+// writable layer and the volumes is monitored while the container runs.
+func (c *Container) setupStorageQuota() error {
+	c.config.StorageQuotaProject = false
+	if c.config.StorageQuota == 0 {
+		return nil
+	}
+	if c.config.Rootfs != "" {
+		return fmt.Errorf("a storage quota cannot be used with a rootfs: %w", define.ErrInvalidArg)
+	}
+	if _, ok := c.config.StorageOpts["size"]; ok {
+		return fmt.Errorf("a storage quota cannot be used with the size storage option: %w", define.ErrInvalidArg)
+	}
+
+	if c.hasAnonymousVolumes() {
+		logrus.Debugf("Container %s has anonymous volumes, monitoring its storage quota", c.ID())
+		return nil
+	}
+	upperDir, err := c.upperDir()
+	if err != nil {
+		return err
+	}
+	if upperDir == "" {
+		logrus.Debugf("Storage driver has no upper directory, monitoring the storage quota of container %s", c.ID())
+		return nil
+	}
+	// Use the layer directory as the base, so that the project ID of the
+	// upper directory does not collide with those other containers use.
+	q, err := quota.NewControl(filepath.Dir(upperDir))
+	if err == nil {
+		err = q.SetQuota(upperDir, quota.Quota{Size: c.config.StorageQuota})
+	}
+	if err != nil {
+		logrus.Debugf("Project quotas are not supported for the writable layer, monitoring the storage quota of container %s: %v", c.ID(), err)
+		return nil
+	}
+	c.config.StorageQuotaProject = true
+	return nil
+}
+
+// hasAnonymousVolumes returns whether anonymous volumes are created for the
+// container.  Only valid before they are created, which names them.
+func (c *Container) hasAnonymousVolumes() bool {
+	for _, vol := range c.config.NamedVolumes {
+		if vol.Name == "" || vol.IsAnonymous {
+			return true
+		}
+	}
+	return false
+}
+
+// upperDir returns the directory holding the writable layer of the container,
+// or "" if the storage driver does not use one.
+func (c *Container) upperDir() (string, error) {
+	storeCtr, err := c.runtime.store.Container(c.ID())
+	if err != nil {
+		return "", fmt.Errorf("getting container from store %q: %w", c.ID(), err)
+	}
+	driverData, err := driver.GetDriverData(c.runtime.store, storeCtr.LayerID)
+	if err != nil {
+		return "", fmt.Errorf("getting graph driver info %q: %w", c.ID(), err)
+	}
+	return driverData.Data["UpperDir"], nil
+}
+
+// CheckStorageQuota checks the storage usage of the running container against
+// its quota and, if it is exceeded, pauses or stops the container according
+// to its storage quota action.  It returns whether the quota was exceeded.
+func (c *Container) CheckStorageQuota(ctx context.Context) (bool, error) {
+	if c.config.StorageQuota == 0 {
+		return false, fmt.Errorf("container %s has no storage quota: %w", c.ID(), define.ErrInvalidArg)
+	}
+
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+
+	switch c.state.State {
+	case define.ContainerStateRunning:
+	case define.ContainerStatePaused:
+		return false, nil
+	default:
+		// The container exited without its cleanup removing the
+		// unit monitoring its storage quota.
+		if c.state.StorageQuotaUnitName != "" {
+			if err := c.removeStorageQuotaTimer(ctx); err != nil {
+				logrus.Errorf("Removing timer for container %s storage quota: %v", c.ID(), err)
+			}
+			return false, c.save()
+		}
+		return false, nil
+	}
+
+	usage, err := c.storageQuotaUsage()
+	if err != nil {
+		return false, err
+	}
+	if usage <= c.config.StorageQuota {
+		return false, nil
+	}
+
+	logrus.Infof("Container %s uses %d bytes of storage, exceeding its quota of %d bytes", c.ID(), usage, c.config.StorageQuota)
+	c.newContainerEvent(events.StorageQuotaExceeded)
+	if c.config.StorageQuotaAction == define.StorageQuotaActionPause {
+		if err := c.pause(); err != nil {
+			return true, err
+		}
+		c.newContainerEvent(events.Pause)
+		return true, nil
+	}
+	return true, c.stop(c.StopTimeout())
+}
@@ -0,0 +1,22 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// createStorageQuotaTimer creates a systemd timer which periodically checks
+// the storage usage of the container against its quota.
+func (c *Container) createStorageQuotaTimer() error {
+	logrus.Warnf("The storage quota of container %s is not enforced on FreeBSD", c.ID())
+	return nil
+}
+
+// removeStorageQuotaTimer stops the systemd timer and service checking the
+// storage quota of the container.
+func (c *Container) removeStorageQuotaTimer(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,22 @@
+//go:build !remote && !systemd
+
+package libpod
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// createStorageQuotaTimer creates a systemd timer which periodically checks
+// the storage usage of the container against its quota.
+func (c *Container) createStorageQuotaTimer() error {
+	logrus.Warnf("The storage quota of container %s is not enforced: it cannot use a project quota and Podman was built without systemd support", c.ID())
+	return nil
+}
+
+// removeStorageQuotaTimer stops the systemd timer and service checking the
+// storage quota of the container.
+func (c *Container) removeStorageQuotaTimer(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,99 @@
+//go:build !remote && systemd
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	systemdCommon "go.podman.io/common/pkg/systemd"
+	"go.podman.io/podman/v6/pkg/errorhandling"
+	"go.podman.io/podman/v6/pkg/rootless"
+	"go.podman.io/podman/v6/pkg/specgenutil"
+	"go.podman.io/podman/v6/pkg/systemd"
+)
+
+// createStorageQuotaTimer creates a systemd timer which periodically checks
+// the storage usage of the container against its quota.
+func (c *Container) createStorageQuotaTimer() error {
+	if !systemdCommon.RunsOnSystemd() {
+		logrus.Warnf("The storage quota of container %s is not enforced: it cannot use a project quota and the system does not run systemd", c.ID())
+		return nil
+	}
+	if c.state.StorageQuotaUnitName != "" {
+		if err := c.removeStorageQuotaTimer(context.Background()); err != nil {
+			logrus.Errorf("Removing timer for container %s storage quota: %v", c.ID(), err)
+		}
+	}
+
+	unitName := fmt.Sprintf("%s-storage-quota-%x", c.ID(), rand.Int())
+
+	podman, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get path for podman for a storage quota timer: %w", err)
+	}
+
+	cmd := []string{"--property", "LogLevelMax=notice"}
+	if rootless.IsRootless() {
+		cmd = append(cmd, "--user")
+	}
+	path := os.Getenv("PATH")
+	if path != "" {
+		cmd = append(cmd, "--setenv=PATH="+path)
+	}
+
+	interval := storageQuotaCheckInterval.String()
+	cmd = append(cmd, "--unit", unitName, "--on-active="+interval, "--on-unit-inactive="+interval, "--timer-property=AccuracySec=1s", "--property=StartLimitIntervalSec=0", podman)
+	cmd = append(cmd, specgenutil.GlobalPodmanArgs(c.runtime.storageConfig, c.runtime.config, logrus.IsLevelEnabled(logrus.DebugLevel))...)
+	cmd = append(cmd, "container", "check-storage-quota", c.ID())
+
+	logrus.Debugf("creating systemd-transient files: %s %s", "systemd-run", cmd)
+	systemdRun := exec.Command("systemd-run", cmd...)
+	if output, err := systemdRun.CombinedOutput(); err != nil {
+		exitError := &exec.ExitError{}
+		if errors.As(err, &exitError) {
+			return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+		}
+		return fmt.Errorf("failed to execute systemd-run: %w", err)
+	}
+
+	c.state.StorageQuotaUnitName = unitName
+	return nil
+}
+
+// removeStorageQuotaTimer stops the systemd timer and service checking the
+// storage quota of the container.
+func (c *Container) removeStorageQuotaTimer(ctx context.Context) error {
+	if c.state.StorageQuotaUnitName == "" {
+		return nil
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove storage quota timer: %w", err)
+	}
+	defer conn.Close()
+
+	stopErrors := []error{}
+	for _, unit := range []string{c.state.StorageQuotaUnitName + ".timer", c.state.StorageQuotaUnitName + ".service"} {
+		stopChan := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", stopChan); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				stopErrors = append(stopErrors, fmt.Errorf("removing storage quota unit %q: %w", unit, err))
+			}
+		} else if err := systemdOpSuccessful(stopChan); err != nil {
+			stopErrors = append(stopErrors, fmt.Errorf("stopping storage quota unit %q: %w", unit, err))
+		}
+	}
+	if err := conn.ResetFailedUnitContext(ctx, c.state.StorageQuotaUnitName+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit file: %q", err)
+	}
+
+	c.state.StorageQuotaUnitName = ""
+	return errorhandling.JoinErrors(stopErrors)
+}
@@ -189,6 +189,11 @@ func writeHijackHeader(r *http.Request, conn io.Writer, tty bool) {
 
 	c := r.Header.Get("Connection")
 	proto := r.Header.Get("Upgrade")
+	if strings.EqualFold(proto, "websocket") {
+		// The API handler completed the WebSocket handshake before
+		// handing over the connection, no HTTP header must follow.
+		return
+	}
 	if len(proto) == 0 || !strings.EqualFold(c, "Upgrade") {
 		// OK - can't upgrade if not requested or protocol is not specified
 		fmt.Fprintf(conn,
@@ -10,11 +10,21 @@ import (
 	"go.podman.io/podman/v6/libpod"
 	"go.podman.io/podman/v6/pkg/api/handlers/utils"
 	"go.podman.io/podman/v6/pkg/api/server/idle"
+	"go.podman.io/podman/v6/pkg/api/server/websocket"
 	api "go.podman.io/podman/v6/pkg/api/types"
 )
 
-func AttachContainer(w http.ResponseWriter, r *http.Request) {
-	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+// attachOptions are the query parameters of the attach endpoints
+type attachOptions struct {
+	detachKeys *string
+	streams    *libpod.HTTPAttachStreams
+	logs       bool
+	stream     bool
+}
+
+// parseAttachOptions parses the query parameters of the attach endpoints,
+// reporting errors to the client
+func parseAttachOptions(w http.ResponseWriter, r *http.Request) (*attachOptions, bool) {
 	decoder := utils.GetDecoder(r)
 
 	query := struct {
@@ -29,7 +39,7 @@ func AttachContainer(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
-		return
+		return nil, false
 	}
 
 	// Detach keys: explicitly set to "" is very different from unset
@@ -61,12 +71,28 @@ func AttachContainer(w http.ResponseWriter, r *http.Request) {
 	}
 	if useStreams && !streams.Stdout && !streams.Stderr && !streams.Stdin {
 		utils.Error(w, http.StatusBadRequest, errors.New("at least one of stdin, stdout, stderr must be true"))
-		return
+		return nil, false
 	}
 
 	// At least one of these must be set
 	if !query.Stream && !query.Logs {
 		utils.Error(w, http.StatusBadRequest, errors.New("at least one of Logs or Stream must be set"))
+		return nil, false
+	}
+
+	return &attachOptions{
+		detachKeys: detachKeys,
+		streams:    streams,
+		logs:       query.Logs,
+		stream:     query.Stream,
+	}, true
+}
+
+func AttachContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	opts, ok := parseAttachOptions(w, r)
+	if !ok {
 		return
 	}
 
@@ -85,7 +111,7 @@ func AttachContainer(w http.ResponseWriter, r *http.Request) {
 	// HTTPAttach will handle everything about the connection from here on
 	// (including closing it and writing errors to it).
 	hijackChan := make(chan bool, 1)
-	err = ctr.HTTPAttach(r, w, streams, detachKeys, nil, query.Stream, query.Logs, hijackChan)
+	err = ctr.HTTPAttach(r, w, opts.streams, opts.detachKeys, nil, opts.stream, opts.logs, hijackChan)
 
 	if <-hijackChan {
 		// If connection was Hijacked, we have to signal it's being closed
@@ -103,3 +129,53 @@ func AttachContainer(w http.ResponseWriter, r *http.Request) {
 	}
 	logrus.Debugf("Attach for container %s completed successfully", ctr.ID())
 }
+
+// AttachContainerWebSocket attaches to a container over a WebSocket
+// connection.  Output is sent as binary messages without the stream
+// headers, input is read from text and binary messages.
+func AttachContainerWebSocket(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	opts, ok := parseAttachOptions(w, r)
+	if !ok {
+		return
+	}
+
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	allowedOrigin, _ := r.Context().Value(api.AllowedOriginKey).(string)
+	conn, err := websocket.Upgrade(w, r, allowedOrigin)
+	if err != nil {
+		switch {
+		case errors.Is(err, websocket.ErrNotWebSocket):
+			utils.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, websocket.ErrOriginNotAllowed):
+			utils.Error(w, http.StatusForbidden, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+	// The connection was hijacked by the upgrade, signal it's being closed
+	t := r.Context().Value(api.IdleTrackerKey).(*idle.Tracker)
+	defer t.Close()
+
+	// HTTPAttach closes the connection once it has hijacked it
+	hijackChan := make(chan bool, 1)
+	err = ctr.HTTPAttach(r, conn.ResponseWriter(!ctr.Terminal()), opts.streams, opts.detachKeys, nil, opts.stream, opts.logs, hijackChan)
+	if !<-hijackChan {
+		if closeErr := conn.CloseWithStatus(websocket.CloseInternalError, err.Error()); closeErr != nil {
+			logrus.Debugf("Closing websocket attach connection: %v", closeErr)
+		}
+	}
+	if err != nil {
+		logrus.Errorf("Error attaching to container %s over websocket: %v", ctr.ID(), err)
+		return
+	}
+	logrus.Debugf("Websocket attach for container %s completed successfully", ctr.ID())
+}
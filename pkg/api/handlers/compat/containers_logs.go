@@ -71,6 +71,22 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Libpod clients may ask for Server-Sent Events.  A reconnecting client
+	// resumes after the last line it received.
+	eventStream := utils.WantsEventStream(r)
+	var lastLine *utils.EventID
+	if eventStream {
+		lastLine, err = utils.LastEventID(r)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if lastLine != nil {
+			since = lastLine.Since()
+			tail = -1
+		}
+	}
+
 	var until time.Time
 	if _, found := r.URL.Query()["until"]; found {
 		if query.Until != "0" {
@@ -104,6 +120,23 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		close(logChannel)
 	}()
 
+	if eventStream {
+		sse := utils.NewEventStream(w)
+		sse.Resume(lastLine)
+		for line := range logChannel {
+			if !until.IsZero() && line.Time.After(until) {
+				break
+			}
+			if (line.Device == "stdout" && !query.Stdout) || (line.Device == "stderr" && !query.Stderr) {
+				continue
+			}
+			if err := sse.SendAt(line.Time, line.Device, line); err != nil {
+				log.Errorf("Unable to write log event: %q", err)
+			}
+		}
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 
 	flush := func() {
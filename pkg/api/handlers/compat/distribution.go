@@ -0,0 +1,178 @@
+//go:build !remote && (linux || freebsd)
+
+package compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/docker/distribution/registry/api/errcode"
+	errcodev2 "github.com/docker/distribution/registry/api/v2"
+	dockerRegistry "github.com/moby/moby/api/types/registry"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"go.podman.io/image/v5/docker"
+	"go.podman.io/image/v5/image"
+	"go.podman.io/image/v5/manifest"
+	"go.podman.io/image/v5/pkg/shortnames"
+	"go.podman.io/image/v5/types"
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	api "go.podman.io/podman/v6/pkg/api/types"
+	"go.podman.io/podman/v6/pkg/auth"
+)
+
+// InspectDistribution returns the manifest descriptor and the platforms of
+// an image in a registry, without pulling it.
+func InspectDistribution(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+
+	query := struct {
+		TLSVerify bool `schema:"tlsVerify"`
+	}{
+		TLSVerify: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	name := utils.GetName(r)
+
+	possiblyNormalizedName, err := utils.NormalizeToDockerHub(r, name)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("normalizing image: %w", err))
+		return
+	}
+
+	authConf, authfile, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	sys := runtime.SystemContext()
+	if authfile != "" {
+		sys.AuthFilePath = authfile
+	}
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		sys.DockerInsecureSkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+	if authConf != nil {
+		sys.DockerAuthConfig = &types.DockerAuthConfig{
+			Username:      authConf.Username,
+			Password:      authConf.Password,
+			IdentityToken: authConf.IdentityToken,
+		}
+	}
+
+	resolved, err := shortnames.Resolve(sys, possiblyNormalizedName)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var inspectErr error
+	for _, candidate := range resolved.PullCandidates {
+		ref, err := docker.NewReference(candidate.Value)
+		if err != nil {
+			inspectErr = err
+			continue
+		}
+		var report *dockerRegistry.DistributionInspect
+		report, inspectErr = inspectDistribution(r.Context(), sys, ref)
+		if inspectErr == nil {
+			utils.WriteResponse(w, http.StatusOK, report)
+			return
+		}
+		inspectErr = fmt.Errorf("inspecting %s: %w", candidate.Value.String(), inspectErr)
+	}
+
+	utils.Error(w, distributionErrorStatus(inspectErr), inspectErr)
+}
+
+func inspectDistribution(ctx context.Context, sys *types.SystemContext, ref types.ImageReference) (*dockerRegistry.DistributionInspect, error) {
+	src, err := ref.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	unparsed := image.UnparsedInstance(src, nil)
+	rawManifest, mimeType, err := unparsed.Manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifestDigest, err := manifest.Digest(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dockerRegistry.DistributionInspect{
+		Descriptor: ocispec.Descriptor{
+			MediaType: mimeType,
+			Digest:    manifestDigest,
+			Size:      int64(len(rawManifest)),
+		},
+		Platforms: []ocispec.Platform{},
+	}
+
+	if manifest.MIMETypeIsMultiImage(mimeType) {
+		list, err := manifest.ListFromBlob(rawManifest, mimeType)
+		if err != nil {
+			return nil, err
+		}
+		for _, instanceDigest := range list.Instances() {
+			instance, err := list.Instance(instanceDigest)
+			if err != nil {
+				return nil, err
+			}
+			if instance.ReadOnly.Platform != nil {
+				report.Platforms = append(report.Platforms, *instance.ReadOnly.Platform)
+			}
+		}
+		return report, nil
+	}
+
+	// A single image, the platform is part of its config.
+	img, err := image.FromUnparsedImage(ctx, sys, unparsed)
+	if err != nil {
+		return nil, err
+	}
+	config, err := img.OCIConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Platforms = append(report.Platforms, config.Platform)
+	return report, nil
+}
+
+// distributionErrorStatus maps an error from the registry to the status
+// code Docker uses for the distribution endpoint.
+func distributionErrorStatus(err error) int {
+	var unauthErr docker.ErrUnauthorizedForCredentials
+	if errors.As(err, &unauthErr) {
+		return http.StatusUnauthorized
+	}
+	var ec errcode.Error
+	if errors.As(err, &ec) {
+		switch ec.Code {
+		case errcode.ErrorCodeUnauthorized:
+			return http.StatusUnauthorized
+		case errcode.ErrorCodeDenied:
+			return http.StatusForbidden
+		case errcodev2.ErrorCodeNameUnknown, errcodev2.ErrorCodeManifestUnknown:
+			return http.StatusNotFound
+		}
+	}
+	var ecs errcode.Errors
+	if errors.As(err, &ecs) && len(ecs) > 0 {
+		if inner, ok := ecs[0].(errcode.Error); ok {
+			return distributionErrorStatus(inner)
+		}
+	}
+	return http.StatusInternalServerError
+}
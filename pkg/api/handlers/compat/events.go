@@ -40,6 +40,22 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Libpod clients may ask for Server-Sent Events.  A reconnecting client
+	// resumes after the last event it received, replacing since.
+	eventStream := utils.WantsEventStream(r)
+	var lastEvent *utils.EventID
+	if eventStream {
+		var err error
+		lastEvent, err = utils.LastEventID(r)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if lastEvent != nil {
+			query.Since = utils.ResumeToken(lastEvent.Since())
+		}
+	}
+
 	if len(query.Since) > 0 || len(query.Until) > 0 {
 		fromStart = true
 	}
@@ -65,17 +81,26 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	flush := func() {}
-	if flusher, ok := w.(http.Flusher); ok {
-		flush = flusher.Flush
-	}
+	var (
+		sse   *utils.EventStream
+		coder *jsoniter.Encoder
+		flush = func() {}
+	)
+	if eventStream {
+		sse = utils.NewEventStream(w)
+		sse.Resume(lastEvent)
+	} else {
+		if flusher, ok := w.(http.Flusher); ok {
+			flush = flusher.Flush
+		}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	flush()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		flush()
 
-	coder := json.NewEncoder(w)
-	coder.SetEscapeHTML(true)
+		coder = json.NewEncoder(w)
+		coder.SetEscapeHTML(true)
+	}
 
 	for {
 		select {
@@ -114,6 +139,12 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 				e.From = ""   //nolint:staticcheck // deprecated field, cleared for API >= 1.52
 			}
 
+			if sse != nil {
+				if err := sse.SendAt(evt.Event.Time, string(e.Type), e); err != nil {
+					logrus.Errorf("Unable to write event: %q", err)
+				}
+				continue
+			}
 			if err := coder.Encode(e); err != nil {
 				logrus.Errorf("Unable to write json: %q", err)
 			}
@@ -16,6 +16,7 @@ import (
 	"go.podman.io/podman/v6/pkg/api/handlers"
 	"go.podman.io/podman/v6/pkg/api/handlers/utils"
 	"go.podman.io/podman/v6/pkg/api/server/idle"
+	"go.podman.io/podman/v6/pkg/api/server/websocket"
 	api "go.podman.io/podman/v6/pkg/api/types"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 	"go.podman.io/podman/v6/pkg/specgenutil"
@@ -219,6 +220,87 @@ func ExecStartHandler(w http.ResponseWriter, r *http.Request) {
 	logrus.Debugf("Attach for container %s exec session %s completed successfully", sessionCtr.ID(), sessionID)
 }
 
+// ExecStartWebSocketHandler runs a given exec session attached over a
+// WebSocket connection.  Output is sent as binary messages without the
+// stream headers, input is read from text and binary messages.
+func ExecStartWebSocketHandler(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+
+	sessionID := mux.Vars(r)["id"]
+
+	query := struct {
+		Height uint16 `schema:"h"`
+		Width  uint16 `schema:"w"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	sessionCtr, err := runtime.GetExecSessionContainer(sessionID)
+	if err != nil {
+		utils.Error(w, http.StatusNotFound, err)
+		return
+	}
+	session, err := sessionCtr.ExecSession(sessionID)
+	if err != nil {
+		utils.Error(w, http.StatusNotFound, err)
+		return
+	}
+
+	state, err := sessionCtr.State()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if state != define.ContainerStateRunning {
+		utils.Error(w, http.StatusConflict, fmt.Errorf("cannot exec in a container that is not running; container %s is %s", sessionCtr.ID(), state.String()))
+		return
+	}
+
+	var size *resize.TerminalSize
+	if session.Config.Terminal && (query.Height > 0 || query.Width > 0) {
+		size = &resize.TerminalSize{
+			Height: query.Height,
+			Width:  query.Width,
+		}
+	}
+
+	allowedOrigin, _ := r.Context().Value(api.AllowedOriginKey).(string)
+	conn, err := websocket.Upgrade(w, r, allowedOrigin)
+	if err != nil {
+		switch {
+		case errors.Is(err, websocket.ErrNotWebSocket):
+			utils.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, websocket.ErrOriginNotAllowed):
+			utils.Error(w, http.StatusForbidden, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+	// The connection was hijacked by the upgrade, signal it's being closed
+	t := r.Context().Value(api.IdleTrackerKey).(*idle.Tracker)
+	defer t.Close()
+
+	logrus.Debugf("Starting exec session %s of container %s over websocket", sessionID, sessionCtr.ID())
+
+	// ExecHTTPStartAndAttach closes the connection once it has hijacked it
+	hijackChan := make(chan bool, 1)
+	err = sessionCtr.ExecHTTPStartAndAttach(sessionID, r, conn.ResponseWriter(!session.Config.Terminal), nil, nil, nil, hijackChan, size)
+	if !<-hijackChan {
+		if closeErr := conn.CloseWithStatus(websocket.CloseInternalError, err.Error()); closeErr != nil {
+			logrus.Debugf("Closing websocket exec connection: %v", closeErr)
+		}
+	}
+	if err != nil && !errors.Is(err, define.ErrDetach) {
+		logrus.Error(fmt.Errorf("attaching to container %s exec session %s over websocket: %w", sessionCtr.ID(), sessionID, err))
+		return
+	}
+	logrus.Debugf("Websocket attach for container %s exec session %s completed successfully", sessionCtr.ID(), sessionID)
+}
+
 // ExecRemoveHandler removes a exec session.
 func ExecRemoveHandler(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
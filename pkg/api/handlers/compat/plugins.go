@@ -0,0 +1,117 @@
+//go:build !remote && (linux || freebsd)
+
+package compat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dockerPlugin "github.com/moby/moby/api/types/plugin"
+	"github.com/opencontainers/go-digest"
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	api "go.podman.io/podman/v6/pkg/api/types"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/domain/infra/abi"
+	"go.podman.io/podman/v6/pkg/util"
+)
+
+// ListPlugins lists the volume plugins configured in containers.conf as
+// Docker managed plugins.
+func ListPlugins(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	filtersMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// Reject the libpod specific name filter, Docker only filters plugins
+	// by capability and status.
+	for filter := range *filtersMap {
+		if filter != "capability" && filter != "enabled" {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid filter %q", filter))
+			return
+		}
+	}
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	reports, err := ic.PluginList(r.Context(), entities.PluginListOptions{Filter: *filtersMap})
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	plugins := make(dockerPlugin.ListResponse, 0, len(reports))
+	for _, report := range reports {
+		plugins = append(plugins, toDockerPlugin(&report.VolumePluginInfo))
+	}
+	utils.WriteResponse(w, http.StatusOK, plugins)
+}
+
+// InspectPlugin returns a volume plugin configured in containers.conf as
+// Docker managed plugin.
+func InspectPlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	// Docker references plugins with a tag, the configured plugins have none.
+	name := strings.TrimSuffix(utils.GetName(r), ":latest")
+	info, err := runtime.VolumePlugin(name)
+	if err != nil {
+		if errors.Is(err, define.ErrMissingPlugin) {
+			utils.Error(w, http.StatusNotFound, fmt.Errorf("plugin %q not found", name))
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, toDockerPlugin(info))
+}
+
+func toDockerPlugin(info *define.VolumePluginInfo) dockerPlugin.Plugin {
+	types := make([]dockerPlugin.CapabilityID, 0, len(info.Implements))
+	for _, impl := range info.Implements {
+		types = append(types, dockerPlugin.CapabilityID{
+			Prefix:     "docker",
+			Capability: strings.ToLower(impl),
+			Version:    "1.0",
+		})
+	}
+	return dockerPlugin.Plugin{
+		ID:      digest.FromString(info.Name + "\x00" + info.SocketPath).Encoded(),
+		Name:    info.Name,
+		Enabled: info.Reachable,
+		Config: dockerPlugin.Config{
+			Args: dockerPlugin.Args{
+				Settable: []string{},
+				Value:    []string{},
+			},
+			Description: "Volume plugin configured in containers.conf",
+			Entrypoint:  []string{},
+			Env:         []dockerPlugin.Env{},
+			Interface: dockerPlugin.Interface{
+				Socket: info.SocketPath,
+				Types:  types,
+			},
+			Linux: dockerPlugin.LinuxConfig{
+				Capabilities: []string{},
+				Devices:      []dockerPlugin.Device{},
+			},
+			Mounts: []dockerPlugin.Mount{},
+			Network: dockerPlugin.NetworkConfig{
+				Type: "host",
+			},
+		},
+		Settings: dockerPlugin.Settings{
+			Args:    []string{},
+			Devices: []dockerPlugin.Device{},
+			Env:     []string{},
+			Mounts:  []dockerPlugin.Mount{},
+		},
+	}
+}
@@ -124,6 +124,29 @@ func ListContainers(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, pss)
 }
 
+func ListRemovedContainers(w http.ResponseWriter, r *http.Request) {
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Last int `schema:"last"`
+	}{
+		// override any golang type defaults
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	tombstones, err := containerEngine.ContainerListRemoved(r.Context(), entities.ContainerListRemovedOptions{Last: query.Last})
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, tombstones)
+}
+
 func GetContainer(w http.ResponseWriter, r *http.Request) {
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
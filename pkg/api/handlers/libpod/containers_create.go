@@ -39,6 +39,28 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	key, err := utils.IdempotencyKey(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer utils.LockIdempotency(key)()
+	if key != nil {
+		ctr, err := runtime.LookupContainerByIdempotencyKey(key)
+		switch {
+		case err == nil:
+			utils.IdempotencyReplayed(w)
+			utils.WriteJSON(w, http.StatusCreated, entities.ContainerCreateResponse{ID: ctr.ID(), Warnings: []string{}})
+			return
+		case errors.Is(err, define.ErrIdempotencyKeyReused):
+			utils.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		case !errors.Is(err, define.ErrNoSuchCtr):
+			utils.InternalServerError(w, err)
+			return
+		}
+	}
+
 	// copy vars here and not leak config pointers into specgen
 	noHosts := conf.Containers.NoHosts
 	privileged := conf.Containers.Privileged
@@ -111,6 +133,9 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
+	if key != nil {
+		opts = append(opts, libpod.WithIdempotencyKey(key))
+	}
 	ctr, err := generate.ExecuteCreate(r.Context(), runtime, rtSpec, spec, false, opts...)
 	if err != nil {
 		utils.InternalServerError(w, err)
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/schema"
 	"github.com/sirupsen/logrus"
@@ -52,6 +53,9 @@ func StatsContainer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Stats are sampled live, a reconnecting Server-Sent Events client
+	// simply receives the next samples.
+	var sse *utils.EventStream
 	wroteContent := false
 	// Set up JSON encoder for streaming.
 	coder := json.NewEncoder(w)
@@ -63,10 +67,22 @@ func StatsContainer(w http.ResponseWriter, r *http.Request) {
 				utils.ContainerNotFound(w, "", stats.Error)
 				return
 			}
-			// Write header and content type.
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
 			wroteContent = true
+			if utils.WantsEventStream(r) {
+				sse = utils.NewEventStream(w)
+			} else {
+				// Write header and content type.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+			}
+		}
+
+		if sse != nil {
+			if err := sse.Send(utils.ResumeToken(time.Now()), "stats", stats); err != nil {
+				logrus.Errorf("Unable to write stats: %v", err)
+				return
+			}
+			continue
 		}
 
 		if err := coder.Encode(stats); err != nil {
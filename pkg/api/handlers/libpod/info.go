@@ -7,6 +7,7 @@ import (
 
 	"go.podman.io/podman/v6/libpod"
 	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	"go.podman.io/podman/v6/pkg/api/server/ratelimit"
 	api "go.podman.io/podman/v6/pkg/api/types"
 	"go.podman.io/podman/v6/pkg/domain/infra/abi"
 )
@@ -19,5 +20,8 @@ func GetInfo(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
+	if limiter, ok := r.Context().Value(api.RateLimiterKey).(*ratelimit.Limiter); ok && limiter != nil {
+		info.Service = limiter.Info()
+	}
 	utils.WriteResponse(w, http.StatusOK, info)
 }
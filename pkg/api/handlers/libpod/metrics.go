@@ -0,0 +1,412 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	api "go.podman.io/podman/v6/pkg/api/types"
+)
+
+const (
+	metricsContentType     = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// Numeric values of the podman_container_health metric.
+const (
+	metricsHealthNone      = -1
+	metricsHealthHealthy   = 0
+	metricsHealthUnhealthy = 1
+	metricsHealthStarting  = 2
+)
+
+type metricLabel struct {
+	name  string
+	value string
+}
+
+type metricSample struct {
+	labels []metricLabel
+	value  float64
+}
+
+type metricFamily struct {
+	// name is the name of the samples, for counters including the
+	// _total suffix.
+	name    string
+	help    string
+	typ     string
+	samples []metricSample
+}
+
+func (f *metricFamily) add(value float64, labels ...metricLabel) {
+	f.samples = append(f.samples, metricSample{labels: labels, value: value})
+}
+
+// metricFamilies keeps metric families in the order they were first used.
+type metricFamilies struct {
+	order    []*metricFamily
+	families map[string]*metricFamily
+}
+
+func newMetricFamilies() *metricFamilies {
+	return &metricFamilies{families: make(map[string]*metricFamily)}
+}
+
+func (m *metricFamilies) family(name, typ, help string) *metricFamily {
+	if f, ok := m.families[name]; ok {
+		return f
+	}
+	f := &metricFamily{name: name, help: help, typ: typ}
+	m.families[name] = f
+	m.order = append(m.order, f)
+	return f
+}
+
+func (m *metricFamilies) gauge(name, help string) *metricFamily {
+	return m.family(name, "gauge", help)
+}
+
+func (m *metricFamilies) counter(name, help string) *metricFamily {
+	return m.family(name, "counter", help)
+}
+
+// write renders the metric families in the Prometheus text format or, if
+// openMetrics is true, in the OpenMetrics text format.
+func (m *metricFamilies) write(w io.Writer, openMetrics bool) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range m.order {
+		familyName := f.name
+		if openMetrics && f.typ == "counter" {
+			// OpenMetrics names the family of a counter without suffix.
+			familyName = strings.TrimSuffix(familyName, "_total")
+		}
+		fmt.Fprintf(bw, "# HELP %s %s\n", familyName, escapeMetricHelp(f.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", familyName, f.typ)
+		for _, s := range f.samples {
+			bw.WriteString(f.name)
+			if len(s.labels) > 0 {
+				bw.WriteByte('{')
+				for i, l := range s.labels {
+					if i > 0 {
+						bw.WriteByte(',')
+					}
+					fmt.Fprintf(bw, "%s=\"%s\"", l.name, escapeMetricLabelValue(l.value))
+				}
+				bw.WriteByte('}')
+			}
+			bw.WriteByte(' ')
+			bw.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+			bw.WriteByte('\n')
+		}
+	}
+	if openMetrics {
+		bw.WriteString("# EOF\n")
+	}
+	return bw.Flush()
+}
+
+func escapeMetricHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+func escapeMetricLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
+
+// sanitizeMetricLabelName turns a container label key into a valid metric
+// label name by prefixing it with "label_" and replacing all characters not
+// allowed in label names with underscores.
+func sanitizeMetricLabelName(key string) string {
+	var b strings.Builder
+	b.WriteString("label_")
+	for _, r := range key {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// containerLabelAllowlist maps the allowed container label keys to metric
+// label names. Keys that map to the same label name as an earlier key are
+// dropped.
+type containerLabelAllowlist struct {
+	keys  []string
+	names []string
+}
+
+func newContainerLabelAllowlist(keys []string) *containerLabelAllowlist {
+	a := &containerLabelAllowlist{}
+	for _, key := range keys {
+		name := sanitizeMetricLabelName(key)
+		if slices.Contains(a.names, name) {
+			logrus.Debugf("Ignoring container label %q for metrics, it conflicts with another allowed label", key)
+			continue
+		}
+		a.keys = append(a.keys, key)
+		a.names = append(a.names, name)
+	}
+	return a
+}
+
+func (a *containerLabelAllowlist) labels(ctrLabels map[string]string) []metricLabel {
+	labels := make([]metricLabel, 0, len(a.keys))
+	for i, key := range a.keys {
+		labels = append(labels, metricLabel{name: a.names[i], value: ctrLabels[key]})
+	}
+	return labels
+}
+
+func healthMetricValue(status string) float64 {
+	switch status {
+	case define.HealthCheckHealthy:
+		return metricsHealthHealthy
+	case define.HealthCheckUnhealthy:
+		return metricsHealthUnhealthy
+	case define.HealthCheckStarting:
+		return metricsHealthStarting
+	}
+	return metricsHealthNone
+}
+
+// podMetrics sums up the resource usage of the running containers of a pod.
+// The sums drop when a container stops, so they are exposed as gauges even
+// where the metrics of the containers are counters.
+type podMetrics struct {
+	// sharesNet is set if the containers share the network namespace and
+	// thus report the same network counters.
+	sharesNet   bool
+	containers  int
+	cpuNano     uint64
+	memUsage    uint64
+	blockInput  uint64
+	blockOutput uint64
+	netRx       uint64
+	netTx       uint64
+	pids        uint64
+}
+
+// Metrics serves engine, container and pod metrics for Prometheus.
+func Metrics(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	var allowedLabels []string
+	if keys, ok := r.Context().Value(api.MetricsLabelsKey).([]string); ok {
+		allowedLabels = keys
+	}
+
+	metrics, err := gatherMetrics(r, runtime, newContainerLabelAllowlist(allowedLabels))
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", metricsContentType)
+	}
+	w.WriteHeader(http.StatusOK)
+	if err := metrics.write(w, openMetrics); err != nil {
+		logrus.Errorf("Unable to write metrics: %v", err)
+	}
+}
+
+func gatherMetrics(r *http.Request, runtime *libpod.Runtime, allowlist *containerLabelAllowlist) (*metricFamilies, error) {
+	m := newMetricFamilies()
+
+	ctrs, err := runtime.GetAllContainers()
+	if err != nil {
+		return nil, err
+	}
+	pods, err := runtime.GetAllPods()
+	if err != nil {
+		return nil, err
+	}
+	vols, err := runtime.GetAllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	imgs, err := runtime.LibimageRuntime().ListImages(r.Context(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(ctrs, func(a, b *libpod.Container) int { return strings.Compare(a.Name(), b.Name()) })
+	slices.SortFunc(pods, func(a, b *libpod.Pod) int { return strings.Compare(a.Name(), b.Name()) })
+
+	podNames := make(map[string]string, len(pods))
+	for _, pod := range pods {
+		podNames[pod.ID()] = pod.Name()
+	}
+
+	// Engine level metrics
+	m.gauge("podman_images", "Number of images.").add(float64(len(imgs)))
+	m.gauge("podman_volumes", "Number of volumes.").add(float64(len(vols)))
+
+	ctrStates := make(map[define.ContainerStatus]int)
+	podStats := make(map[string]*podMetrics, len(pods))
+	for _, pod := range pods {
+		podStats[pod.ID()] = &podMetrics{sharesNet: pod.SharesNet()}
+	}
+
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			// The container may have been removed in the meantime.
+			logrus.Debugf("Skipping metrics of container %s: %v", ctr.ID(), err)
+			continue
+		}
+		ctrStates[state]++
+
+		labels := []metricLabel{
+			{name: "id", value: ctr.ID()},
+			{name: "name", value: ctr.Name()},
+			{name: "pod_id", value: ctr.PodID()},
+			{name: "pod_name", value: podNames[ctr.PodID()]},
+		}
+		labels = append(labels, allowlist.labels(ctr.Labels())...)
+
+		infoLabels := append(slices.Clone(labels),
+			metricLabel{name: "image", value: ctr.RawImageName()},
+			metricLabel{name: "state", value: state.String()},
+		)
+		m.gauge("podman_container_info", "Information about a container, the value is always 1.").add(1, infoLabels...)
+
+		restarts, err := ctr.RestartCount()
+		if err == nil {
+			m.counter("podman_container_restarts_total", "Number of times the container was restarted by its restart policy.").add(float64(restarts), labels...)
+		}
+
+		health := float64(metricsHealthNone)
+		if ctr.HasHealthCheck() {
+			status, err := ctr.HealthCheckStatus()
+			if err != nil {
+				logrus.Debugf("Unable to get health of container %s: %v", ctr.ID(), err)
+			}
+			health = healthMetricValue(status)
+		}
+		m.gauge("podman_container_health", "Health of the container: -1 no healthcheck, 0 healthy, 1 unhealthy, 2 starting.").add(health, labels...)
+
+		ps, hasPod := podStats[ctr.PodID()]
+		if hasPod {
+			ps.containers++
+		}
+
+		if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+			continue
+		}
+		stats, err := ctr.GetContainerStats(nil)
+		if err != nil {
+			logrus.Debugf("Unable to get stats of container %s: %v", ctr.ID(), err)
+			continue
+		}
+
+		m.counter("podman_container_cpu_seconds_total", "Total CPU time consumed by the container in seconds.").add(float64(stats.CPUNano)/1e9, labels...)
+		m.counter("podman_container_cpu_system_seconds_total", "CPU time consumed by the container in kernel mode in seconds.").add(float64(stats.CPUSystemNano)/1e9, labels...)
+		m.gauge("podman_container_memory_usage_bytes", "Memory used by the container in bytes.").add(float64(stats.MemUsage), labels...)
+		m.gauge("podman_container_memory_limit_bytes", "Memory limit of the container in bytes.").add(float64(stats.MemLimit), labels...)
+		m.counter("podman_container_block_input_bytes_total", "Bytes read from block devices by the container.").add(float64(stats.BlockInput), labels...)
+		m.counter("podman_container_block_output_bytes_total", "Bytes written to block devices by the container.").add(float64(stats.BlockOutput), labels...)
+		m.gauge("podman_container_pids", "Number of processes in the container.").add(float64(stats.PIDs), labels...)
+
+		ifaces := make([]string, 0, len(stats.Network))
+		for iface := range stats.Network {
+			ifaces = append(ifaces, iface)
+		}
+		slices.Sort(ifaces)
+		var netRx, netTx uint64
+		for _, iface := range ifaces {
+			net := stats.Network[iface]
+			netLabels := append(slices.Clone(labels), metricLabel{name: "interface", value: iface})
+			m.counter("podman_container_network_receive_bytes_total", "Bytes received by the container per network interface.").add(float64(net.RxBytes), netLabels...)
+			m.counter("podman_container_network_transmit_bytes_total", "Bytes transmitted by the container per network interface.").add(float64(net.TxBytes), netLabels...)
+			netRx += net.RxBytes
+			netTx += net.TxBytes
+		}
+
+		if hasPod {
+			ps.cpuNano += stats.CPUNano
+			ps.memUsage += stats.MemUsage
+			ps.blockInput += stats.BlockInput
+			ps.blockOutput += stats.BlockOutput
+			if ps.sharesNet {
+				ps.netRx = max(ps.netRx, netRx)
+				ps.netTx = max(ps.netTx, netTx)
+			} else {
+				ps.netRx += netRx
+				ps.netTx += netTx
+			}
+			ps.pids += stats.PIDs
+		}
+	}
+
+	ctrsFamily := m.gauge("podman_containers", "Number of containers by state.")
+	for _, state := range []define.ContainerStatus{
+		define.ContainerStateConfigured,
+		define.ContainerStateCreated,
+		define.ContainerStateRunning,
+		define.ContainerStateStopped,
+		define.ContainerStatePaused,
+		define.ContainerStateExited,
+		define.ContainerStateRemoving,
+		define.ContainerStateStopping,
+		define.ContainerStateUnknown,
+	} {
+		ctrsFamily.add(float64(ctrStates[state]), metricLabel{name: "state", value: state.String()})
+	}
+
+	podStates := make(map[string]int)
+	for _, pod := range pods {
+		status, err := pod.GetPodStatus()
+		if err != nil {
+			logrus.Debugf("Skipping metrics of pod %s: %v", pod.ID(), err)
+			continue
+		}
+		podStates[status]++
+
+		labels := []metricLabel{
+			{name: "id", value: pod.ID()},
+			{name: "name", value: pod.Name()},
+		}
+		ps := podStats[pod.ID()]
+		m.gauge("podman_pod_info", "Information about a pod, the value is always 1.").add(1, append(slices.Clone(labels), metricLabel{name: "state", value: status})...)
+		m.gauge("podman_pod_containers", "Number of containers in the pod.").add(float64(ps.containers), labels...)
+		m.gauge("podman_pod_cpu_seconds", "CPU time consumed by the running containers of the pod in seconds.").add(float64(ps.cpuNano)/1e9, labels...)
+		m.gauge("podman_pod_memory_usage_bytes", "Memory used by the running containers of the pod in bytes.").add(float64(ps.memUsage), labels...)
+		m.gauge("podman_pod_block_input_bytes", "Bytes read from block devices by the running containers of the pod.").add(float64(ps.blockInput), labels...)
+		m.gauge("podman_pod_block_output_bytes", "Bytes written to block devices by the running containers of the pod.").add(float64(ps.blockOutput), labels...)
+		m.gauge("podman_pod_network_receive_bytes", "Bytes received by the running containers of the pod.").add(float64(ps.netRx), labels...)
+		m.gauge("podman_pod_network_transmit_bytes", "Bytes transmitted by the running containers of the pod.").add(float64(ps.netTx), labels...)
+		m.gauge("podman_pod_pids", "Number of processes in the running containers of the pod.").add(float64(ps.pids), labels...)
+	}
+
+	podsFamily := m.gauge("podman_pods", "Number of pods by state.")
+	for _, state := range []string{
+		define.PodStateCreated,
+		define.PodStateRunning,
+		define.PodStateDegraded,
+		define.PodStatePaused,
+		define.PodStateStopped,
+		define.PodStateExited,
+		define.PodStateErrored,
+	} {
+		podsFamily.add(float64(podStates[state]), metricLabel{name: "state", value: state})
+	}
+
+	return m, nil
+}
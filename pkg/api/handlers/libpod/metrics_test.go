@@ -0,0 +1,68 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeMetricLabelName(t *testing.T) {
+	assert.Equal(t, "label_app", sanitizeMetricLabelName("app"))
+	assert.Equal(t, "label_com_example_team", sanitizeMetricLabelName("com.example.team"))
+	assert.Equal(t, "label_io_podman_compose_project", sanitizeMetricLabelName("io.podman/compose-project"))
+}
+
+func TestContainerLabelAllowlist(t *testing.T) {
+	allowlist := newContainerLabelAllowlist([]string{"app", "com.example.team", "com_example.team"})
+	labels := allowlist.labels(map[string]string{"app": "web", "other": "x"})
+	assert.Equal(t, []metricLabel{
+		{name: "label_app", value: "web"},
+		{name: "label_com_example_team", value: ""},
+	}, labels)
+}
+
+func TestWriteMetrics(t *testing.T) {
+	m := newMetricFamilies()
+	m.gauge("podman_images", "Number of images.").add(3)
+	cpu := m.counter("podman_container_cpu_seconds_total", "Total CPU time.")
+	cpu.add(1.5, metricLabel{name: "name", value: "web"})
+	cpu.add(0.25, metricLabel{name: "name", value: "say \"hi\"\n"})
+	// Asking for a known family again must not create a second one.
+	m.counter("podman_container_cpu_seconds_total", "Total CPU time.").add(2, metricLabel{name: "name", value: `a\b`})
+
+	var buf bytes.Buffer
+	require.NoError(t, m.write(&buf, false))
+	assert.Equal(t, `# HELP podman_images Number of images.
+# TYPE podman_images gauge
+podman_images 3
+# HELP podman_container_cpu_seconds_total Total CPU time.
+# TYPE podman_container_cpu_seconds_total counter
+podman_container_cpu_seconds_total{name="web"} 1.5
+podman_container_cpu_seconds_total{name="say \"hi\"\n"} 0.25
+podman_container_cpu_seconds_total{name="a\\b"} 2
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, m.write(&buf, true))
+	assert.Equal(t, `# HELP podman_images Number of images.
+# TYPE podman_images gauge
+podman_images 3
+# HELP podman_container_cpu_seconds Total CPU time.
+# TYPE podman_container_cpu_seconds counter
+podman_container_cpu_seconds_total{name="web"} 1.5
+podman_container_cpu_seconds_total{name="say \"hi\"\n"} 0.25
+podman_container_cpu_seconds_total{name="a\\b"} 2
+# EOF
+`, buf.String())
+}
+
+func TestHealthMetricValue(t *testing.T) {
+	assert.Equal(t, float64(metricsHealthHealthy), healthMetricValue("healthy"))
+	assert.Equal(t, float64(metricsHealthUnhealthy), healthMetricValue("unhealthy"))
+	assert.Equal(t, float64(metricsHealthStarting), healthMetricValue("starting"))
+	assert.Equal(t, float64(metricsHealthNone), healthMetricValue(""))
+}
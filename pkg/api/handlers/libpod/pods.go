@@ -7,19 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/gorilla/schema"
 	"github.com/hashicorp/go-multierror"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/podman/v6/libpod"
 	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/events"
 	"go.podman.io/podman/v6/pkg/api/handlers"
+	"go.podman.io/podman/v6/pkg/api/handlers/compat"
 	"go.podman.io/podman/v6/pkg/api/handlers/utils"
 	api "go.podman.io/podman/v6/pkg/api/types"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 	"go.podman.io/podman/v6/pkg/domain/infra/abi"
+	"go.podman.io/podman/v6/pkg/errorhandling"
 	"go.podman.io/podman/v6/pkg/specgen"
 	"go.podman.io/podman/v6/pkg/specgen/generate"
 	"go.podman.io/podman/v6/pkg/specgenutil"
@@ -34,6 +40,30 @@ func PodCreate(w http.ResponseWriter, r *http.Request) {
 		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 		err     error
 	)
+	key, err := utils.IdempotencyKey(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer utils.LockIdempotency(key)()
+	var podOptions []libpod.PodCreateOption
+	if key != nil {
+		pod, err := runtime.LookupPodByIdempotencyKey(key)
+		switch {
+		case err == nil:
+			utils.IdempotencyReplayed(w)
+			utils.WriteResponse(w, http.StatusCreated, entities.IDResponse{ID: pod.ID()})
+			return
+		case errors.Is(err, define.ErrIdempotencyKeyReused):
+			utils.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		case !errors.Is(err, define.ErrNoSuchPod):
+			utils.InternalServerError(w, err)
+			return
+		}
+		podOptions = append(podOptions, libpod.WithPodIdempotencyKey(key))
+	}
+
 	psg := specgen.PodSpecGenerator{InfraContainerSpec: &specgen.SpecGenerator{}}
 	if err := utils.ReadJSONFromBody(r, &psg); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
@@ -74,7 +104,7 @@ func PodCreate(w http.ResponseWriter, r *http.Request) {
 		psg.InfraContainerSpec.RawImageName = psg.InfraImage
 	}
 	podSpecComplete := entities.PodSpec{PodSpecGen: psg}
-	pod, err := generate.MakePod(&podSpecComplete, runtime)
+	pod, err := generate.MakePod(&podSpecComplete, runtime, podOptions...)
 	if err != nil {
 		httpCode := http.StatusInternalServerError
 		if errors.Is(err, define.ErrPodExists) {
@@ -384,6 +414,371 @@ func PodUnpause(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, code, &report)
 }
 
+func PodCheckpoint(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Keep           bool `schema:"keep"`
+		LeaveRunning   bool `schema:"leaveRunning"`
+		TCPEstablished bool `schema:"tcpEstablished"`
+		Export         bool `schema:"export"`
+		IgnoreRootFS   bool `schema:"ignoreRootFS"`
+		IgnoreVolumes  bool `schema:"ignoreVolumes"`
+		FileLocks      bool `schema:"fileLocks"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	name := utils.GetName(r)
+	if _, err := runtime.LookupPod(name); err != nil {
+		utils.PodNotFound(w, name, err)
+		return
+	}
+
+	options := entities.PodCheckpointOptions{
+		Keep:           query.Keep,
+		LeaveRunning:   query.LeaveRunning,
+		TCPEstablished: query.TCPEstablished,
+		IgnoreRootFS:   query.IgnoreRootFS,
+		IgnoreVolumes:  query.IgnoreVolumes,
+		FileLocks:      query.FileLocks,
+	}
+
+	if query.Export {
+		f, err := os.CreateTemp("", "pod-checkpoint")
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer os.Remove(f.Name())
+		if err := f.Close(); err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		options.Export = f.Name()
+	}
+
+	reports, err := containerEngine.PodCheckpoint(r.Context(), []string{name}, options)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if len(reports) != 1 {
+		utils.InternalServerError(w, fmt.Errorf("expected 1 checkpoint report but got %d", len(reports)))
+		return
+	}
+	if len(reports[0].Errs) > 0 {
+		utils.WriteResponse(w, http.StatusConflict, errorhandling.PodConflictErrorModel{
+			Id:   reports[0].Id,
+			Errs: errorhandling.ErrorsToStrings(reports[0].Errs),
+		})
+		return
+	}
+
+	if !query.Export {
+		utils.WriteResponse(w, http.StatusOK, reports[0])
+		return
+	}
+
+	f, err := os.Open(options.Export)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	defer f.Close()
+	utils.WriteResponse(w, http.StatusOK, f)
+}
+
+func PodRestore(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Keep            bool     `schema:"keep"`
+		TCPEstablished  bool     `schema:"tcpEstablished"`
+		TCPClose        bool     `schema:"tcpClose"`
+		Import          bool     `schema:"import"`
+		Name            string   `schema:"name"`
+		IgnoreRootFS    bool     `schema:"ignoreRootFS"`
+		IgnoreVolumes   bool     `schema:"ignoreVolumes"`
+		IgnoreStaticIP  bool     `schema:"ignoreStaticIP"`
+		IgnoreStaticMAC bool     `schema:"ignoreStaticMAC"`
+		FileLocks       bool     `schema:"fileLocks"`
+		PublishPorts    []string `schema:"publishPorts"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	options := entities.PodRestoreOptions{
+		Name:            query.Name,
+		Keep:            query.Keep,
+		TCPEstablished:  query.TCPEstablished,
+		TCPClose:        query.TCPClose,
+		IgnoreRootFS:    query.IgnoreRootFS,
+		IgnoreVolumes:   query.IgnoreVolumes,
+		IgnoreStaticIP:  query.IgnoreStaticIP,
+		IgnoreStaticMAC: query.IgnoreStaticMAC,
+		FileLocks:       query.FileLocks,
+		PublishPorts:    query.PublishPorts,
+	}
+
+	var names []string
+	if query.Import {
+		t, err := os.CreateTemp("", "pod-restore")
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer os.Remove(t.Name())
+		if err := compat.SaveFromBody(t, r); err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		options.Import = t.Name()
+	} else {
+		name := utils.GetName(r)
+		if _, err := runtime.LookupPod(name); err != nil {
+			utils.PodNotFound(w, name, err)
+			return
+		}
+		names = []string{name}
+	}
+
+	reports, err := containerEngine.PodRestore(r.Context(), names, options)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if len(reports) != 1 {
+		utils.InternalServerError(w, fmt.Errorf("expected 1 restore report but got %d", len(reports)))
+		return
+	}
+	if len(reports[0].Errs) > 0 {
+		utils.WriteResponse(w, http.StatusConflict, errorhandling.PodConflictErrorModel{
+			Id:   reports[0].Id,
+			Errs: errorhandling.ErrorsToStrings(reports[0].Errs),
+		})
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports[0])
+}
+
+func PodWait(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Conditions          []string `schema:"condition"`
+		Interval            string   `schema:"interval"`
+		Any                 bool     `schema:"any"`
+		ExitCodePropagation string   `schema:"exitCodePropagation"`
+	}{
+		Interval: libpod.DefaultWaitInterval.String(),
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	interval, err := time.ParseDuration(query.Interval)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid interval %q: %w", query.Interval, err))
+		return
+	}
+
+	name := utils.GetName(r)
+	pod, err := runtime.LookupPod(name)
+	if err != nil {
+		utils.PodNotFound(w, name, err)
+		return
+	}
+
+	options := libpod.PodWaitOptions{
+		Conditions: query.Conditions,
+		Interval:   interval,
+		Any:        query.Any,
+	}
+	if query.ExitCodePropagation != "" {
+		options.ExitCodePropagation, err = define.ParseKubeExitCodePropagation(query.ExitCodePropagation)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	exitCode, err := pod.Wait(r.Context(), options)
+	if err != nil {
+		if errors.Is(err, define.ErrPodRemoved) {
+			utils.PodNotFound(w, name, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, entities.PodWaitReport{Id: pod.ID(), ExitCode: exitCode})
+}
+
+func PodEvents(w http.ResponseWriter, r *http.Request) {
+	var fromStart bool
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	// NOTE: the "filters" parameter is extracted separately via
+	// `FiltersFromRequest()`.
+	query := struct {
+		Since  string `schema:"since"`
+		Until  string `schema:"until"`
+		Stream bool   `schema:"stream"`
+	}{
+		Stream: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if len(query.Since) > 0 || len(query.Until) > 0 {
+		fromStart = true
+	}
+
+	libpodFilters, err := util.FiltersFromRequest(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse filters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	name := utils.GetName(r)
+	pod, err := runtime.LookupPod(name)
+	if err != nil {
+		utils.PodNotFound(w, name, err)
+		return
+	}
+
+	eventChannel := make(chan events.ReadResult)
+	readOpts := events.ReadOptions{
+		FromStart:    fromStart,
+		Stream:       query.Stream,
+		Filters:      libpodFilters,
+		EventChannel: eventChannel,
+		Since:        query.Since,
+		Until:        query.Until,
+	}
+	if err := pod.Events(r.Context(), readOpts); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok {
+		flush = flusher.Flush
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	coder := json.NewEncoder(w)
+	coder.SetEscapeHTML(true)
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case evt, ok := <-eventChannel:
+			if !ok {
+				return
+			}
+			if evt.Error != nil {
+				logrus.Errorf("Unable to read event: %q", evt.Error)
+				continue
+			}
+			if evt.Event == nil {
+				continue
+			}
+			if err := coder.Encode(entities.ConvertToEntitiesEvent(*evt.Event)); err != nil {
+				logrus.Errorf("Unable to write json: %q", err)
+			}
+			flush()
+		}
+	}
+}
+
+func PodUpdate(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	name := utils.GetName(r)
+	query := struct {
+		RestartPolicy  string `schema:"restartPolicy"`
+		RestartRetries uint   `schema:"restartRetries"`
+	}{
+		// override any golang type defaults
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	pod, err := runtime.LookupPod(name)
+	if err != nil {
+		utils.PodNotFound(w, name, err)
+		return
+	}
+
+	var restartPolicy *string
+	var restartRetries *uint
+	if query.RestartPolicy != "" {
+		restartPolicy = &query.RestartPolicy
+		if query.RestartPolicy == define.RestartPolicyOnFailure {
+			restartRetries = &query.RestartRetries
+		} else if query.RestartRetries != 0 {
+			utils.Error(w, http.StatusBadRequest, errors.New("cannot set restart retries unless restart policy is on-failure"))
+			return
+		}
+	} else if query.RestartRetries != 0 {
+		utils.Error(w, http.StatusBadRequest, errors.New("cannot set restart retries unless restart policy is set"))
+		return
+	}
+
+	options := &handlers.PodUpdateEntities{}
+	if err := utils.ReadJSONFromBody(r, options); err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	updateOptions := &entities.PodUpdateOptions{
+		RestartPolicy:  restartPolicy,
+		RestartRetries: restartRetries,
+		Labels:         options.Labels,
+		UnsetLabels:    options.UnsetLabels,
+	}
+	// Only touch the pod cgroup when the client asked for a resource change.
+	if !reflect.DeepEqual(options.LinuxResources, specs.LinuxResources{}) || !reflect.DeepEqual(options.UpdateContainerDevicesLimits, define.UpdateContainerDevicesLimits{}) {
+		updateOptions.Resources, err = specgenutil.UpdateMajorAndMinorNumbers(&options.LinuxResources, &options.UpdateContainerDevicesLimits)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+	}
+
+	if err := pod.Update(r.Context(), updateOptions); err != nil {
+		if errors.Is(err, define.ErrInvalidArg) {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusCreated, pod.ID())
+}
+
 func PodTop(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
@@ -3,12 +3,17 @@
 package libpod
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gorilla/schema"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/secrets"
 	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/pkg/api/handlers/utils"
 	api "go.podman.io/podman/v6/pkg/api/types"
 	"go.podman.io/podman/v6/pkg/domain/entities"
@@ -59,18 +64,98 @@ func SystemPrune(w http.ResponseWriter, r *http.Request) {
 }
 
 func DiskUsage(w http.ResponseWriter, r *http.Request) {
-	// Options are only used by the CLI
-	options := entities.SystemDfOptions{}
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	query := struct {
+		GroupBy   string `schema:"group_by"`
+		Exclusive bool   `schema:"exclusive"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// Format and Verbose are only used by the CLI
+	options := entities.SystemDfOptions{
+		GroupBy:   query.GroupBy,
+		Exclusive: query.Exclusive,
+	}
 	ic := abi.ContainerEngine{Libpod: runtime}
 	response, err := ic.SystemDf(r.Context(), options)
 	if err != nil {
+		if errors.Is(err, define.ErrInvalidArg) {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
 		utils.InternalServerError(w, err)
 		return
 	}
 	utils.WriteResponse(w, http.StatusOK, response)
 }
 
+func SystemGC(w http.ResponseWriter, r *http.Request) {
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	query := struct {
+		DryRun          bool     `schema:"dry_run"`
+		ContainerTTL    string   `schema:"container_ttl"`
+		KeepLabel       string   `schema:"keep_label"`
+		ImageUnusedFor  string   `schema:"image_unused_for"`
+		ProtectedImages []string `schema:"protected_images"`
+		MaxDiskUsage    int      `schema:"max_disk_usage"`
+		BuildCache      bool     `schema:"build_cache"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	gcOptions := entities.SystemGCOptions{
+		DryRun:          query.DryRun,
+		KeepLabel:       query.KeepLabel,
+		ProtectedImages: query.ProtectedImages,
+		MaxDiskUsage:    query.MaxDiskUsage,
+		BuildCache:      query.BuildCache,
+	}
+	if query.ContainerTTL != "" {
+		duration, err := time.ParseDuration(query.ContainerTTL)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest,
+				fmt.Errorf("failed to parse container_ttl parameter %q for %s: %w", query.ContainerTTL, r.URL.String(), err))
+			return
+		}
+		gcOptions.ContainerTTL = duration
+	}
+	if query.ImageUnusedFor != "" {
+		duration, err := time.ParseDuration(query.ImageUnusedFor)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest,
+				fmt.Errorf("failed to parse image_unused_for parameter %q for %s: %w", query.ImageUnusedFor, r.URL.String(), err))
+			return
+		}
+		gcOptions.ImageUnusedFor = duration
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.SystemGC(r.Context(), gcOptions)
+	if err != nil {
+		if errors.Is(err, define.ErrInvalidArg) {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
 func SystemCheck(w http.ResponseWriter, r *http.Request) {
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
@@ -114,3 +199,140 @@ func SystemCheck(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteResponse(w, http.StatusOK, report)
 }
+
+// SystemMonitor streams samples of the resource usage of the host and of
+// the engine
+func SystemMonitor(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		Stream   bool `schema:"stream"`
+		Interval int  `schema:"interval"`
+	}{
+		Stream:   true,
+		Interval: 5,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Interval < 1 {
+		utils.Error(w, http.StatusBadRequest, errors.New("invalid interval, must be a positive number greater zero"))
+		return
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	// The monitor stops when the connection is closed.
+	reportChan, err := containerEngine.SystemMonitor(r.Context(), entities.SystemMonitorOptions{
+		Stream:   query.Stream,
+		Interval: query.Interval,
+	})
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	// Usage is sampled live, a reconnecting Server-Sent Events client
+	// simply receives the next samples.
+	var sse *utils.EventStream
+	wroteContent := false
+	coder := json.NewEncoder(w)
+	coder.SetEscapeHTML(true)
+
+	for report := range reportChan {
+		if report.Error != nil {
+			if !wroteContent {
+				utils.InternalServerError(w, report.Error)
+				return
+			}
+			// The error cannot be encoded, end the stream instead
+			logrus.Errorf("Unable to sample system usage: %v", report.Error)
+			return
+		}
+		if !wroteContent {
+			wroteContent = true
+			if utils.WantsEventStream(r) {
+				sse = utils.NewEventStream(w)
+			} else {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+			}
+		}
+
+		if sse != nil {
+			if err := sse.Send(utils.ResumeToken(report.Usage.Time), "usage", report); err != nil {
+				logrus.Errorf("Unable to write system usage: %v", err)
+				return
+			}
+			continue
+		}
+
+		if err := coder.Encode(report); err != nil {
+			logrus.Errorf("Unable to encode system usage: %v", err)
+			return
+		}
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+}
+
+// SystemBackup writes a backup archive of the engine state
+func SystemBackup(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		Volumes    bool `schema:"volumes"`
+		Images     bool `schema:"images"`
+		SecretData bool `schema:"secretData"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// set the correct header
+	w.Header().Set("Content-Type", "application/x-tar")
+	// NOTE: As described in w.Write() it automatically sets the http code to
+	// 200 on first write if no other code was set. The archive is only
+	// written once it is complete.
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	if err := containerEngine.SystemBackup(r.Context(), entities.SystemBackupOptions{
+		Output:     w,
+		Volumes:    query.Volumes,
+		Images:     query.Images,
+		SecretData: query.SecretData,
+	}); err != nil {
+		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("failed to back up the engine state: %w", err))
+		return
+	}
+}
+
+// SystemRestore recreates the objects of a backup archive of the engine state
+func SystemRestore(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	if r.Body == nil {
+		utils.Error(w, http.StatusBadRequest, errors.New("must provide backup archive to restore in request body"))
+		return
+	}
+	defer r.Body.Close()
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.SystemRestore(r.Context(), entities.SystemRestoreOptions{Input: r.Body})
+	if err != nil {
+		switch {
+		case errors.Is(err, define.ErrCtrExists), errors.Is(err, define.ErrPodExists), errors.Is(err, define.ErrVolumeExists):
+			utils.Error(w, http.StatusConflict, err)
+		case errors.Is(err, secrets.ErrNoSuchSecret):
+			utils.Error(w, http.StatusNotFound, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
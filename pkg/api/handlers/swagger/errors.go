@@ -44,6 +44,13 @@ type artifactBadAuth struct {
 	Body errorhandling.ErrorModel
 }
 
+// Registry authentication failed
+// swagger:response
+type registryBadAuth struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // Error from registry
 // swagger:response
 type errorFromRegistry struct {
@@ -79,6 +86,13 @@ type volumeNotFound struct {
 	Body errorhandling.ErrorModel
 }
 
+// No such plugin
+// swagger:response
+type pluginNotFound struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // No such pod
 // swagger:response
 type podNotFound struct {
@@ -114,6 +128,13 @@ type conflictError struct {
 	Body errorhandling.ErrorModel
 }
 
+// Idempotency key used with a different request
+// swagger:response
+type idempotencyKeyReused struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // Bad parameter in request
 // swagger:response
 type badParamError struct {
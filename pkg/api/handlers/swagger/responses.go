@@ -7,6 +7,7 @@ import (
 	"github.com/moby/moby/api/types/container"
 	dockerImage "github.com/moby/moby/api/types/image"
 	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/plugin"
 	"github.com/moby/moby/api/types/registry"
 	"github.com/moby/moby/api/types/volume"
 	"go.podman.io/common/libnetwork/types"
@@ -91,6 +92,13 @@ type imageDeleteResponse struct {
 	}
 }
 
+// Distribution Inspect
+// swagger:response
+type distributionInspectResponse struct {
+	// in:body
+	Body registry.DistributionInspect
+}
+
 // Registry Search
 // swagger:response
 type registrySearchResponse struct {
@@ -189,6 +197,13 @@ type systemCheckResponse struct {
 	Body entities.SystemCheckReport
 }
 
+// Garbage collection
+// swagger:response
+type systemGCResponse struct {
+	// in:body
+	Body entities.SystemGCReport
+}
+
 // Disk usage
 // swagger:response
 type systemDiskUsage struct {
@@ -196,6 +211,20 @@ type systemDiskUsage struct {
 	Body entities.SystemDfReport
 }
 
+// System usage
+// swagger:response
+type systemMonitor struct {
+	// in:body
+	Body entities.SystemMonitorReport
+}
+
+// System restore
+// swagger:response
+type systemRestore struct {
+	// in:body
+	Body entities.SystemRestoreReport
+}
+
 // System Prune results
 // swagger:response
 type systemPruneResponse struct {
@@ -266,6 +295,34 @@ type volumeListLibpod struct {
 	Body []entities.VolumeConfigResponse
 }
 
+// Plugin list
+// swagger:response
+type pluginList struct {
+	// in:body
+	Body plugin.ListResponse
+}
+
+// Plugin inspect
+// swagger:response
+type pluginInspect struct {
+	// in:body
+	Body plugin.Plugin
+}
+
+// Plugin list
+// swagger:response
+type pluginListLibpod struct {
+	// in:body
+	Body []entities.PluginReport
+}
+
+// Plugin inspect
+// swagger:response
+type pluginInspectLibpod struct {
+	// in:body
+	Body entities.PluginReport
+}
+
 // Image Prune
 // swagger:response
 type imagesPruneLibpod struct {
@@ -358,6 +415,13 @@ type containersListLibpod struct {
 	Body []entities.ListContainer
 }
 
+// List removed containers
+// swagger:response
+type containersListRemovedLibpod struct {
+	// in:body
+	Body []entities.ContainerTombstone
+}
+
 // Inspect Manifest
 // swagger:response
 type manifestInspect struct {
@@ -372,6 +436,27 @@ type podKillResponse struct {
 	Body entities.PodKillReport
 }
 
+// Checkpoint pod
+// swagger:response
+type podCheckpointResponse struct {
+	// in:body
+	Body entities.PodCheckpointReport
+}
+
+// Restore pod
+// swagger:response
+type podRestoreResponse struct {
+	// in:body
+	Body entities.PodRestoreReport
+}
+
+// Wait pod
+// swagger:response
+type podWaitResponse struct {
+	// in:body
+	Body entities.PodWaitReport
+}
+
 // Pause pod
 // swagger:response
 type podPauseResponse struct {
@@ -386,6 +471,15 @@ type podUnpauseResponse struct {
 	Body entities.PodUnpauseReport
 }
 
+// Update pod
+// swagger:response
+type podUpdateResponse struct {
+	// in:body
+	Body struct {
+		ID string
+	}
+}
+
 // Stop pod
 // swagger:response
 type podStopResponse struct {
@@ -99,6 +99,15 @@ type UpdateEntities struct {
 	Rlimits  []specs.POSIXRlimit `json:"r_limits,omitempty"`
 }
 
+// PodUpdateEntities used to wrap the pod update options in a swagger model
+// swagger:model
+type PodUpdateEntities struct {
+	specs.LinuxResources
+	define.UpdateContainerDevicesLimits
+	Labels      map[string]string
+	UnsetLabels []string
+}
+
 type Info struct {
 	dockerSystem.Info
 	BuildahVersion     string
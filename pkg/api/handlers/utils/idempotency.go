@@ -0,0 +1,130 @@
+//go:build !remote
+
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying an idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the response to a request whose
+	// result was replayed from an earlier request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// idempotencyKeyLifetime is how long a key is honored after the object
+	// was created
+	idempotencyKeyLifetime  = 24 * time.Hour
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyLocks holds a lock for each idempotency key in use, so
+// concurrent retries of one request cannot both create an object while
+// requests with different keys are not serialized
+var idempotencyLocks = struct {
+	sync.Mutex
+	keys map[string]*idempotencyLock
+}{keys: make(map[string]*idempotencyLock)}
+
+type idempotencyLock struct {
+	sync.Mutex
+	// users is the number of requests holding or waiting for the lock,
+	// it is removed from idempotencyLocks by the last one
+	users int
+}
+
+// IdempotencyKey returns the idempotency key supplied with the request, or
+// nil if there is none.  The key holds a digest of the query and body of the
+// request; the body is read and restored so the handler can decode it.  The
+// path is left out, as it holds the API version, and a retry by an upgraded
+// client is the same request.
+func IdempotencyKey(r *http.Request) (*define.IdempotencyKey, error) {
+	values := r.Header.Values(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if len(values) > 1 {
+		return nil, fmt.Errorf("only one %s header may be given", IdempotencyKeyHeader)
+	}
+	key := values[0]
+	if err := validateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	h := sha256.New()
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return &define.IdempotencyKey{
+		Key:           key,
+		RequestDigest: fmt.Sprintf("sha256:%x", h.Sum(nil)),
+		Expires:       time.Now().Add(idempotencyKeyLifetime),
+	}, nil
+}
+
+func validateIdempotencyKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("%s header must not be empty", IdempotencyKeyHeader)
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return fmt.Errorf("%s header must not be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+	for _, c := range key {
+		if c < 0x21 || c > 0x7e {
+			return errors.New(IdempotencyKeyHeader + " header must only hold printable ASCII characters")
+		}
+	}
+	return nil
+}
+
+// LockIdempotency must be held while looking up the object created with key
+// and creating it if there is none.  Only requests with the same key wait for
+// each other.  It returns the function releasing the lock, a nil key takes no
+// lock.
+func LockIdempotency(key *define.IdempotencyKey) func() {
+	if key == nil {
+		return func() {}
+	}
+	idempotencyLocks.Lock()
+	l, ok := idempotencyLocks.keys[key.Key]
+	if !ok {
+		l = &idempotencyLock{}
+		idempotencyLocks.keys[key.Key] = l
+	}
+	l.users++
+	idempotencyLocks.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		idempotencyLocks.Lock()
+		l.users--
+		if l.users == 0 {
+			delete(idempotencyLocks.keys, key.Key)
+		}
+		idempotencyLocks.Unlock()
+	}
+}
+
+// IdempotencyReplayed marks the response as replaying the result of an
+// earlier request
+func IdempotencyReplayed(w http.ResponseWriter) {
+	w.Header().Set(IdempotentReplayedHeader, "true")
+}
@@ -0,0 +1,113 @@
+//go:build !remote
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	newRequest := func(key, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/v6.0.0/libpod/containers/create", strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+		return r
+	}
+
+	key, err := IdempotencyKey(newRequest("", `{"image":"alpine"}`))
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	r := newRequest("retry-1", `{"image":"alpine"}`)
+	key, err = IdempotencyKey(r)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.Equal(t, "retry-1", key.Key)
+	assert.True(t, key.Expires.After(time.Now()))
+	assert.False(t, key.Expired())
+
+	// The body is still available to the handler
+	body, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"image":"alpine"}`, string(body))
+
+	same, err := IdempotencyKey(newRequest("retry-1", `{"image":"alpine"}`))
+	require.NoError(t, err)
+	assert.Equal(t, key.RequestDigest, same.RequestDigest)
+
+	other, err := IdempotencyKey(newRequest("retry-1", `{"image":"fedora"}`))
+	require.NoError(t, err)
+	assert.NotEqual(t, key.RequestDigest, other.RequestDigest)
+
+	// A retry with another API version is the same request
+	r = httptest.NewRequest(http.MethodPost, "/v5.0.0/libpod/containers/create", strings.NewReader(`{"image":"alpine"}`))
+	r.Header.Set(IdempotencyKeyHeader, "retry-1")
+	upgraded, err := IdempotencyKey(r)
+	require.NoError(t, err)
+	assert.Equal(t, key.RequestDigest, upgraded.RequestDigest)
+}
+
+func TestLockIdempotency(t *testing.T) {
+	unlock := LockIdempotency(&define.IdempotencyKey{Key: "one"})
+
+	// Another key is not blocked
+	done := make(chan struct{})
+	go func() {
+		LockIdempotency(&define.IdempotencyKey{Key: "two"})()
+		LockIdempotency(nil)()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("lock of another key blocked")
+	}
+
+	// The same key waits for the lock to be released
+	locked := make(chan struct{})
+	go func() {
+		LockIdempotency(&define.IdempotencyKey{Key: "one"})()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("lock of the same key not blocked")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	idempotencyLocks.Lock()
+	assert.Empty(t, idempotencyLocks.keys)
+	idempotencyLocks.Unlock()
+}
+
+func TestIdempotencyKeyInvalid(t *testing.T) {
+	for _, key := range []string{
+		"with space",
+		"tab\there",
+		"ünicode",
+		strings.Repeat("k", maxIdempotencyKeyLength+1),
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/v6.0.0/libpod/pods/create", strings.NewReader("{}"))
+		r.Header.Set(IdempotencyKeyHeader, key)
+		_, err := IdempotencyKey(r)
+		assert.Error(t, err, "key %q", key)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/v6.0.0/libpod/pods/create", strings.NewReader("{}"))
+	r.Header.Add(IdempotencyKeyHeader, "one")
+	r.Header.Add(IdempotencyKeyHeader, "two")
+	_, err := IdempotencyKey(r)
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+//go:build !remote
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// EventStreamContentType is the media type of Server-Sent Events
+	EventStreamContentType = "text/event-stream"
+	// eventStreamRetry is the reconnection delay suggested to clients, in
+	// milliseconds
+	eventStreamRetry = 3000
+)
+
+// WantsEventStream returns true if the request is a libpod request asking
+// for its response to be streamed as Server-Sent Events
+func WantsEventStream(r *http.Request) bool {
+	if !IsLibpodRequest(r) {
+		return false
+	}
+	for _, accept := range r.Header.Values("Accept") {
+		for mediaRange := range strings.SplitSeq(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(mediaRange)
+			if err == nil && mediaType == EventStreamContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResumeToken formats the time of an event as SSE event ID, for streams
+// which cannot be resumed.
+func ResumeToken(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// EventID identifies an event of a resumable stream by its time and the
+// number of events of the stream sent before it with the same time, as
+// several events can share a time.
+type EventID struct {
+	Time time.Time
+	Seq  int
+}
+
+// String formats the ID as "<time>" for the first event with a time and as
+// "<time>#<seq>" for the following ones
+func (id EventID) String() string {
+	t := ResumeToken(id.Time)
+	if id.Seq == 0 {
+		return t
+	}
+	return t + "#" + strconv.Itoa(id.Seq)
+}
+
+// Since returns the time to read the events of a resumed stream from.  Since
+// times are exclusive, it is just before the time of the ID, so the events
+// with the same time that the client did not receive yet are not lost.
+func (id EventID) Since() time.Time {
+	return id.Time.Add(-time.Nanosecond)
+}
+
+// LastEventID returns the ID of the last event received by a reconnecting
+// client, or nil if the client is not reconnecting
+func LastEventID(r *http.Request) (*EventID, error) {
+	header := r.Header.Get("Last-Event-ID")
+	if header == "" {
+		return nil, nil
+	}
+	ts, seq, hasSeq := strings.Cut(header, "#")
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Last-Event-ID %q: %w", header, err)
+	}
+	id := &EventID{Time: t}
+	if hasSeq {
+		id.Seq, err = strconv.Atoi(seq)
+		if err != nil || id.Seq < 0 {
+			return nil, fmt.Errorf("invalid Last-Event-ID %q: invalid sequence number", header)
+		}
+	}
+	return id, nil
+}
+
+// EventStream writes a response as Server-Sent Events
+type EventStream struct {
+	w     http.ResponseWriter
+	flush func()
+	buf   bytes.Buffer
+
+	// last is the ID of the last event sent by SendAt, or received by
+	// the resuming client
+	last EventID
+	// resumed is the time of the last event received by the resuming
+	// client, older events are not sent again
+	resumed time.Time
+	// skip is the number of events with the time of last that the
+	// resuming client received already
+	skip int
+}
+
+// NewEventStream writes the header of an event stream response
+func NewEventStream(w http.ResponseWriter) *EventStream {
+	s := &EventStream{w: w, flush: func() {}}
+	if flusher, ok := w.(http.Flusher); ok {
+		s.flush = flusher.Flush
+	}
+
+	w.Header().Set("Content-Type", EventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	// Ask reverse proxies not to buffer the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry)
+	s.flush()
+	return s
+}
+
+// Resume makes SendAt skip the events up to and including the last event
+// received by a reconnecting client.  The events must be sent in the same
+// order, with the same filters, as on the stream the client received.
+func (s *EventStream) Resume(last *EventID) {
+	if last == nil {
+		return
+	}
+	s.last = *last
+	s.resumed = last.Time
+	s.skip = last.Seq + 1
+}
+
+// SendAt writes an event with the given time, unless the client received it
+// already before resuming the stream.  Its ID is derived from the time, see
+// EventID.
+func (s *EventStream) SendAt(t time.Time, event string, data any) error {
+	switch {
+	case t.Before(s.resumed):
+		return nil
+	case t.Equal(s.last.Time):
+		if s.skip > 0 {
+			s.skip--
+			return nil
+		}
+		s.last.Seq++
+	default:
+		s.last = EventID{Time: t}
+		s.skip = 0
+	}
+	return s.Send(s.last.String(), event, data)
+}
+
+// Send writes an event with the JSON encoded data.  The id is sent back by
+// the client as Last-Event-ID when reconnecting.
+func (s *EventStream) Send(id, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.buf.Reset()
+	if id != "" {
+		fmt.Fprintf(&s.buf, "id: %s\n", id)
+	}
+	if event != "" {
+		fmt.Fprintf(&s.buf, "event: %s\n", event)
+	}
+	// JSON output never holds a raw newline, so the data fits on one line
+	fmt.Fprintf(&s.buf, "data: %s\n\n", payload)
+	if _, err := s.w.Write(s.buf.Bytes()); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
@@ -0,0 +1,106 @@
+//go:build !remote
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWantsEventStream(t *testing.T) {
+	for _, tc := range []struct {
+		path   string
+		accept string
+		want   bool
+	}{
+		{"/v6.0.0/libpod/events", "text/event-stream", true},
+		{"/v6.0.0/libpod/events", "application/json, text/event-stream;q=0.9", true},
+		{"/v6.0.0/libpod/events", "application/json", false},
+		{"/v6.0.0/libpod/events", "", false},
+		{"/v1.41/events", "text/event-stream", false},
+	} {
+		r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.accept != "" {
+			r.Header.Set("Accept", tc.accept)
+		}
+		assert.Equal(t, tc.want, WantsEventStream(r), "%s with Accept %q", tc.path, tc.accept)
+	}
+}
+
+func TestLastEventID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v6.0.0/libpod/events", nil)
+	last, err := LastEventID(r)
+	require.NoError(t, err)
+	assert.Nil(t, last)
+
+	eventTime := time.Date(2026, 10, 19, 8, 30, 0, 123456789, time.FixedZone("CEST", 2*60*60))
+	r.Header.Set("Last-Event-ID", EventID{Time: eventTime}.String())
+	last, err = LastEventID(r)
+	require.NoError(t, err)
+	assert.True(t, last.Time.Equal(eventTime))
+	assert.Equal(t, 0, last.Seq)
+
+	r.Header.Set("Last-Event-ID", EventID{Time: eventTime, Seq: 2}.String())
+	last, err = LastEventID(r)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-19T06:30:00.123456789Z#2", last.String())
+	assert.True(t, last.Since().Before(eventTime))
+
+	r.Header.Set("Last-Event-ID", "yesterday")
+	_, err = LastEventID(r)
+	assert.ErrorContains(t, err, `invalid Last-Event-ID "yesterday"`)
+
+	r.Header.Set("Last-Event-ID", "2026-10-19T06:30:00Z#-1")
+	_, err = LastEventID(r)
+	assert.ErrorContains(t, err, "invalid sequence number")
+}
+
+func TestEventStreamResume(t *testing.T) {
+	t1 := time.Date(2026, 10, 19, 6, 30, 0, 0, time.UTC)
+	t2 := t1.Add(time.Second)
+	events := []struct {
+		time time.Time
+		name string
+	}{{t1, "a"}, {t2, "b"}, {t2, "c"}, {t2, "d"}, {t2.Add(time.Second), "e"}}
+
+	send := func(sse *EventStream) {
+		for _, e := range events {
+			require.NoError(t, sse.SendAt(e.time, "", e.name))
+		}
+	}
+
+	w := httptest.NewRecorder()
+	send(NewEventStream(w))
+	assert.Contains(t, w.Body.String(), "id: 2026-10-19T06:30:01Z\ndata: \"b\"")
+	assert.Contains(t, w.Body.String(), "id: 2026-10-19T06:30:01Z#1\ndata: \"c\"")
+
+	// The client received the event "c", which shares its time with "b"
+	// and "d"; only "d" and "e" are sent again.
+	w = httptest.NewRecorder()
+	sse := NewEventStream(w)
+	sse.Resume(&EventID{Time: t2, Seq: 1})
+	send(sse)
+	assert.Equal(t, "retry: 3000\n\n"+
+		"id: 2026-10-19T06:30:01Z#2\ndata: \"d\"\n\n"+
+		"id: 2026-10-19T06:30:02Z\ndata: \"e\"\n\n", w.Body.String())
+}
+
+func TestEventStream(t *testing.T) {
+	w := httptest.NewRecorder()
+	sse := NewEventStream(w)
+	require.NoError(t, sse.Send("2026-10-19T06:30:00.5Z", "container", map[string]string{"Action": "start"}))
+	require.NoError(t, sse.Send("", "", "multi\nline"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, EventStreamContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.True(t, w.Flushed)
+	assert.Equal(t, "retry: 3000\n\n"+
+		"id: 2026-10-19T06:30:00.5Z\nevent: container\ndata: {\"Action\":\"start\"}\n\n"+
+		"data: \"multi\\nline\"\n\n", w.Body.String())
+}
@@ -0,0 +1,227 @@
+//go:build !remote
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.podman.io/podman/v6/pkg/api/server/authz"
+)
+
+// Level of detail recorded for a request
+type Level string
+
+const (
+	// LevelNone does not record the request
+	LevelNone Level = "none"
+	// LevelMetadata records the request without headers and body
+	LevelMetadata Level = "metadata"
+	// LevelRequest records the request including headers and JSON body
+	LevelRequest Level = "request"
+)
+
+// Redacted replaces the value of sensitive headers, parameters and fields
+const Redacted = "<redacted>"
+
+var (
+	// defaultRedact are the headers, query parameters and body fields that
+	// are always redacted
+	defaultRedact = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+		"X-Registry-Auth",
+		"X-Registry-Config",
+		"Auth",
+		"Env",
+		"IdentityToken",
+		"Password",
+		"RegistryToken",
+	}
+
+	// secretEndpoints are never recorded with their body as it holds the
+	// secret data
+	secretEndpoints = []authz.Endpoint{
+		{Path: "/secrets/create"},
+		{Path: "/libpod/secrets/create"},
+		{Path: "/secrets/*/update"},
+	}
+)
+
+// Policy configures which requests are recorded
+type Policy struct {
+	// Rules override the level of matching requests, the first match wins.
+	// Requests not matched by any rule are recorded at the metadata level
+	// if they are mutating, and not recorded otherwise.
+	Rules []Rule `json:"rules,omitempty"`
+	// Redact lists additional headers, query parameters and body fields
+	// redacted for all requests
+	Redact []string `json:"redact,omitempty"`
+}
+
+// Rule sets the level of detail recorded for an endpoint
+type Rule struct {
+	authz.Endpoint
+	// Level of detail recorded
+	Level Level `json:"level"`
+	// Redact lists additional headers, query parameters and body fields
+	// redacted for the endpoint
+	Redact []string `json:"redact,omitempty"`
+}
+
+// Record describes an API request and its outcome
+type Record struct {
+	// Time the request was received
+	Time time.Time `json:"time"`
+	// RequestID is the X-Reference-Id of the request
+	RequestID string `json:"requestId"`
+	// Identity of the client
+	Identity string `json:"identity"`
+	// Method of the request
+	Method string `json:"method"`
+	// Path of the request
+	Path string `json:"path"`
+	// Query parameters of the request
+	Query url.Values `json:"query,omitempty"`
+	// Objects are the IDs or names of the objects targeted or created
+	Objects []string `json:"objects,omitempty"`
+	// Status code of the response
+	Status int `json:"status"`
+	// DurationMS is the time taken to handle the request in milliseconds
+	DurationMS int64 `json:"durationMs"`
+	// Headers of the request, only recorded at the request level
+	Headers http.Header `json:"headers,omitempty"`
+	// Body of the request, only recorded at the request level for JSON
+	// bodies
+	Body any `json:"body,omitempty"`
+}
+
+// LoadPolicy reads and validates the policy file
+func LoadPolicy(file string) (*Policy, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading audit policy: %w", err)
+	}
+	policy := new(Policy)
+	if err := json.Unmarshal(content, policy); err != nil {
+		return nil, fmt.Errorf("parsing audit policy %s: %w", file, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid audit policy %s: %w", file, err)
+	}
+	return policy, nil
+}
+
+// Validate checks that the policy is consistent
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+		switch rule.Level {
+		case LevelNone, LevelMetadata, LevelRequest:
+		default:
+			return fmt.Errorf("rule %d: invalid level %q, must be one of %q, %q or %q", i, rule.Level, LevelNone, LevelMetadata, LevelRequest)
+		}
+	}
+	return nil
+}
+
+// Evaluate returns the level of detail recorded for the request and the
+// redactor to apply to it
+func (p *Policy) Evaluate(method, requestPath string) (Level, *Redactor) {
+	redact := slices.Concat(defaultRedact, p.Redact)
+	level := LevelNone
+	if isMutating(method) {
+		level = LevelMetadata
+	}
+	for _, rule := range p.Rules {
+		if rule.Matches(method, requestPath) {
+			level = rule.Level
+			redact = append(redact, rule.Redact...)
+			break
+		}
+	}
+	if level == LevelRequest && slices.ContainsFunc(secretEndpoints, func(e authz.Endpoint) bool {
+		return e.Matches(method, requestPath)
+	}) {
+		level = LevelMetadata
+	}
+	return level, newRedactor(redact)
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// Redactor replaces the values of sensitive headers, query parameters and
+// body fields, names are matched case insensitively
+type Redactor struct {
+	names map[string]struct{}
+}
+
+func newRedactor(names []string) *Redactor {
+	r := &Redactor{names: make(map[string]struct{}, len(names))}
+	for _, n := range names {
+		r.names[strings.ToLower(n)] = struct{}{}
+	}
+	return r
+}
+
+func (r *Redactor) sensitive(name string) bool {
+	_, ok := r.names[strings.ToLower(name)]
+	return ok
+}
+
+// Header returns a copy of the headers with sensitive values redacted
+func (r *Redactor) Header(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, v := range h {
+		if r.sensitive(k) {
+			out[k] = []string{Redacted}
+			continue
+		}
+		out[k] = slices.Clone(v)
+	}
+	return out
+}
+
+// Query returns a copy of the query parameters with sensitive values
+// redacted
+func (r *Redactor) Query(q url.Values) url.Values {
+	if len(q) == 0 {
+		return nil
+	}
+	return url.Values(r.Header(http.Header(q)))
+}
+
+// Body returns the decoded JSON body with sensitive fields redacted, at any
+// depth
+func (r *Redactor) Body(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if r.sensitive(k) {
+				t[k] = Redacted
+				continue
+			}
+			t[k] = r.Body(val)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = r.Body(val)
+		}
+	}
+	return v
+}
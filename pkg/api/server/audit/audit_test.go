@@ -0,0 +1,180 @@
+//go:build !remote
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/pkg/api/server/authz"
+)
+
+func TestEvaluate(t *testing.T) {
+	policy := &Policy{
+		Rules: []Rule{
+			{Endpoint: authz.Endpoint{Path: "/libpod/containers/*/wait"}, Level: LevelNone},
+			{Endpoint: authz.Endpoint{Methods: []string{"POST"}, Path: "/libpod/containers/create"}, Level: LevelRequest, Redact: []string{"Labels"}},
+			{Endpoint: authz.Endpoint{Path: "/libpod/secrets/create"}, Level: LevelRequest},
+			{Endpoint: authz.Endpoint{Path: "/libpod/info"}, Level: LevelMetadata},
+		},
+	}
+	require.NoError(t, policy.Validate())
+
+	for _, tc := range []struct {
+		method string
+		path   string
+		level  Level
+	}{
+		{"GET", "/v6.0.0/libpod/containers/json", LevelNone},
+		{"DELETE", "/v6.0.0/libpod/containers/abc", LevelMetadata},
+		{"POST", "/v6.0.0/libpod/containers/abc/wait", LevelNone},
+		{"POST", "/v6.0.0/libpod/containers/create", LevelRequest},
+		{"POST", "/v6.0.0/libpod/secrets/create", LevelMetadata},
+		{"GET", "/v6.0.0/libpod/info", LevelMetadata},
+	} {
+		level, _ := policy.Evaluate(tc.method, tc.path)
+		assert.Equal(t, tc.level, level, tc.method+" "+tc.path)
+	}
+
+	_, redactor := policy.Evaluate("POST", "/v6.0.0/libpod/containers/create")
+	assert.True(t, redactor.sensitive("labels"))
+	assert.True(t, redactor.sensitive("x-registry-auth"))
+	_, redactor = policy.Evaluate("POST", "/v6.0.0/libpod/pods/create")
+	assert.False(t, redactor.sensitive("labels"))
+
+	policy.Rules = append(policy.Rules, Rule{Endpoint: authz.Endpoint{Path: "/**"}, Level: "everything"})
+	assert.ErrorContains(t, policy.Validate(), `rule 4: invalid level "everything"`)
+}
+
+func TestRedactor(t *testing.T) {
+	redactor := newRedactor(append(defaultRedact, "token"))
+
+	header := http.Header{
+		"X-Registry-Auth": []string{"secret"},
+		"Content-Type":    []string{"application/json"},
+	}
+	redacted := redactor.Header(header)
+	assert.Equal(t, []string{Redacted}, redacted["X-Registry-Auth"])
+	assert.Equal(t, []string{"application/json"}, redacted["Content-Type"])
+	assert.Equal(t, []string{"secret"}, header["X-Registry-Auth"], "input must not be modified")
+
+	query := redactor.Query(url.Values{"token": []string{"abc"}, "all": []string{"true"}})
+	assert.Equal(t, url.Values{"token": []string{Redacted}, "all": []string{"true"}}, query)
+	assert.Nil(t, redactor.Query(url.Values{}))
+
+	var body any
+	require.NoError(t, json.Unmarshal([]byte(`{"image":"alpine","env":{"PASSWORD":"x"},"mounts":[{"password":"y","source":"/srv"}]}`), &body))
+	b, err := json.Marshal(redactor.Body(body))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"image":"alpine","env":"<redacted>","mounts":[{"password":"<redacted>","source":"/srv"}]}`, string(b))
+}
+
+func readRecords(t *testing.T, path string) []Record {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		records = append(records, rec)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	rec := &Record{Method: "POST", Path: "/libpod/containers/create", Objects: []string{"a"}, Status: http.StatusCreated}
+	line, err := json.Marshal(rec)
+	require.NoError(t, err)
+
+	// room for two records per file
+	sink, err := NewSink(path, int64(2*(len(line)+1)), 2)
+	require.NoError(t, err)
+
+	for i := range 7 {
+		rec.Objects = []string{string(rune('a' + i))}
+		require.NoError(t, sink.Write(rec))
+	}
+	require.NoError(t, sink.Close())
+	assert.Error(t, sink.Write(rec))
+
+	current := readRecords(t, path)
+	require.Len(t, current, 1)
+	assert.Equal(t, []string{"g"}, current[0].Objects)
+
+	rotated := readRecords(t, path+".1")
+	require.Len(t, rotated, 2)
+	assert.Equal(t, []string{"e"}, rotated[0].Objects)
+
+	rotated = readRecords(t, path+".2")
+	require.Len(t, rotated, 2)
+	assert.Equal(t, []string{"c"}, rotated[0].Objects)
+
+	assert.NoFileExists(t, path+".3")
+
+	// reopening appends to the existing log
+	sink, err = NewSink(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(rec))
+	require.NoError(t, sink.Close())
+	assert.Len(t, readRecords(t, path), 2)
+}
+
+func TestSinkRotationFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	rec := &Record{Method: "POST", Path: "/libpod/containers/create", Objects: []string{"a"}, Status: http.StatusCreated}
+	line, err := json.Marshal(rec)
+	require.NoError(t, err)
+
+	sink, err := NewSink(path, int64(len(line)+1), 1)
+	require.NoError(t, err)
+	defer sink.Close()
+	require.NoError(t, sink.Write(rec))
+
+	// A non-empty directory in place of the rotated file cannot be
+	// replaced, the record is written to the current file anyway
+	require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "dir"), 0o700))
+	rec.Objects = []string{"b"}
+	assert.ErrorContains(t, sink.Write(rec), "rotating audit log")
+	assert.Len(t, readRecords(t, path), 2)
+
+	// Once the obstacle is gone, rotation resumes
+	require.NoError(t, os.RemoveAll(path+".1"))
+	rec.Objects = []string{"c"}
+	require.NoError(t, sink.Write(rec))
+	current := readRecords(t, path)
+	require.Len(t, current, 1)
+	assert.Equal(t, []string{"c"}, current[0].Objects)
+	assert.Len(t, readRecords(t, path+".1"), 2)
+}
+
+func TestSinkRotationWithoutFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	rec := &Record{Method: "POST", Path: "/libpod/containers/create", Objects: []string{"a"}, Status: http.StatusCreated}
+	line, err := json.Marshal(rec)
+	require.NoError(t, err)
+
+	sink, err := NewSink(path, int64(len(line)+1), 0)
+	require.NoError(t, err)
+	for i := range 3 {
+		rec.Objects = []string{string(rune('a' + i))}
+		require.NoError(t, sink.Write(rec))
+	}
+	require.NoError(t, sink.Close())
+
+	current := readRecords(t, path)
+	require.Len(t, current, 1)
+	assert.Equal(t, []string{"c"}, current[0].Objects)
+	assert.NoFileExists(t, path+".1")
+}
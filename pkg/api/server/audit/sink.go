@@ -0,0 +1,133 @@
+//go:build !remote
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// Sink writes records as JSON lines to a file, rotating it when it exceeds
+// its maximum size.  The rotated files are named after the log file with a
+// numeric suffix, the most recent one being ".1".
+type Sink struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	lock     sync.Mutex
+	file     *os.File
+	size     int64
+}
+
+// NewSink opens the log file at path for appending.  A maxSize of 0
+// disables rotation, maxFiles is the number of rotated files kept.
+func NewSink(path string, maxSize int64, maxFiles int) (*Sink, error) {
+	s := &Sink{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Sink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// Write appends the record to the log file
+func (s *Sink) Write(rec *Record) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return errors.New("audit log is closed")
+	}
+	// A failed rotation leaves the current file open, so the record is
+	// never lost
+	var rotateErr error
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(b)) > s.maxSize {
+		rotateErr = s.rotate()
+	}
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return errors.Join(rotateErr, err)
+}
+
+// rotate shifts the rotated files, dropping the oldest one, and starts a
+// new log file.  The current file is only replaced once the new one is open,
+// so the sink keeps writing to it if rotation fails.  Must be called with
+// the lock held.
+func (s *Sink) rotate() error {
+	if s.maxFiles == 0 {
+		// Nothing is kept, start over in the same file
+		if err := s.file.Truncate(0); err != nil {
+			return fmt.Errorf("rotating audit log: %w", err)
+		}
+		s.size = 0
+		return nil
+	}
+	if err := os.Remove(s.rotatedPath(s.maxFiles)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	for i := s.maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(s.rotatedPath(i), s.rotatedPath(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("rotating audit log: %w", err)
+		}
+	}
+	if err := os.Rename(s.path, s.rotatedPath(1)); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	old := s.file
+	if err := s.open(); err != nil {
+		// Keep writing to the current file under its own name
+		if rbErr := os.Rename(s.rotatedPath(1), s.path); rbErr != nil {
+			err = errors.Join(err, fmt.Errorf("restoring audit log: %w", rbErr))
+		}
+		return err
+	}
+	if err := old.Close(); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	return nil
+}
+
+func (s *Sink) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// Close closes the log file
+func (s *Sink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,328 @@
+//go:build !remote
+
+package authz
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// versionPrefix matches the optional API version segment of a request path
+var versionPrefix = regexp.MustCompile(`^/v[0-9][0-9A-Za-z.-]*(/|$)`)
+
+// Policy maps client identities to roles, and roles to the endpoints and
+// container create options they are allowed to use
+type Policy struct {
+	// DefaultRole is assigned to clients not matched by any binding.
+	// When empty such clients are denied every request.
+	DefaultRole string `json:"defaultRole,omitempty"`
+	// Roles by name
+	Roles map[string]*Role `json:"roles"`
+	// Bindings assign roles to identities, the first match wins
+	Bindings []Binding `json:"bindings,omitempty"`
+}
+
+// Role is a named set of permissions
+type Role struct {
+	// Endpoints the role may call, no endpoints means nothing is allowed
+	Endpoints []Endpoint `json:"endpoints"`
+	// Create restricts the options used to create containers, pods and
+	// exec sessions
+	Create CreateRestrictions `json:"create"`
+}
+
+// Endpoint matches requests by method and path.  The path is matched with
+// the API version prefix removed, each segment is a path.Match pattern and
+// a final "**" segment matches any remaining segments.
+type Endpoint struct {
+	// Methods allowed, empty means all methods
+	Methods []string `json:"methods,omitempty"`
+	// Path pattern, for example "/libpod/containers/*/json"
+	Path string `json:"path"`
+}
+
+// CreateRestrictions lists the create options a role may use.  Every option
+// is forbidden unless allowed explicitly.
+type CreateRestrictions struct {
+	// AllowPrivileged allows privileged containers and exec sessions, and
+	// with them all host devices and capabilities
+	AllowPrivileged bool `json:"allowPrivileged,omitempty"`
+	// AllowHostNamespaces allows joining the host network, pid, ipc, uts,
+	// user and cgroup namespaces
+	AllowHostNamespaces bool `json:"allowHostNamespaces,omitempty"`
+	// AllowedHostPaths are the host directories, including everything
+	// below them, that may be bind mounted
+	AllowedHostPaths []string `json:"allowedHostPaths,omitempty"`
+	// AllowedDevices are the host devices, or directories of devices,
+	// that may be added to containers or mounted by volumes
+	AllowedDevices []string `json:"allowedDevices,omitempty"`
+	// AllowedCapabilities are the capabilities that may be added to
+	// containers, "ALL" allows every capability
+	AllowedCapabilities []string `json:"allowedCapabilities,omitempty"`
+}
+
+// Binding assigns a role to a client identity.  Exactly one of Subject and
+// UID must be set.
+type Binding struct {
+	// Subject of the TLS client certificate, matched against the full
+	// distinguished name or the common name
+	Subject string `json:"subject,omitempty"`
+	// UID of the peer connected over the unix socket
+	UID *int `json:"uid,omitempty"`
+	// Role assigned to the identity
+	Role string `json:"role"`
+}
+
+// Identity of an API client
+type Identity struct {
+	// Subject is the distinguished name of the verified TLS client certificate
+	Subject string
+	// CommonName is the common name of the verified TLS client certificate
+	CommonName string
+	// UID is the user ID of the unix socket peer, -1 when unknown
+	UID int
+}
+
+// String returns a human readable form of the identity
+func (i Identity) String() string {
+	switch {
+	case i.Subject != "":
+		return "subject=" + i.Subject
+	case i.UID >= 0:
+		return "uid=" + strconv.Itoa(i.UID)
+	default:
+		return "anonymous"
+	}
+}
+
+// CreateRequest is the summary of a create request checked against the
+// restrictions of a role
+type CreateRequest struct {
+	// Privileged is set when a privileged container or exec session is requested
+	Privileged bool
+	// HostNamespaces are the namespaces requested to be shared with the host
+	HostNamespaces []string
+	// HostPaths are the host paths requested to be bind mounted
+	HostPaths []string
+	// Devices are the host devices requested to be added or mounted
+	Devices []string
+	// CapAdd are the capabilities requested to be added
+	CapAdd []string
+	// Unchecked describes a request whose content cannot be inspected, for
+	// instance a tar archive.  It is only allowed to unrestricted roles.
+	Unchecked string
+}
+
+// Load reads and validates the policy file
+func Load(file string) (*Policy, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading authorization policy: %w", err)
+	}
+	policy := new(Policy)
+	if err := json.Unmarshal(content, policy); err != nil {
+		return nil, fmt.Errorf("parsing authorization policy %s: %w", file, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy %s: %w", file, err)
+	}
+	return policy, nil
+}
+
+// Validate checks that the policy is consistent
+func (p *Policy) Validate() error {
+	if p.DefaultRole != "" {
+		if _, ok := p.Roles[p.DefaultRole]; !ok {
+			return fmt.Errorf("default role %q is not defined", p.DefaultRole)
+		}
+	}
+	for name, role := range p.Roles {
+		if role == nil {
+			return fmt.Errorf("role %q is empty", name)
+		}
+		for _, e := range role.Endpoints {
+			if err := e.Validate(); err != nil {
+				return fmt.Errorf("role %q: %w", name, err)
+			}
+		}
+		for _, hostPath := range role.Create.AllowedHostPaths {
+			if !filepath.IsAbs(hostPath) {
+				return fmt.Errorf("role %q: allowed host path %q must be absolute", name, hostPath)
+			}
+		}
+		for _, device := range role.Create.AllowedDevices {
+			if !filepath.IsAbs(device) {
+				return fmt.Errorf("role %q: allowed device %q must be absolute", name, device)
+			}
+		}
+	}
+	for i, b := range p.Bindings {
+		if (b.Subject == "") == (b.UID == nil) {
+			return fmt.Errorf("binding %d: exactly one of subject and uid must be set", i)
+		}
+		if _, ok := p.Roles[b.Role]; !ok {
+			return fmt.Errorf("binding %d: role %q is not defined", i, b.Role)
+		}
+	}
+	return nil
+}
+
+// RoleFor returns the name and the role assigned to the identity.  A nil
+// role is returned when the identity has no role.
+func (p *Policy) RoleFor(id Identity) (string, *Role) {
+	for _, b := range p.Bindings {
+		switch {
+		case b.Subject != "":
+			if id.Subject == "" || (b.Subject != id.Subject && b.Subject != id.CommonName) {
+				continue
+			}
+		case b.UID != nil:
+			if id.Subject != "" || id.UID < 0 || *b.UID != id.UID {
+				continue
+			}
+		}
+		return b.Role, p.Roles[b.Role]
+	}
+	if p.DefaultRole != "" {
+		return p.DefaultRole, p.Roles[p.DefaultRole]
+	}
+	return "", nil
+}
+
+// Allows reports whether the role may call the endpoint
+func (r *Role) Allows(method, requestPath string) bool {
+	return slices.ContainsFunc(r.Endpoints, func(e Endpoint) bool {
+		return e.Matches(method, requestPath)
+	})
+}
+
+// Matches reports whether the request method and path match the endpoint
+func (e Endpoint) Matches(method, requestPath string) bool {
+	if len(e.Methods) > 0 && !slices.ContainsFunc(e.Methods, func(m string) bool {
+		return strings.EqualFold(m, method)
+	}) {
+		return false
+	}
+	return matchSegments(splitPath(e.Path), splitPath(NormalizePath(requestPath)))
+}
+
+// Validate checks that the endpoint path is a valid pattern
+func (e Endpoint) Validate() error {
+	if !strings.HasPrefix(e.Path, "/") {
+		return fmt.Errorf("endpoint path %q must be absolute", e.Path)
+	}
+	for _, segment := range strings.Split(e.Path, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("endpoint path %q: %w", e.Path, err)
+		}
+	}
+	return nil
+}
+
+// Check returns an error describing the first create option of the request
+// the role is not allowed to use
+func (r *Role) Check(req CreateRequest) error {
+	if req.Unchecked != "" && !r.Create.Unrestricted() {
+		return fmt.Errorf("%s cannot be checked and is only allowed to unrestricted roles", req.Unchecked)
+	}
+	if req.Privileged && !r.Create.AllowPrivileged {
+		return errors.New("privileged is not allowed")
+	}
+	if len(req.HostNamespaces) > 0 && !r.Create.AllowHostNamespaces {
+		return fmt.Errorf("host %s namespace is not allowed", req.HostNamespaces[0])
+	}
+	for _, p := range req.HostPaths {
+		if !pathAllowed(p, r.Create.AllowedHostPaths) {
+			return fmt.Errorf("bind mount of host path %q is not allowed", p)
+		}
+	}
+	if r.Create.AllowPrivileged {
+		return nil
+	}
+	for _, d := range req.Devices {
+		if !pathAllowed(d, r.Create.AllowedDevices) {
+			return fmt.Errorf("host device %q is not allowed", d)
+		}
+	}
+	for _, c := range req.CapAdd {
+		if !capabilityAllowed(c, r.Create.AllowedCapabilities) {
+			return fmt.Errorf("capability %s is not allowed", normalizeCapability(c))
+		}
+	}
+	return nil
+}
+
+// Unrestricted reports whether every create option is allowed
+func (c CreateRestrictions) Unrestricted() bool {
+	return c.AllowPrivileged && c.AllowHostNamespaces && pathAllowed("/", c.AllowedHostPaths)
+}
+
+// NormalizePath cleans the request path and removes the API version prefix
+func NormalizePath(p string) string {
+	p = path.Clean("/" + p)
+	if loc := versionPrefix.FindStringIndex(p); loc != nil {
+		p = "/" + p[loc[1]:]
+	}
+	return p
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+func matchSegments(pattern, segments []string) bool {
+	for i, p := range pattern {
+		if p == "**" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if ok, _ := path.Match(p, segments[i]); !ok {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+func normalizeCapability(c string) string {
+	c = strings.ToUpper(c)
+	if c != "ALL" && !strings.HasPrefix(c, "CAP_") {
+		c = "CAP_" + c
+	}
+	return c
+}
+
+func capabilityAllowed(c string, allowed []string) bool {
+	c = normalizeCapability(c)
+	return slices.ContainsFunc(allowed, func(a string) bool {
+		a = normalizeCapability(a)
+		return a == "ALL" || a == c
+	})
+}
+
+func pathAllowed(p string, allowed []string) bool {
+	p = filepath.Clean(p)
+	for _, a := range allowed {
+		rel, err := filepath.Rel(filepath.Clean(a), p)
+		if err != nil {
+			continue
+		}
+		if rel == "." || (rel != ".." && !strings.HasPrefix(rel, "../")) {
+			return true
+		}
+	}
+	return false
+}
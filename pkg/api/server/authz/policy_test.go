@@ -0,0 +1,183 @@
+//go:build !remote
+
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `{
+  "defaultRole": "viewer",
+  "roles": {
+    "admin": {
+      "endpoints": [{"path": "/**"}],
+      "create": {"allowPrivileged": true, "allowHostNamespaces": true, "allowedHostPaths": ["/"]}
+    },
+    "developer": {
+      "endpoints": [{"path": "/libpod/containers/**"}, {"path": "/containers/**"}],
+      "create": {"allowedHostPaths": ["/srv/projects"]}
+    },
+    "viewer": {
+      "endpoints": [{"methods": ["GET", "HEAD"], "path": "/**"}]
+    }
+  },
+  "bindings": [
+    {"subject": "alice", "role": "admin"},
+    {"uid": 1000, "role": "developer"}
+  ]
+}`
+
+func loadTestPolicy(t *testing.T, content string) (*Policy, error) {
+	file := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return Load(file)
+}
+
+func TestLoadValidation(t *testing.T) {
+	_, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		policy string
+		err    string
+	}{
+		{`{"defaultRole": "missing", "roles": {}}`, `default role "missing" is not defined`},
+		{`{"roles": {"r": {"endpoints": [{"path": "containers"}]}}}`, "must be absolute"},
+		{`{"roles": {"r": {"endpoints": [{"path": "/containers/["}]}}}`, "syntax error in pattern"},
+		{`{"roles": {"r": {"create": {"allowedHostPaths": ["srv"]}}}}`, `allowed host path "srv" must be absolute`},
+		{`{"roles": {"r": {}}, "bindings": [{"role": "r"}]}`, "exactly one of subject and uid must be set"},
+		{`{"roles": {"r": {}}, "bindings": [{"uid": 0, "role": "other"}]}`, `role "other" is not defined`},
+		{`{"roles": `, "parsing authorization policy"},
+	} {
+		_, err := loadTestPolicy(t, tc.policy)
+		assert.ErrorContains(t, err, tc.err, tc.policy)
+	}
+}
+
+func TestRoleFor(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		id   Identity
+		role string
+	}{
+		{Identity{Subject: "CN=alice,O=example", CommonName: "alice", UID: -1}, "admin"},
+		{Identity{Subject: "CN=bob,O=example", CommonName: "bob", UID: -1}, "viewer"},
+		{Identity{UID: 1000}, "developer"},
+		{Identity{UID: 0}, "viewer"},
+		{Identity{UID: -1}, "viewer"},
+	} {
+		name, role := policy.RoleFor(tc.id)
+		assert.Equal(t, tc.role, name, tc.id.String())
+		assert.NotNil(t, role)
+	}
+
+	policy.DefaultRole = ""
+	name, role := policy.RoleFor(Identity{UID: 0})
+	assert.Empty(t, name)
+	assert.Nil(t, role)
+}
+
+func TestAllows(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	developer := policy.Roles["developer"]
+	viewer := policy.Roles["viewer"]
+
+	assert.True(t, developer.Allows("POST", "/v6.0.0/libpod/containers/create"))
+	assert.True(t, developer.Allows("DELETE", "/v1.44/containers/abc"))
+	assert.True(t, developer.Allows("GET", "/containers/json"))
+	assert.False(t, developer.Allows("GET", "/v6.0.0/libpod/images/json"))
+	assert.False(t, developer.Allows("GET", "/libpod/containers/../images/json"))
+	assert.False(t, developer.Allows("GET", "/volumes"))
+
+	assert.True(t, viewer.Allows("GET", "/v6.0.0/libpod/info"))
+	assert.True(t, viewer.Allows("head", "/_ping"))
+	assert.False(t, viewer.Allows("POST", "/v6.0.0/libpod/containers/create"))
+
+	single := &Role{Endpoints: []Endpoint{{Path: "/libpod/containers/*/json"}}}
+	assert.True(t, single.Allows("GET", "/v6.0.0/libpod/containers/abc/json"))
+	assert.False(t, single.Allows("GET", "/v6.0.0/libpod/containers/json"))
+	assert.False(t, single.Allows("GET", "/v6.0.0/libpod/containers/abc/json/more"))
+}
+
+func TestCheck(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	admin := policy.Roles["admin"]
+	developer := policy.Roles["developer"]
+
+	req := CreateRequest{
+		Privileged:     true,
+		HostNamespaces: []string{"network"},
+		HostPaths:      []string{"/etc"},
+	}
+	assert.NoError(t, admin.Check(req))
+	assert.EqualError(t, developer.Check(req), "privileged is not allowed")
+
+	req.Privileged = false
+	assert.EqualError(t, developer.Check(req), "host network namespace is not allowed")
+
+	req.HostNamespaces = nil
+	assert.EqualError(t, developer.Check(req), `bind mount of host path "/etc" is not allowed`)
+
+	req.HostPaths = []string{"/srv/projects", "/srv/projects/app/", "/srv/projects/../projects/app"}
+	assert.NoError(t, developer.Check(req))
+
+	req.HostPaths = []string{"/srv/projects-other"}
+	assert.Error(t, developer.Check(req))
+	req.HostPaths = []string{"/srv/projects/../secret"}
+	assert.Error(t, developer.Check(req))
+}
+
+func TestCheckDevicesAndCapabilities(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	admin := policy.Roles["admin"]
+	developer := policy.Roles["developer"]
+
+	req := CreateRequest{Devices: []string{"/dev/fuse"}}
+	assert.NoError(t, admin.Check(req))
+	assert.EqualError(t, developer.Check(req), `host device "/dev/fuse" is not allowed`)
+	developer.Create.AllowedDevices = []string{"/dev/fuse", "/dev/dri"}
+	assert.NoError(t, developer.Check(req))
+	req.Devices = []string{"/dev/dri/card0"}
+	assert.NoError(t, developer.Check(req))
+	req.Devices = []string{"/dev/sda"}
+	assert.Error(t, developer.Check(req))
+
+	req = CreateRequest{CapAdd: []string{"sys_admin"}}
+	assert.NoError(t, admin.Check(req))
+	assert.EqualError(t, developer.Check(req), "capability CAP_SYS_ADMIN is not allowed")
+	developer.Create.AllowedCapabilities = []string{"CAP_NET_ADMIN"}
+	req.CapAdd = []string{"NET_ADMIN", "cap_net_admin"}
+	assert.NoError(t, developer.Check(req))
+	req.CapAdd = []string{"ALL"}
+	assert.EqualError(t, developer.Check(req), "capability ALL is not allowed")
+	developer.Create.AllowedCapabilities = []string{"all"}
+	assert.NoError(t, developer.Check(req))
+
+	_, err = loadTestPolicy(t, `{"roles": {"r": {"create": {"allowedDevices": ["dev/fuse"]}}}}`)
+	assert.ErrorContains(t, err, `allowed device "dev/fuse" must be absolute`)
+}
+
+func TestCheckUnchecked(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	require.NoError(t, err)
+
+	req := CreateRequest{Unchecked: "kube play of a tar archive"}
+	assert.NoError(t, policy.Roles["admin"].Check(req))
+	assert.EqualError(t, policy.Roles["developer"].Check(req), "kube play of a tar archive cannot be checked and is only allowed to unrestricted roles")
+
+	restricted := &Role{Create: CreateRestrictions{AllowPrivileged: true, AllowHostNamespaces: true, AllowedHostPaths: []string{"/srv"}}}
+	assert.Error(t, restricted.Check(req))
+}
@@ -0,0 +1,155 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/pkg/api/server/audit"
+)
+
+const (
+	// auditBodyLimit is the largest request body recorded in the audit log
+	auditBodyLimit = 1 << 20
+	// auditResponseLimit is the largest response body inspected for the
+	// ID of a created object
+	auditResponseLimit = 4096
+)
+
+// auditResponseWriter records the status code and the start of the
+// response body
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status   int
+	hijacked bool
+	body     bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if room := auditResponseLimit - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(room, len(b))])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if wrapped, ok := w.ResponseWriter.(http.Hijacker); ok {
+		w.hijacked = true
+		return wrapped.Hijack()
+	}
+
+	return nil, nil, errors.New("ResponseWriter does not support hijacking")
+}
+
+func (w *auditResponseWriter) Flush() {
+	if wrapped, ok := w.ResponseWriter.(http.Flusher); ok {
+		wrapped.Flush()
+	}
+}
+
+// createdID returns the ID of the object created by the request, if the
+// response is a JSON object holding one
+func (w *auditResponseWriter) createdID() string {
+	if w.status < 200 || w.status > 299 || w.body.Len() >= auditResponseLimit {
+		return ""
+	}
+	var resp map[string]any
+	if err := json.Unmarshal(w.body.Bytes(), &resp); err != nil {
+		return ""
+	}
+	for _, key := range []string{"Id", "ID", "id"} {
+		if id, ok := resp[key].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// auditHandler records API requests, with their caller and outcome, to the
+// audit log as selected by the audit policy
+func auditHandler(policy *audit.Policy, sink *audit.Sink) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			level, redactor := policy.Evaluate(r.Method, r.URL.Path)
+			if level == audit.LevelNone {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &audit.Record{
+				Time:      time.Now(),
+				RequestID: r.Header.Get("X-Reference-Id"),
+				Identity:  clientIdentity(r).String(),
+				Method:    r.Method,
+				Path:      r.URL.Path,
+				Query:     redactor.Query(r.URL.Query()),
+			}
+			for key, value := range mux.Vars(r) {
+				if key != "version" {
+					rec.Objects = append(rec.Objects, value)
+				}
+			}
+			slices.Sort(rec.Objects)
+
+			if level == audit.LevelRequest {
+				rec.Headers = redactor.Header(r.Header)
+				if r.Body != nil && r.Body != http.NoBody {
+					// Only the start of the body is read, the rest is left to the
+					// handler so large uploads are streamed as usual
+					head, err := io.ReadAll(io.LimitReader(r.Body, auditBodyLimit+1))
+					if err != nil {
+						logrus.Debugf("Unable to read request body for audit log: %v", err)
+					}
+					r.Body = struct {
+						io.Reader
+						io.Closer
+					}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+
+					var body any
+					if len(head) <= auditBodyLimit && json.Unmarshal(head, &body) == nil {
+						rec.Body = redactor.Body(body)
+					}
+				}
+			}
+
+			aw := &auditResponseWriter{ResponseWriter: w}
+			h.ServeHTTP(aw, r)
+
+			rec.DurationMS = time.Since(rec.Time).Milliseconds()
+			switch {
+			case aw.status != 0:
+				rec.Status = aw.status
+			case aw.hijacked:
+				rec.Status = http.StatusSwitchingProtocols
+			default:
+				rec.Status = http.StatusOK
+			}
+			if id := aw.createdID(); id != "" && !slices.Contains(rec.Objects, id) {
+				rec.Objects = append(rec.Objects, id)
+			}
+			if err := sink.Write(rec); err != nil {
+				logrus.Errorf("Unable to write audit log: %v", err)
+			}
+		})
+	}
+}
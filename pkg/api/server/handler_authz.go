@@ -0,0 +1,443 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/api/handlers"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	"go.podman.io/podman/v6/pkg/api/server/authz"
+	"go.podman.io/podman/v6/pkg/api/types"
+	"go.podman.io/podman/v6/pkg/specgen"
+	"go.podman.io/podman/v6/pkg/util"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// authorizationHandler enforces the authorization policy: the client
+// identity must have a role allowing the endpoint, and create requests must
+// only use the options allowed to the role.  Denials are recorded as events.
+func authorizationHandler(runtime *libpod.Runtime, policy *authz.Policy) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := clientIdentity(r)
+			roleName, role := policy.RoleFor(id)
+
+			deny := func(reason error) {
+				logrus.WithFields(logrus.Fields{
+					"X-Reference-Id": r.Header.Get("X-Reference-Id"),
+				}).Infof("Denied Request: %s %s for %s: %v", r.Method, r.URL.Path, id, reason)
+				runtime.NewDeniedEvent(id.String(), r.Method, r.URL.Path, reason.Error())
+				utils.Error(w, http.StatusForbidden, fmt.Errorf("authorization denied: %w", reason))
+			}
+
+			switch {
+			case role == nil:
+				deny(errors.New("no role assigned"))
+				return
+			case !role.Allows(r.Method, r.URL.Path):
+				deny(fmt.Errorf("role %q is not allowed to %s %s", roleName, r.Method, authz.NormalizePath(r.URL.Path)))
+				return
+			}
+
+			req, err := createRequestFor(r)
+			if err != nil {
+				// A request which cannot be checked must not get
+				// through, even if the handler would reject it too.
+				deny(fmt.Errorf("checking request: %w", err))
+				return
+			}
+			if req != nil {
+				if err := role.Check(*req); err != nil {
+					deny(fmt.Errorf("role %q: %w", roleName, err))
+					return
+				}
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIdentity returns the identity of the client, the subject of the
+// verified TLS client certificate or the UID of the unix socket peer
+func clientIdentity(r *http.Request) authz.Identity {
+	id := authz.Identity{UID: -1}
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		cert := r.TLS.VerifiedChains[0][0]
+		id.Subject = cert.Subject.String()
+		id.CommonName = cert.Subject.CommonName
+		return id
+	}
+	if conn, ok := r.Context().Value(types.ConnKey).(*net.UnixConn); ok {
+		uid, err := peerUID(conn)
+		if err != nil {
+			logrus.Debugf("Unable to get peer credentials of API client: %v", err)
+			return id
+		}
+		id.UID = uid
+	}
+	return id
+}
+
+// createRequestFor returns the options of a container, pod, exec session,
+// kube play or volume create request that are restricted by the policy.  It
+// returns nil for other requests.  The request body is restored for the
+// endpoint handler.
+func createRequestFor(r *http.Request) (*authz.CreateRequest, error) {
+	if r.Method != http.MethodPost {
+		return nil, nil
+	}
+
+	var decode func(*http.Request, []byte) (*authz.CreateRequest, error)
+	p := authz.NormalizePath(r.URL.Path)
+	switch {
+	case p == "/containers/create":
+		decode = jsonRequest(compatCreateRequest)
+	case p == "/libpod/containers/create":
+		decode = jsonRequest(specgenCreateRequest)
+	case p == "/libpod/pods/create":
+		decode = jsonRequest(podSpecgenCreateRequest)
+	case matchPath("/containers/*/exec", p), matchPath("/libpod/containers/*/exec", p):
+		decode = jsonRequest(execCreateRequest)
+	case p == "/volumes/create", p == "/libpod/volumes/create":
+		decode = jsonRequest(volumeCreateRequest)
+	case p == "/libpod/play/kube", p == "/libpod/kube/play":
+		decode = kubePlayRequest
+	case matchPath("/libpod/containers/*/restore", p), matchPath("/libpod/pods/*/restore", p):
+		// Imported checkpoints carry the configuration of the
+		// containers they were taken from.
+		if ok, _ := strconv.ParseBool(r.URL.Query().Get("import")); !ok {
+			return nil, nil
+		}
+		return &authz.CreateRequest{Unchecked: "restore of an imported checkpoint"}, nil
+	case p == "/libpod/system/restore":
+		return &authz.CreateRequest{Unchecked: "restore of a backup"}, nil
+	case p == "/libpod/quadlets":
+		return &authz.CreateRequest{Unchecked: "installation of quadlets"}, nil
+	default:
+		return nil, nil
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if len(body) == 0 {
+		return &authz.CreateRequest{}, nil
+	}
+	return decode(r, body)
+}
+
+// jsonRequest wraps a decoder of a JSON request body
+func jsonRequest(decode func([]byte) (*authz.CreateRequest, error)) func(*http.Request, []byte) (*authz.CreateRequest, error) {
+	return func(_ *http.Request, body []byte) (*authz.CreateRequest, error) {
+		req, err := decode(body)
+		if err != nil {
+			return nil, fmt.Errorf("decoding request body as JSON: %w", err)
+		}
+		return req, nil
+	}
+}
+
+func matchPath(pattern, p string) bool {
+	ok, _ := path.Match(pattern, p)
+	return ok
+}
+
+func compatCreateRequest(body []byte) (*authz.CreateRequest, error) {
+	var config handlers.CreateContainerConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	hc := config.HostConfig
+	req := &authz.CreateRequest{Privileged: hc.Privileged, CapAdd: hc.CapAdd}
+	for name, isHost := range map[string]bool{
+		"network": hc.NetworkMode.IsHost(),
+		"pid":     hc.PidMode.IsHost(),
+		"ipc":     hc.IpcMode.IsHost(),
+		"uts":     hc.UTSMode.IsHost(),
+		"user":    hc.UsernsMode.IsHost(),
+		"cgroup":  hc.CgroupnsMode.IsHost(),
+	} {
+		if isHost {
+			req.HostNamespaces = append(req.HostNamespaces, name)
+		}
+	}
+	slices.Sort(req.HostNamespaces)
+	for _, bind := range hc.Binds {
+		src, _, _ := strings.Cut(bind, ":")
+		if filepath.IsAbs(src) {
+			req.HostPaths = append(req.HostPaths, src)
+		}
+	}
+	for _, m := range hc.Mounts {
+		if m.Type == mount.TypeBind {
+			req.HostPaths = append(req.HostPaths, m.Source)
+		}
+	}
+	for _, d := range hc.Devices {
+		req.Devices = append(req.Devices, d.PathOnHost)
+	}
+	return req, nil
+}
+
+func specgenCreateRequest(body []byte) (*authz.CreateRequest, error) {
+	var s specgen.SpecGenerator
+	if err := json.Unmarshal(body, &s); err != nil {
+		return nil, err
+	}
+	req := &authz.CreateRequest{Privileged: s.IsPrivileged(), CapAdd: s.CapAdd}
+	addHostNamespaces(req, map[string]specgen.Namespace{
+		"network": s.NetNS,
+		"pid":     s.PidNS,
+		"ipc":     s.IpcNS,
+		"uts":     s.UtsNS,
+		"user":    s.UserNS,
+		"cgroup":  s.CgroupNS,
+	})
+	for _, m := range s.Mounts {
+		if m.Type == "bind" || slices.Contains(m.Options, "bind") || slices.Contains(m.Options, "rbind") {
+			req.HostPaths = append(req.HostPaths, m.Source)
+		}
+	}
+	for _, o := range s.OverlayVolumes {
+		req.HostPaths = append(req.HostPaths, o.Source)
+	}
+	for _, d := range s.Devices {
+		// The path is the device as given on the command line,
+		// <host path>[:<container path>[:<permissions>]]
+		src, _, _ := strings.Cut(d.Path, ":")
+		req.Devices = append(req.Devices, src)
+	}
+	return req, nil
+}
+
+func podSpecgenCreateRequest(body []byte) (*authz.CreateRequest, error) {
+	var p specgen.PodSpecGenerator
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+	req := &authz.CreateRequest{}
+	addHostNamespaces(req, map[string]specgen.Namespace{
+		"network": p.NetNS,
+		"pid":     p.Pid,
+		"ipc":     p.Ipc,
+		"uts":     p.UtsNs,
+		"user":    p.Userns,
+	})
+	for _, m := range p.Mounts {
+		if m.Type == "bind" || slices.Contains(m.Options, "bind") || slices.Contains(m.Options, "rbind") {
+			req.HostPaths = append(req.HostPaths, m.Source)
+		}
+	}
+	for _, o := range p.OverlayVolumes {
+		req.HostPaths = append(req.HostPaths, o.Source)
+	}
+	for _, d := range p.Devices {
+		src, _, _ := strings.Cut(d, ":")
+		req.Devices = append(req.Devices, src)
+	}
+	return req, nil
+}
+
+func execCreateRequest(body []byte) (*authz.CreateRequest, error) {
+	var config handlers.ExecCreateConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	return &authz.CreateRequest{Privileged: config.Privileged}, nil
+}
+
+// volumeCreateRequest records the host path bind mounted, or the host device
+// mounted, by a volume of the local driver.  Both the libpod and the compat
+// option names are accepted.
+func volumeCreateRequest(body []byte) (*authz.CreateRequest, error) {
+	var config struct {
+		Driver     string
+		Options    map[string]string
+		DriverOpts map[string]string
+	}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	req := &authz.CreateRequest{}
+	if config.Driver != "" && config.Driver != define.VolumeDriverLocal {
+		return req, nil
+	}
+	opts := make(map[string]string)
+	maps.Copy(opts, config.Options)
+	maps.Copy(opts, config.DriverOpts)
+	device := opts["device"]
+	if !filepath.IsAbs(device) {
+		return req, nil
+	}
+	mountOpts := strings.Split(opts["o"], ",")
+	if opts["type"] == "bind" || slices.Contains(mountOpts, "bind") || slices.Contains(mountOpts, "rbind") {
+		req.HostPaths = append(req.HostPaths, device)
+	} else {
+		req.Devices = append(req.Devices, device)
+	}
+	return req, nil
+}
+
+// kubePlayRequest records the options restricted by the policy of all pods
+// in the kube YAML, and of the persistent volume claims mounting host paths
+// or devices.  The documents are walked generically, so that the pod
+// templates of deployments, daemon sets, jobs and lists are found as well.
+func kubePlayRequest(r *http.Request, body []byte) (*authz.CreateRequest, error) {
+	if r.Header.Get("Content-Type") == "application/x-tar" {
+		return &authz.CreateRequest{Unchecked: "kube play of a tar archive"}, nil
+	}
+
+	req := &authz.CreateRequest{}
+	query := r.URL.Query()
+	if slices.Contains(query["network"], "host") {
+		req.HostNamespaces = append(req.HostNamespaces, "network")
+	}
+	if query.Get("userns") == "host" {
+		req.HostNamespaces = append(req.HostNamespaces, "user")
+	}
+
+	d := yamlv3.NewDecoder(bytes.NewReader(body))
+	for {
+		var doc any
+		err := d.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding request body as YAML: %w", err)
+		}
+		addKubeObject(req, doc)
+	}
+	slices.Sort(req.HostNamespaces)
+	req.HostNamespaces = slices.Compact(req.HostNamespaces)
+	return req, nil
+}
+
+func addKubeObject(req *authz.CreateRequest, obj any) {
+	switch o := obj.(type) {
+	case []any:
+		for _, item := range o {
+			addKubeObject(req, item)
+		}
+	case map[string]any:
+		if _, ok := o["containers"]; ok {
+			addKubePodSpec(req, o)
+		}
+		if o["kind"] == "PersistentVolumeClaim" {
+			addKubeVolumeClaim(req, o)
+		}
+		if o["kind"] != nil {
+			for key, value := range kubeMap(kubeMap(o, "metadata"), "annotations") {
+				if (key == define.UserNsAnnotation || strings.HasPrefix(key, define.UserNsAnnotation+"/")) && value == "host" {
+					req.HostNamespaces = append(req.HostNamespaces, "user")
+				}
+			}
+		}
+		for _, v := range o {
+			addKubeObject(req, v)
+		}
+	}
+}
+
+func addKubePodSpec(req *authz.CreateRequest, spec map[string]any) {
+	for name, field := range map[string]string{
+		"network": "hostNetwork",
+		"pid":     "hostPID",
+		"ipc":     "hostIPC",
+	} {
+		if spec[field] == true {
+			req.HostNamespaces = append(req.HostNamespaces, name)
+		}
+	}
+	for _, v := range kubeList(spec, "volumes") {
+		hostPath := kubeMap(kubeMapOf(v), "hostPath")
+		p, ok := hostPath["path"].(string)
+		if !ok {
+			continue
+		}
+		switch hostPath["type"] {
+		case "CharDevice", "BlockDevice":
+			req.Devices = append(req.Devices, p)
+		default:
+			req.HostPaths = append(req.HostPaths, p)
+		}
+	}
+	for _, key := range []string{"initContainers", "containers"} {
+		for _, ctr := range kubeList(spec, key) {
+			securityContext := kubeMap(kubeMapOf(ctr), "securityContext")
+			if securityContext["privileged"] == true {
+				req.Privileged = true
+			}
+			for _, c := range kubeList(kubeMap(securityContext, "capabilities"), "add") {
+				if c, ok := c.(string); ok {
+					req.CapAdd = append(req.CapAdd, c)
+				}
+			}
+		}
+	}
+}
+
+func addKubeVolumeClaim(req *authz.CreateRequest, claim map[string]any) {
+	annotations := kubeMap(kubeMap(claim, "metadata"), "annotations")
+	device, ok := annotations[util.VolumeDeviceAnnotation].(string)
+	if !ok || !filepath.IsAbs(device) {
+		return
+	}
+	mountOpts, _ := annotations[util.VolumeMountOptsAnnotation].(string)
+	opts := strings.Split(mountOpts, ",")
+	if annotations[util.VolumeTypeAnnotation] == "bind" || slices.Contains(opts, "bind") || slices.Contains(opts, "rbind") {
+		req.HostPaths = append(req.HostPaths, device)
+	} else {
+		req.Devices = append(req.Devices, device)
+	}
+}
+
+func kubeMapOf(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func kubeMap(m map[string]any, key string) map[string]any {
+	return kubeMapOf(m[key])
+}
+
+func kubeList(m map[string]any, key string) []any {
+	l, _ := m[key].([]any)
+	return l
+}
+
+// addHostNamespaces records the namespaces shared with the host, including
+// namespaces joined by path as these may belong to the host as well
+func addHostNamespaces(req *authz.CreateRequest, namespaces map[string]specgen.Namespace) {
+	for name, ns := range namespaces {
+		if ns.NSMode == specgen.Host || ns.NSMode == specgen.Path {
+			req.HostNamespaces = append(req.HostNamespaces, name)
+		}
+	}
+	slices.Sort(req.HostNamespaces)
+}
@@ -0,0 +1,193 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/pkg/api/server/authz"
+)
+
+func TestCreateRequestFor(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		expected    *authz.CreateRequest
+	}{
+		{
+			name:     "other endpoint",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/containers/abc/start",
+			expected: nil,
+		},
+		{
+			name:     "container create with devices and capabilities",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/containers/create",
+			body:     `{"devices": [{"path": "/dev/fuse:/dev/fuse:rwm"}], "cap_add": ["SYS_ADMIN"]}`,
+			expected: &authz.CreateRequest{Devices: []string{"/dev/fuse"}, CapAdd: []string{"SYS_ADMIN"}},
+		},
+		{
+			name:     "compat container create with devices and capabilities",
+			method:   http.MethodPost,
+			target:   "/v1.44/containers/create",
+			body:     `{"HostConfig": {"Devices": [{"PathOnHost": "/dev/sda", "PathInContainer": "/dev/xvda"}], "CapAdd": ["NET_ADMIN"]}}`,
+			expected: &authz.CreateRequest{Devices: []string{"/dev/sda"}, CapAdd: []string{"NET_ADMIN"}},
+		},
+		{
+			name:     "pod create with devices",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/pods/create",
+			body:     `{"pod_devices": ["/dev/fuse"]}`,
+			expected: &authz.CreateRequest{Devices: []string{"/dev/fuse"}},
+		},
+		{
+			name:     "volume create binding a host path",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/volumes/create",
+			body:     `{"Name": "vol", "Options": {"o": "bind,ro", "device": "/etc"}}`,
+			expected: &authz.CreateRequest{HostPaths: []string{"/etc"}},
+		},
+		{
+			name:     "compat volume create mounting a device",
+			method:   http.MethodPost,
+			target:   "/v1.44/volumes/create",
+			body:     `{"Name": "vol", "DriverOpts": {"type": "ext4", "device": "/dev/sda1"}}`,
+			expected: &authz.CreateRequest{Devices: []string{"/dev/sda1"}},
+		},
+		{
+			name:     "volume create with tmpfs",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/volumes/create",
+			body:     `{"Name": "vol", "Options": {"type": "tmpfs", "device": "tmpfs"}}`,
+			expected: &authz.CreateRequest{},
+		},
+		{
+			name:   "kube play",
+			method: http.MethodPost,
+			target: "/v6.0.0/libpod/play/kube",
+			body: `apiVersion: v1
+kind: Pod
+metadata:
+  name: pod
+spec:
+  hostNetwork: true
+  containers:
+  - name: ctr
+    image: alpine
+    securityContext:
+      privileged: true
+      capabilities:
+        add: ["SYS_ADMIN"]
+  volumes:
+  - name: root
+    hostPath:
+      path: /
+  - name: disk
+    hostPath:
+      path: /dev/sda
+      type: BlockDevice
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+  annotations:
+    io.podman.annotations.userns: host
+spec:
+  template:
+    spec:
+      hostPID: true
+      containers:
+      - name: ctr
+        image: alpine
+---
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: claim
+  annotations:
+    volume.podman.io/device: /etc
+    volume.podman.io/mount-options: bind
+`,
+			expected: &authz.CreateRequest{
+				Privileged:     true,
+				HostNamespaces: []string{"network", "pid", "user"},
+				HostPaths:      []string{"/", "/etc"},
+				Devices:        []string{"/dev/sda"},
+				CapAdd:         []string{"SYS_ADMIN"},
+			},
+		},
+		{
+			name:     "kube play with host network option",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/kube/play?network=host",
+			body:     "apiVersion: v1\nkind: Pod\nspec:\n  containers:\n  - name: ctr\n",
+			expected: &authz.CreateRequest{HostNamespaces: []string{"network"}},
+		},
+		{
+			name:        "kube play of a tar archive",
+			method:      http.MethodPost,
+			target:      "/v6.0.0/libpod/play/kube",
+			contentType: "application/x-tar",
+			body:        "not checked",
+			expected:    &authz.CreateRequest{Unchecked: "kube play of a tar archive"},
+		},
+		{
+			name:     "restore of an imported checkpoint",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/containers/abc/restore?import=true",
+			expected: &authz.CreateRequest{Unchecked: "restore of an imported checkpoint"},
+		},
+		{
+			name:     "restore of a local checkpoint",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/containers/abc/restore",
+			expected: nil,
+		},
+		{
+			name:     "system restore",
+			method:   http.MethodPost,
+			target:   "/v6.0.0/libpod/system/restore",
+			expected: &authz.CreateRequest{Unchecked: "restore of a backup"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.contentType != "" {
+				r.Header.Set("Content-Type", tc.contentType)
+			}
+			req, err := createRequestFor(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, req)
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tc.body, string(body), "body is restored for the handler")
+		})
+	}
+}
+
+func TestCreateRequestForInvalidBody(t *testing.T) {
+	for _, target := range []string{
+		"/v6.0.0/libpod/containers/create",
+		"/v6.0.0/libpod/volumes/create",
+	} {
+		r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"privileged": true`))
+		_, err := createRequestFor(r)
+		assert.ErrorContains(t, err, "decoding request body as JSON", target)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/v6.0.0/libpod/play/kube", strings.NewReader("kind: [Pod"))
+	_, err := createRequestFor(r)
+	assert.ErrorContains(t, err, "decoding request body as YAML")
+}
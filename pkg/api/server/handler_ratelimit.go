@@ -0,0 +1,62 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	"go.podman.io/podman/v6/pkg/api/server/ratelimit"
+	"go.podman.io/podman/v6/pkg/api/types"
+)
+
+// rateLimitHandler enforces the request rate and in flight limits of each
+// client.  Requests over the limits are refused with 429 Too Many Requests
+// and a Retry-After header.
+func rateLimitHandler(limiter *ratelimit.Limiter) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client := rateLimitIdentity(r)
+			release, err := limiter.Acquire(r.Context(), client, r.Method, r.URL.Path, r.URL.Query())
+			if err != nil {
+				if limitErr, ok := ratelimit.IsLimitError(err); ok {
+					logrus.WithFields(logrus.Fields{
+						"X-Reference-Id": r.Header.Get("X-Reference-Id"),
+					}).Infof("Limited Request: %s %s for %s: %v", r.Method, r.URL.Path, client, err)
+					w.Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+					utils.Error(w, http.StatusTooManyRequests, err)
+					return
+				}
+				if !errors.Is(err, context.Canceled) {
+					utils.InternalServerError(w, err)
+				}
+				// The client went away while queued
+				return
+			}
+			defer release()
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitIdentity returns the key the limits of the client are accounted
+// to.  Clients without an identity are told apart by their address.
+func rateLimitIdentity(r *http.Request) string {
+	id := clientIdentity(r)
+	if id.Subject != "" || id.UID >= 0 {
+		return id.String()
+	}
+	if _, ok := r.Context().Value(types.ConnKey).(*net.UnixConn); !ok {
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			return "address=" + host
+		}
+	}
+	return id.String()
+}
@@ -17,6 +17,7 @@ type Tracker struct {
 	// Duration is the API idle window
 	Duration time.Duration
 	hijacked int                   // count of active connections managed by handlers
+	queued   int                   // count of requests waiting for the rate limiter
 	managed  map[net.Conn]struct{} // set of active connections managed by http package
 	mux      sync.Mutex            // protect managed map
 	timer    *time.Timer
@@ -81,7 +82,7 @@ func (t *Tracker) ConnState(conn net.Conn, state http.ConnState) {
 		}
 
 		// Transitioned from any "active" connection to no connections
-		if oldActive > 0 && t.ActiveConnections() == 0 {
+		if oldActive > 0 && t.ActiveConnections() == 0 && t.queued == 0 {
 			t.timer.Stop()            // See library source for Reset() issues and why they are not fixed
 			t.timer.Reset(t.Duration) // Restart the API window timer
 		}
@@ -93,6 +94,36 @@ func (t *Tracker) Close() {
 	t.ConnState(nil, http.StateClosed)
 }
 
+// Enqueue is called when a request starts waiting in a queue.  The service is
+// not idle while requests are queued, even if their connection goes away.
+func (t *Tracker) Enqueue() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.queued++
+	t.timer.Stop()
+}
+
+// Dequeue is called when a request leaves the queue
+func (t *Tracker) Dequeue() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.queued--
+	if t.queued == 0 && t.ActiveConnections() == 0 {
+		t.timer.Stop()
+		t.timer.Reset(t.Duration)
+	}
+}
+
+// QueuedRequests returns the number of requests waiting in a queue
+func (t *Tracker) QueuedRequests() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return t.queued
+}
+
 // ActiveConnections returns the number of current managed or StateHijacked connections
 func (t *Tracker) ActiveConnections() int {
 	return len(t.managed) + t.hijacked
@@ -0,0 +1,1047 @@
+// Package extract reads the API routes and the request parameters accepted
+// by their handlers from the source code.  It feeds the generator of the
+// OpenAPI operation table and the conformance test checking the table
+// against the handlers.
+package extract
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// modulePath is the import path of the repository root
+const modulePath = "go.podman.io/podman/v6"
+
+// serverDir holds the route registrations
+const serverDir = "pkg/api/server"
+
+// sourceDirs are the packages searched for handlers and the helpers they
+// pass the request to, relative to the repository root
+var sourceDirs = []string{
+	"pkg/api/handlers",
+	"pkg/api/handlers/compat",
+	"pkg/api/handlers/libpod",
+	"pkg/api/handlers/utils",
+	"pkg/util",
+}
+
+// Param is a request parameter
+type Param struct {
+	Name string
+	// In is query, path or header
+	In string
+	// Type is the OpenAPI type: string, integer, number, boolean or array
+	Type string
+	// Items is the OpenAPI type of the elements of an array
+	Items       string
+	Description string
+	Required    bool
+}
+
+// TypeRef is a reference to a named Go type
+type TypeRef struct {
+	ImportPath string
+	Name       string
+	Slice      bool
+}
+
+// Operation is an API route and what is known about its handler
+type Operation struct {
+	Method string
+	// Path is the route path without the API version prefix and with the
+	// patterns removed from the path variables
+	Path string
+	// Handler is the package qualified name of the handler function
+	Handler     string
+	OperationID string `yaml:"-"`
+	Summary     string
+	Description string
+	Tags        []string `yaml:"tags"`
+	Deprecated  bool
+	// Parameters are the query and header parameters
+	Parameters []Param
+	// Body is the type the request body is decoded into
+	Body *TypeRef
+	// HasBody is set when the handler reads a request body
+	HasBody bool
+	// Response is the type of the success response
+	Response *TypeRef
+	// Status is the status code of the success response
+	Status int
+}
+
+// Key returns the method and path identifying the operation
+func (o *Operation) Key() string {
+	return o.Method + " " + o.Path
+}
+
+var (
+	versionPrefix = regexp.MustCompile(`^/v\{version(:[^}]*)?\}`)
+	varPattern    = regexp.MustCompile(`\{(\w+):[^}]*\}`)
+)
+
+// NormalizePath removes the version prefix and the patterns of the path
+// variables from a route template
+func NormalizePath(template string) string {
+	p := versionPrefix.ReplaceAllString(template, "")
+	p = varPattern.ReplaceAllString(p, "{$1}")
+	if p == "" {
+		p = "/"
+	}
+	return p
+}
+
+// Load reads the operations from the source tree at root
+func Load(root string) ([]Operation, error) {
+	idx := &index{
+		fset:  token.NewFileSet(),
+		funcs: make(map[string]*funcInfo),
+		types: make(map[string]*typeInfo),
+	}
+	for _, dir := range sourceDirs {
+		if err := idx.parseDir(filepath.Join(root, dir), modulePath+"/"+dir); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(root, serverDir, "register_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	var ops []Operation
+	for _, file := range files {
+		f, err := parser.ParseFile(idx.fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, idx.routes(f)...)
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		if ops[i].Path != ops[j].Path {
+			return ops[i].Path < ops[j].Path
+		}
+		return ops[i].Method < ops[j].Method
+	})
+	return ops, nil
+}
+
+// Merge combines the operations of routes registered more than once, e.g.
+// with and without the version prefix or for different query parameters.
+// The metadata is taken from the first registration and the parameters of
+// all handlers are combined.
+func Merge(ops []Operation) []Operation {
+	var merged []Operation
+	index := make(map[string]int)
+	for _, op := range ops {
+		i, ok := index[op.Key()]
+		if !ok {
+			index[op.Key()] = len(merged)
+			merged = append(merged, op)
+			continue
+		}
+		m := &merged[i]
+		for _, p := range op.Parameters {
+			if !slices.ContainsFunc(m.Parameters, func(mp Param) bool { return mp.In == p.In && mp.Name == p.Name }) {
+				// A parameter selecting one of the handlers is not required
+				p.Required = false
+				m.Parameters = append(m.Parameters, p)
+			}
+		}
+		if m.OperationID == "" {
+			m.OperationID, m.Summary, m.Description, m.Tags, m.Deprecated = op.OperationID, op.Summary, op.Description, op.Tags, op.Deprecated
+		}
+		if m.Handler != op.Handler {
+			// Query parameters selecting the handler are optional
+			// as a whole
+			for j := range m.Parameters {
+				m.Parameters[j].Required = false
+			}
+		}
+		m.HasBody = m.HasBody || op.HasBody
+		if m.Body == nil {
+			m.Body = op.Body
+		}
+		if m.Response == nil {
+			m.Response, m.Status = op.Response, op.Status
+		}
+	}
+	return merged
+}
+
+type funcInfo struct {
+	decl *ast.FuncDecl
+	file *fileInfo
+}
+
+type typeInfo struct {
+	spec *ast.TypeSpec
+	file *fileInfo
+}
+
+type fileInfo struct {
+	importPath string
+	// imports maps the names used in the file to import paths
+	imports map[string]string
+}
+
+type index struct {
+	fset *token.FileSet
+	// funcs and types are keyed by import path and name
+	funcs map[string]*funcInfo
+	types map[string]*typeInfo
+}
+
+// skipFile returns true for files not built on Linux, the handlers of the
+// other platforms do not differ in their parameters
+func skipFile(name string) bool {
+	if strings.HasSuffix(name, "_test.go") {
+		return true
+	}
+	for _, suffix := range []string{"_freebsd.go", "_darwin.go", "_windows.go", "_unsupported.go", "_other.go"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx *index) parseDir(dir, importPath string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || skipFile(name) {
+			continue
+		}
+		f, err := parser.ParseFile(idx.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		fi := &fileInfo{importPath: importPath, imports: fileImports(f)}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Body != nil {
+					idx.funcs[importPath+"."+d.Name.Name] = &funcInfo{decl: d, file: fi}
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						idx.types[importPath+"."+ts.Name.Name] = &typeInfo{spec: ts, file: fi}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func fileImports(f *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, imp := range f.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		name := p[strings.LastIndex(p, "/")+1:]
+		if strings.HasPrefix(name, "v") && strings.Count(p, "/") > 0 {
+			// Major version suffix, e.g. github.com/blang/semver/v4
+			if _, err := strconv.Atoi(name[1:]); err == nil {
+				parent := strings.TrimSuffix(p, "/"+name)
+				name = parent[strings.LastIndex(parent, "/")+1:]
+			}
+		}
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+// swaggerComment is the metadata of the swagger:operation comment of a route
+type swaggerComment struct {
+	Method      string   `yaml:"-"`
+	Path        string   `yaml:"-"`
+	OperationID string   `yaml:"-"`
+	Summary     string   `yaml:"summary"`
+	Description string   `yaml:"description"`
+	Tags        []string `yaml:"tags"`
+	Deprecated  bool     `yaml:"deprecated"`
+	Parameters  []struct {
+		In          string `yaml:"in"`
+		Name        string `yaml:"name"`
+		Type        string `yaml:"type"`
+		Description string `yaml:"description"`
+		Required    bool   `yaml:"required"`
+		Items       struct {
+			Type string `yaml:"type"`
+		} `yaml:"items"`
+	} `yaml:"parameters"`
+}
+
+var swaggerOperation = regexp.MustCompile(`swagger:operation\s+(\w+)\s+(\S+)\s+(\w+)\s+(\w+)`)
+
+func parseSwaggerComment(cg *ast.CommentGroup) *swaggerComment {
+	var lines []string
+	for _, c := range cg.List {
+		lines = append(lines, strings.TrimPrefix(c.Text, "//"))
+	}
+	var sc *swaggerComment
+	for i, line := range lines {
+		if m := swaggerOperation.FindStringSubmatch(line); m != nil {
+			sc = &swaggerComment{Method: m[1], Path: m[2], Tags: []string{m[3]}, OperationID: m[4]}
+			lines = lines[i+1:]
+			break
+		}
+	}
+	if sc == nil {
+		return nil
+	}
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		lines = lines[1:]
+	}
+	tags := sc.Tags
+	// The metadata is best effort, a comment which is not valid YAML only
+	// loses its descriptions
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), sc); err != nil || len(sc.Tags) == 0 {
+		sc.Tags = tags
+	}
+	return sc
+}
+
+// routes returns the operations registered in a register_*.go file
+func (idx *index) routes(f *ast.File) []Operation {
+	cmap := ast.NewCommentMap(idx.fset, f, f.Comments)
+	imports := fileImports(f)
+	var ops []Operation
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		prefixes := make(map[string]string)
+		var comment *swaggerComment
+		for _, stmt := range fn.Body.List {
+			if groups := cmap[stmt]; len(groups) > 0 {
+				comment = nil
+				for _, cg := range groups {
+					if sc := parseSwaggerComment(cg); sc != nil {
+						comment = sc
+					}
+				}
+			}
+			if name, prefix, ok := subrouter(stmt); ok {
+				prefixes[name] = prefix
+				comment = nil
+				continue
+			}
+			found := idx.handleStmt(stmt, prefixes, imports, comment)
+			if len(found) == 0 {
+				comment = nil
+			}
+			ops = append(ops, found...)
+		}
+	}
+	return ops
+}
+
+// subrouter matches `v := r.PathPrefix("/prefix").Subrouter()`
+func subrouter(stmt ast.Stmt) (string, string, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return "", "", false
+	}
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || selectorName(call.Fun) != "Subrouter" {
+		return "", "", false
+	}
+	prefixCall, ok := call.Fun.(*ast.SelectorExpr).X.(*ast.CallExpr)
+	if !ok || selectorName(prefixCall.Fun) != "PathPrefix" || len(prefixCall.Args) != 1 {
+		return "", "", false
+	}
+	prefix, ok := stringLit(prefixCall.Args[0])
+	return ident.Name, prefix, ok
+}
+
+func selectorName(e ast.Expr) string {
+	if sel, ok := e.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// handleStmt returns the operations of a statement of the form
+// `r.Handle(path, handler).Methods(methods...)`
+func (idx *index) handleStmt(stmt ast.Stmt, prefixes map[string]string, imports map[string]string, comment *swaggerComment) []Operation {
+	expr, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil
+	}
+	// Walk the chain of route options down to the Handle call
+	var (
+		methods    []ast.Expr
+		queries    []string
+		handleCall *ast.CallExpr
+	)
+	for call, ok := expr.X.(*ast.CallExpr); ok && handleCall == nil; call, ok = call.Fun.(*ast.SelectorExpr).X.(*ast.CallExpr) {
+		sel, isSel := call.Fun.(*ast.SelectorExpr)
+		if !isSel {
+			return nil
+		}
+		switch sel.Sel.Name {
+		case "Methods":
+			methods = append(methods, call.Args...)
+		case "Queries":
+			// Queries takes pairs of names and value patterns
+			for i := 0; i < len(call.Args); i += 2 {
+				if name, ok := stringLit(call.Args[i]); ok {
+					queries = append(queries, name)
+				}
+			}
+		case "Handle", "HandleFunc":
+			handleCall = call
+		}
+	}
+	if handleCall == nil || len(handleCall.Args) != 2 || len(methods) == 0 {
+		return nil
+	}
+	router, ok := handleCall.Fun.(*ast.SelectorExpr).X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	pathExpr := handleCall.Args[0]
+	if call, ok := pathExpr.(*ast.CallExpr); ok && len(call.Args) == 1 {
+		pathExpr = call.Args[0]
+	}
+	path, ok := stringLit(pathExpr)
+	if !ok {
+		return nil
+	}
+	path = NormalizePath(prefixes[router.Name] + path)
+
+	handler := handlerName(handleCall.Args[1], imports)
+
+	// Aliases registered next to a documented route are not documented
+	// themselves
+	if comment != nil && NormalizePath(comment.Path) != path {
+		comment = nil
+	}
+
+	var ops []Operation
+	for _, m := range methods {
+		method := methodName(m)
+		if method == "" {
+			continue
+		}
+		op := Operation{Method: method, Path: path, Handler: handler}
+		if comment != nil && strings.EqualFold(comment.Method, method) {
+			op.OperationID = comment.OperationID
+			op.Summary = strings.TrimSpace(comment.Summary)
+			op.Description = strings.TrimSpace(comment.Description)
+			op.Tags = comment.Tags
+			op.Deprecated = comment.Deprecated
+		}
+		for _, name := range queries {
+			op.Parameters = append(op.Parameters, Param{Name: name, In: "query", Type: "string", Required: true})
+		}
+		idx.describe(&op, comment)
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+func methodName(e ast.Expr) string {
+	if s, ok := stringLit(e); ok {
+		return strings.ToUpper(s)
+	}
+	if name := selectorName(e); strings.HasPrefix(name, "Method") {
+		return strings.ToUpper(strings.TrimPrefix(name, "Method"))
+	}
+	return ""
+}
+
+// handlerName returns the import path qualified name of the handler,
+// unwrapping s.APIHandler() and similar wrappers
+func handlerName(e ast.Expr, imports map[string]string) string {
+	for {
+		call, ok := e.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			break
+		}
+		e = call.Args[0]
+	}
+	sel, ok := e.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || imports[pkg.Name] == "" {
+		return ""
+	}
+	return imports[pkg.Name] + "." + sel.Sel.Name
+}
+
+// ShortHandlerName returns the handler name qualified by the package name
+// instead of the import path
+func ShortHandlerName(handler string) string {
+	if i := strings.LastIndex(handler, "/"); i >= 0 {
+		return handler[i+1:]
+	}
+	return handler
+}
+
+// describe fills in the parameters and types of the operation from its
+// handler and its swagger comment
+func (idx *index) describe(op *Operation, comment *swaggerComment) {
+	params := make(map[string]*Param)
+	var order []string
+	add := func(p Param) {
+		key := p.In + ":" + p.Name
+		if existing, ok := params[key]; ok {
+			if existing.Type == "string" && p.Type != "string" {
+				existing.Type, existing.Items = p.Type, p.Items
+			}
+			return
+		}
+		params[key] = &p
+		order = append(order, key)
+	}
+	for _, p := range op.Parameters {
+		add(p)
+	}
+	op.Parameters = nil
+
+	if fn := idx.funcs[op.Handler]; fn != nil {
+		a := &analysis{idx: idx, visited: make(map[string]bool), params: add}
+		a.handler(fn, op)
+	}
+
+	if comment != nil && strings.EqualFold(comment.Method, op.Method) {
+		for _, cp := range comment.Parameters {
+			switch cp.In {
+			case "query":
+				if p, ok := params["query:"+cp.Name]; ok {
+					p.Description = strings.TrimSpace(cp.Description)
+				}
+			case "header":
+				add(Param{Name: cp.Name, In: "header", Type: "string", Description: strings.TrimSpace(cp.Description), Required: cp.Required})
+			case "body":
+				op.HasBody = true
+			}
+		}
+	}
+
+	for _, key := range order {
+		op.Parameters = append(op.Parameters, *params[key])
+	}
+}
+
+// analysis collects what a handler, and the functions it passes the
+// request to, read from the request
+type analysis struct {
+	idx     *index
+	visited map[string]bool
+	params  func(Param)
+}
+
+func (a *analysis) handler(fn *funcInfo, op *Operation) {
+	req := requestParam(fn.decl)
+	if req == "" {
+		return
+	}
+	a.function(fn, req)
+
+	// The body and response types are only taken from the handler itself
+	locals := localTypes(fn.decl)
+	ast.Inspect(fn.decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case isCall(call, "utils", "ReadJSONFromBody") && len(call.Args) == 2:
+			op.HasBody = true
+			if op.Body == nil {
+				op.Body = a.typeRef(fn.file, exprType(call.Args[1], locals))
+			}
+		case isJSONBodyDecode(call, req):
+			op.HasBody = true
+			if op.Body == nil {
+				op.Body = a.typeRef(fn.file, exprType(call.Args[0], locals))
+			}
+		case (isCall(call, "utils", "WriteResponse") || isCall(call, "utils", "WriteJSON")) && len(call.Args) == 3:
+			status := statusCode(call.Args[1])
+			if status < 200 || status > 299 || op.Status != 0 {
+				return true
+			}
+			op.Status = status
+			op.Response = a.typeRef(fn.file, exprType(call.Args[2], locals))
+		}
+		return true
+	})
+}
+
+// function collects the parameters read by a function from the request
+// variable req
+func (a *analysis) function(fn *funcInfo, req string) {
+	key := fn.file.importPath + "." + fn.decl.Name.Name
+	if a.visited[key] {
+		return
+	}
+	a.visited[key] = true
+
+	locals := localTypes(fn.decl)
+	queryVars := make(map[string]bool)
+	ast.Inspect(fn.decl.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok && isQuery(assign.Rhs[0], req, queryVars) {
+				queryVars[ident.Name] = true
+			}
+		}
+		switch x := n.(type) {
+		case *ast.IndexExpr:
+			// r.URL.Query()["name"]
+			if name, ok := stringLit(x.Index); ok && isQuery(x.X, req, queryVars) {
+				a.params(Param{Name: name, In: "query", Type: "string"})
+			}
+		case *ast.CallExpr:
+			a.call(fn, req, x, locals, queryVars)
+		}
+		return true
+	})
+}
+
+func (a *analysis) call(fn *funcInfo, req string, call *ast.CallExpr, locals map[string]ast.Expr, queryVars map[string]bool) {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		switch sel.Sel.Name {
+		case "Get", "Has":
+			// r.URL.Query().Get("name"), r.Form.Get("name")
+			if len(call.Args) == 1 && (isQuery(sel.X, req, queryVars) || isForm(sel.X, req)) {
+				if name, ok := stringLit(call.Args[0]); ok {
+					a.params(Param{Name: name, In: "query", Type: "string"})
+				}
+			}
+		case "FormValue":
+			if isIdent(sel.X, req) && len(call.Args) == 1 {
+				if name, ok := stringLit(call.Args[0]); ok {
+					a.params(Param{Name: name, In: "query", Type: "string"})
+				}
+			}
+		case "Decode":
+			// decoder.Decode(&query, r.URL.Query())
+			if len(call.Args) == 2 && isQuery(call.Args[1], req, queryVars) {
+				a.structParams(fn.file, exprType(call.Args[0], locals))
+			}
+		}
+	}
+
+	// Follow the request into the functions it is passed to
+	pos := slices.IndexFunc(call.Args, func(arg ast.Expr) bool { return isIdent(arg, req) })
+	if pos < 0 {
+		return
+	}
+	callee := a.resolveFunc(fn.file, call.Fun)
+	if callee == nil {
+		return
+	}
+	if name := paramName(callee.decl, pos); name != "" && name != "_" {
+		a.function(callee, name)
+	}
+}
+
+// structParams collects the schema tagged fields of a query struct
+func (a *analysis) structParams(file *fileInfo, t ast.Expr) {
+	switch x := t.(type) {
+	case *ast.StarExpr:
+		a.structParams(file, x.X)
+	case *ast.StructType:
+		for _, field := range x.Fields.List {
+			if len(field.Names) == 0 {
+				// Embedded struct, its fields are decoded as well
+				a.structParams(file, field.Type)
+				continue
+			}
+			name := ""
+			if field.Tag != nil {
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				name, _, _ = strings.Cut(reflectTag(tag, "schema"), ",")
+			}
+			if name == "-" {
+				continue
+			}
+			for _, ident := range field.Names {
+				if !ident.IsExported() {
+					continue
+				}
+				n := name
+				if n == "" {
+					n = ident.Name
+				}
+				typ, items := paramType(field.Type)
+				a.params(Param{Name: n, In: "query", Type: typ, Items: items})
+			}
+		}
+	case *ast.Ident, *ast.SelectorExpr:
+		if ti := a.resolveType(file, x); ti != nil {
+			a.structParams(ti.file, ti.spec.Type)
+		}
+	}
+}
+
+// reflectTag returns the value of the key in a struct tag
+func reflectTag(tag, key string) string {
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		name, rest, ok := strings.Cut(tag, ":")
+		if !ok || len(rest) == 0 || rest[0] != '"' {
+			return ""
+		}
+		end := strings.Index(rest[1:], `"`)
+		if end < 0 {
+			return ""
+		}
+		if name == key {
+			return rest[1 : end+1]
+		}
+		tag = rest[end+2:]
+	}
+	return ""
+}
+
+// paramType maps the Go type of a query field to an OpenAPI type
+func paramType(t ast.Expr) (string, string) {
+	switch x := t.(type) {
+	case *ast.StarExpr:
+		return paramType(x.X)
+	case *ast.ArrayType:
+		items, _ := paramType(x.Elt)
+		return "array", items
+	case *ast.Ident:
+		switch x.Name {
+		case "bool":
+			return "boolean", ""
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+			return "integer", ""
+		case "float32", "float64":
+			return "number", ""
+		}
+	}
+	// Strings, and maps and structs passed as JSON
+	return "string", ""
+}
+
+func (a *analysis) resolveFunc(file *fileInfo, fun ast.Expr) *funcInfo {
+	switch x := fun.(type) {
+	case *ast.Ident:
+		return a.idx.funcs[file.importPath+"."+x.Name]
+	case *ast.SelectorExpr:
+		if pkg, ok := x.X.(*ast.Ident); ok && file.imports[pkg.Name] != "" {
+			return a.idx.funcs[file.imports[pkg.Name]+"."+x.Sel.Name]
+		}
+	}
+	return nil
+}
+
+func (a *analysis) resolveType(file *fileInfo, t ast.Expr) *typeInfo {
+	switch x := t.(type) {
+	case *ast.Ident:
+		return a.idx.types[file.importPath+"."+x.Name]
+	case *ast.SelectorExpr:
+		if pkg, ok := x.X.(*ast.Ident); ok && file.imports[pkg.Name] != "" {
+			return a.idx.types[file.imports[pkg.Name]+"."+x.Sel.Name]
+		}
+	}
+	return nil
+}
+
+// typeRef returns a reference to a named, exported, non generic type
+func (a *analysis) typeRef(file *fileInfo, t ast.Expr) *TypeRef {
+	ref := &TypeRef{}
+	for {
+		switch x := t.(type) {
+		case *ast.StarExpr:
+			t = x.X
+			continue
+		case *ast.ArrayType:
+			if ref.Slice || x.Len != nil {
+				return nil
+			}
+			ref.Slice = true
+			t = x.Elt
+			continue
+		case *ast.Ident:
+			ti := a.idx.types[file.importPath+"."+x.Name]
+			if ti == nil || ti.spec.TypeParams != nil {
+				return nil
+			}
+			if !x.IsExported() {
+				// Unexported wire types embed the type they extend
+				st, ok := ti.spec.Type.(*ast.StructType)
+				if !ok || len(st.Fields.List) == 0 || len(st.Fields.List[0].Names) != 0 {
+					return nil
+				}
+				t = st.Fields.List[0].Type
+				continue
+			}
+			ref.ImportPath, ref.Name = file.importPath, x.Name
+			return ref
+		case *ast.SelectorExpr:
+			pkg, ok := x.X.(*ast.Ident)
+			if !ok || file.imports[pkg.Name] == "" || !x.Sel.IsExported() {
+				return nil
+			}
+			if ti := a.idx.types[file.imports[pkg.Name]+"."+x.Sel.Name]; ti != nil && ti.spec.TypeParams != nil {
+				return nil
+			}
+			ref.ImportPath, ref.Name = file.imports[pkg.Name], x.Sel.Name
+			if strings.Contains(ref.ImportPath, "/internal/") {
+				return nil
+			}
+			return ref
+		}
+		return nil
+	}
+}
+
+// localTypes returns the declared types of the variables of a function, as
+// far as they are visible in the source
+func localTypes(fn *ast.FuncDecl) map[string]ast.Expr {
+	locals := make(map[string]ast.Expr)
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			locals[name.Name] = field.Type
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range x.Names {
+				switch {
+				case x.Type != nil:
+					locals[name.Name] = x.Type
+				case i < len(x.Values):
+					if t := valueType(x.Values[i]); t != nil {
+						locals[name.Name] = t
+					}
+				}
+			}
+		case *ast.AssignStmt:
+			if x.Tok != token.DEFINE || len(x.Lhs) != len(x.Rhs) {
+				return true
+			}
+			for i, lhs := range x.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					if t := valueType(x.Rhs[i]); t != nil {
+						locals[ident.Name] = t
+					}
+				}
+			}
+		}
+		return true
+	})
+	return locals
+}
+
+// valueType returns the type of a composite literal, new() or make()
+// expression
+func valueType(e ast.Expr) ast.Expr {
+	switch x := e.(type) {
+	case *ast.CompositeLit:
+		return x.Type
+	case *ast.UnaryExpr:
+		if x.Op == token.AND {
+			if t := valueType(x.X); t != nil {
+				return &ast.StarExpr{X: t}
+			}
+		}
+	case *ast.CallExpr:
+		if fun, ok := x.Fun.(*ast.Ident); ok && (fun.Name == "new" || fun.Name == "make") && len(x.Args) > 0 {
+			if fun.Name == "new" {
+				return &ast.StarExpr{X: x.Args[0]}
+			}
+			return x.Args[0]
+		}
+	}
+	return nil
+}
+
+// exprType returns the type of an expression naming a variable, taking its
+// address or building a value
+func exprType(e ast.Expr, locals map[string]ast.Expr) ast.Expr {
+	switch x := e.(type) {
+	case *ast.Ident:
+		return locals[x.Name]
+	case *ast.UnaryExpr:
+		if x.Op == token.AND {
+			if t := exprType(x.X, locals); t != nil {
+				return t
+			}
+		}
+		return valueType(x)
+	case *ast.StarExpr:
+		if t, ok := exprType(x.X, locals).(*ast.StarExpr); ok {
+			return t.X
+		}
+	}
+	return valueType(e)
+}
+
+func requestParam(fn *ast.FuncDecl) string {
+	for _, field := range fn.Type.Params.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "Request" && isIdent(sel.X, "http") && len(field.Names) == 1 {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+func paramName(fn *ast.FuncDecl, pos int) string {
+	i := 0
+	for _, field := range fn.Type.Params.List {
+		names := max(1, len(field.Names))
+		if pos < i+names {
+			if len(field.Names) == 0 {
+				return ""
+			}
+			return field.Names[pos-i].Name
+		}
+		i += names
+	}
+	return ""
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	ident, ok := e.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func isCall(call *ast.CallExpr, pkg, name string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == name && isIdent(sel.X, pkg)
+}
+
+// isQuery matches r.URL.Query() and variables holding it
+func isQuery(e ast.Expr, req string, queryVars map[string]bool) bool {
+	if ident, ok := e.(*ast.Ident); ok {
+		return queryVars[ident.Name]
+	}
+	call, ok := e.(*ast.CallExpr)
+	if !ok || selectorName(call.Fun) != "Query" {
+		return false
+	}
+	url, ok := call.Fun.(*ast.SelectorExpr).X.(*ast.SelectorExpr)
+	return ok && url.Sel.Name == "URL" && isIdent(url.X, req)
+}
+
+// isForm matches r.Form and r.PostForm
+func isForm(e ast.Expr, req string) bool {
+	sel, ok := e.(*ast.SelectorExpr)
+	return ok && (sel.Sel.Name == "Form" || sel.Sel.Name == "PostForm") && isIdent(sel.X, req)
+}
+
+// isJSONBodyDecode matches json.NewDecoder(r.Body).Decode(&v)
+func isJSONBodyDecode(call *ast.CallExpr, req string) bool {
+	if selectorName(call.Fun) != "Decode" || len(call.Args) != 1 {
+		return false
+	}
+	inner, ok := call.Fun.(*ast.SelectorExpr).X.(*ast.CallExpr)
+	if !ok || selectorName(inner.Fun) != "NewDecoder" || len(inner.Args) != 1 {
+		return false
+	}
+	body, ok := inner.Args[0].(*ast.SelectorExpr)
+	return ok && body.Sel.Name == "Body" && isIdent(body.X, req)
+}
+
+var statusCodes = map[string]int{
+	"StatusOK":        http.StatusOK,
+	"StatusCreated":   http.StatusCreated,
+	"StatusAccepted":  http.StatusAccepted,
+	"StatusNoContent": http.StatusNoContent,
+}
+
+func statusCode(e ast.Expr) int {
+	if code, ok := statusCodes[selectorName(e)]; ok {
+		return code
+	}
+	if lit, ok := e.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		code, _ := strconv.Atoi(lit.Value)
+		return code
+	}
+	return 0
+}
+
+// Check compares the parameters the handlers accept with the operations of
+// a spec and returns a description of each parameter missing from the spec
+func Check(accepted []Operation, spec map[string][]Param) []string {
+	var problems []string
+	for _, op := range accepted {
+		params, ok := spec[op.Key()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not in the spec", op.Key()))
+			continue
+		}
+		for _, p := range op.Parameters {
+			if p.In != "query" {
+				continue
+			}
+			if !slices.ContainsFunc(params, func(sp Param) bool { return sp.In == p.In && sp.Name == p.Name }) {
+				problems = append(problems, fmt.Sprintf("%s: handler %s accepts query parameter %q which is not in the spec",
+					op.Key(), ShortHandlerName(op.Handler), p.Name))
+			}
+		}
+	}
+	return problems
+}
+
+// FindRoot returns the repository root above dir
+func FindRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no go.mod found above %s", dir)
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,166 @@
+//go:build ignore
+
+package main
+
+// This program generates operations_generated.go, the table of the query
+// parameters and the body and response types of the API operations read from
+// the handlers.  It can be invoked by running go generate
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.podman.io/podman/v6/pkg/api/server/openapi/extract"
+)
+
+var bodyTmpl = `// Code generated by go generate; DO NOT EDIT.
+//go:build !remote && (linux || freebsd)
+
+package openapi
+
+import (
+	"reflect"
+{{range $path, $alias := .Imports}}
+	{{$alias}} {{quote $path}}{{end}}
+)
+
+var operations = map[string]operationInfo{
+{{- range .Operations}}
+	{{quote .Key}}: {
+		Handler: {{quote .Handler}},
+		{{- if .OperationID}}
+		OperationID: {{quote .OperationID}},{{end}}
+		{{- if .Summary}}
+		Summary: {{quote .Summary}},{{end}}
+		{{- if .Description}}
+		Description: {{quote .Description}},{{end}}
+		{{- if .Tags}}
+		Tags: []string{ {{- range $i, $t := .Tags}}{{if $i}}, {{end}}{{quote $t}}{{end}} },{{end}}
+		{{- if .Deprecated}}
+		Deprecated: true,{{end}}
+		{{- if .Parameters}}
+		Parameters: []parameter{
+		{{- range .Parameters}}
+			{Name: {{quote .Name}}, In: {{quote .In}}, Type: {{quote .Type}}
+			{{- if .Items}}, Items: {{quote .Items}}{{end}}
+			{{- if .Description}}, Description: {{quote .Description}}{{end}}
+			{{- if .Required}}, Required: true{{end}}},
+		{{- end}}
+		},{{end}}
+		{{- if .HasBody}}
+		HasBody: true,{{end}}
+		{{- with .Body}}
+		Body: {{typeFor .}},{{end}}
+		{{- with .Response}}
+		Response: {{typeFor .}},{{end}}
+		{{- if .Status}}
+		Status: {{.Status}},{{end}}
+	},
+{{- end}}
+}
+`
+
+func main() {
+	root, err := extract.FindRoot(".")
+	if err != nil {
+		panic(err)
+	}
+	ops, err := extract.Load(root)
+	if err != nil {
+		panic(err)
+	}
+	ops = extract.Merge(ops)
+
+	imports := importAliases(ops)
+	funcs := template.FuncMap{
+		"quote": strconv.Quote,
+		"typeFor": func(ref *extract.TypeRef) string {
+			t := imports[ref.ImportPath] + "." + ref.Name
+			if ref.Slice {
+				t = "[]" + t
+			}
+			return fmt.Sprintf("reflect.TypeFor[%s]()", t)
+		},
+	}
+	tmpl := template.Must(template.New("operations").Funcs(funcs).Parse(bodyTmpl))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Imports    map[string]string
+		Operations []extract.Operation
+	}{imports, ops})
+	if err != nil {
+		panic(err)
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(fmt.Errorf("formatting generated code: %w", err))
+	}
+	if err := os.WriteFile("operations_generated.go", out, 0o644); err != nil {
+		panic(err)
+	}
+}
+
+// importAliases returns a unique alias for each package holding a body or
+// response type, package names are extended by their parent directories until
+// they no longer collide
+func importAliases(ops []extract.Operation) map[string]string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, op := range ops {
+		for _, ref := range []*extract.TypeRef{op.Body, op.Response} {
+			if ref != nil && !seen[ref.ImportPath] {
+				seen[ref.ImportPath] = true
+				paths = append(paths, ref.ImportPath)
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	aliases := make(map[string]string, len(paths))
+	for depth := 1; len(aliases) < len(paths); depth++ {
+		count := make(map[string]int)
+		candidates := make(map[string]string)
+		for _, p := range paths {
+			if _, done := aliases[p]; done {
+				continue
+			}
+			candidates[p] = alias(p, depth)
+			count[candidates[p]]++
+		}
+		for _, a := range aliases {
+			count[a]++
+		}
+		for p, a := range candidates {
+			if count[a] == 1 {
+				aliases[p] = a
+			}
+		}
+	}
+	return aliases
+}
+
+// alias joins the last depth elements of an import path into an identifier
+func alias(path string, depth int) string {
+	elems := strings.Split(path, "/")
+	elems = elems[max(0, len(elems)-depth):]
+	var b strings.Builder
+	for _, e := range elems {
+		for _, c := range e {
+			if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' && b.Len() > 0 {
+				b.WriteRune(c)
+			}
+		}
+	}
+	// Do not shadow the reflect import
+	if b.String() == "reflect" {
+		return "reflect" + strconv.Itoa(depth)
+	}
+	return b.String()
+}
@@ -0,0 +1,305 @@
+//go:build !remote && (linux || freebsd)
+
+//go:generate go run generator/generator.go
+
+// Package openapi builds an OpenAPI 3 document of the API from the routes
+// registered with the server.  The query parameters and the body and
+// response types of each route are taken from a table generated from the
+// source of the handlers, so the document follows the handlers instead of
+// hand written annotations.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"go.podman.io/podman/v6/pkg/api/handlers/utils"
+	"go.podman.io/podman/v6/pkg/api/server/openapi/extract"
+	"go.podman.io/podman/v6/pkg/errorhandling"
+)
+
+// Version is the version of the OpenAPI specification the document follows
+const Version = "3.0.3"
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info is the metadata of the API
+type Info struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Version     string   `json:"version"`
+	License     *License `json:"license,omitempty"`
+}
+
+// License of the API
+type License struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// Server is a base URL of the API
+type Server struct {
+	URL       string                    `json:"url"`
+	Variables map[string]ServerVariable `json:"variables,omitempty"`
+}
+
+// ServerVariable is a variable of a server URL
+type ServerVariable struct {
+	Default     string `json:"default"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds the operations of a path by lower case method
+type PathItem map[string]*Operation
+
+// Operation is one method of a path
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter of an operation
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody of an operation
+type RequestBody struct {
+	Content map[string]MediaType `json:"content"`
+}
+
+// Response of an operation
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the content of a body
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Components holds the schemas referenced by the document
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// operationInfo is what is known about an operation from the source of its
+// handler, see operations_generated.go
+type operationInfo struct {
+	Handler     string
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+	Parameters  []parameter
+	HasBody     bool
+	Body        reflect.Type
+	Response    reflect.Type
+	Status      int
+}
+
+type parameter struct {
+	Name        string
+	In          string
+	Type        string
+	Items       string
+	Description string
+	Required    bool
+}
+
+var pathVar = regexp.MustCompile(`\{(\w+)\}`)
+
+// Build returns the document of the routes of the router
+func Build(router *mux.Router, apiVersion string) (*Document, error) {
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "Podman API",
+			Description: "The Docker compatible API and the Libpod API of the Podman service. See podman-system-service(1) for more information.",
+			Version:     apiVersion,
+			License:     &License{Name: "Apache-2.0", URL: "https://opensource.org/licenses/Apache-2.0"},
+		},
+		Servers: []Server{{
+			URL: "/v{version}",
+			Variables: map[string]ServerVariable{
+				"version": {Default: apiVersion, Description: "Version of the API, the paths are also served without it"},
+			},
+		}},
+		Paths: make(map[string]*PathItem),
+	}
+	schemas := newSchemaGenerator()
+	errorSchema := schemas.schemaFor(reflect.TypeFor[errorhandling.ErrorModel]())
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			// Routes matching on something else than the path, e.g. gRPC
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Catch-all routes of unsupported endpoints
+			return nil
+		}
+		path := extract.NormalizePath(template)
+		item := doc.Paths[path]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		for _, method := range methods {
+			key := strings.ToLower(method)
+			if _, ok := (*item)[key]; ok {
+				continue
+			}
+			(*item)[key] = buildOperation(method, path, operations[method+" "+path], schemas, errorSchema)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uniqueOperationIDs(doc); err != nil {
+		return nil, err
+	}
+	doc.Components.Schemas = schemas.components
+	return doc, nil
+}
+
+func buildOperation(method, path string, info operationInfo, schemas *schemaGenerator, errorSchema *Schema) *Operation {
+	op := &Operation{
+		OperationID: info.OperationID,
+		Summary:     info.Summary,
+		Description: info.Description,
+		Tags:        info.Tags,
+		Deprecated:  info.Deprecated,
+		Responses:   make(map[string]*Response),
+	}
+	if op.OperationID == "" {
+		op.OperationID = operationID(method, path)
+	}
+
+	for _, m := range pathVar.FindAllStringSubmatch(path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	for _, p := range info.Parameters {
+		schema := &Schema{Type: p.Type}
+		if p.Type == "array" {
+			schema.Items = &Schema{Type: p.Items}
+		}
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        p.Name,
+			In:          p.In,
+			Description: p.Description,
+			Required:    p.Required,
+			Schema:      schema,
+		})
+	}
+
+	switch {
+	case info.Body != nil:
+		op.RequestBody = &RequestBody{Content: map[string]MediaType{
+			"application/json": {Schema: schemas.schemaFor(info.Body)},
+		}}
+	case info.HasBody:
+		op.RequestBody = &RequestBody{Content: map[string]MediaType{
+			"application/octet-stream": {Schema: &Schema{Type: "string", Format: "binary"}},
+		}}
+	}
+
+	status := info.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	response := &Response{Description: http.StatusText(status)}
+	if info.Response != nil {
+		response.Content = map[string]MediaType{
+			"application/json": {Schema: schemas.schemaFor(info.Response)},
+		}
+	}
+	op.Responses[fmt.Sprint(status)] = response
+	op.Responses["default"] = &Response{
+		Description: "Error",
+		Content:     map[string]MediaType{"application/json": {Schema: errorSchema}},
+	}
+	return op
+}
+
+// operationID derives an operation ID for routes without a swagger comment,
+// e.g. GetLibpodOpenapi for GET /libpod/openapi
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(method[:1]) + strings.ToLower(method[1:]))
+	for _, elem := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '_' || r == '-' || r == '{' || r == '}'
+	}) {
+		b.WriteString(strings.ToUpper(elem[:1]) + elem[1:])
+	}
+	return b.String()
+}
+
+// uniqueOperationIDs returns an error naming the operations sharing an ID
+func uniqueOperationIDs(doc *Document) error {
+	seen := make(map[string]string)
+	var dups []string
+	for path, item := range doc.Paths {
+		for method, op := range *item {
+			key := strings.ToUpper(method) + " " + path
+			if other, ok := seen[op.OperationID]; ok {
+				dups = append(dups, fmt.Sprintf("%s (%s and %s)", op.OperationID, other, key))
+				continue
+			}
+			seen[op.OperationID] = key
+		}
+	}
+	if len(dups) > 0 {
+		sort.Strings(dups)
+		return fmt.Errorf("duplicate operation IDs: %s", strings.Join(dups, ", "))
+	}
+	return nil
+}
+
+// Handler serves the document of the routes of the router.  It is built on
+// the first request, when all routes are registered.
+func Handler(router *mux.Router, apiVersion string) http.HandlerFunc {
+	var (
+		once sync.Once
+		doc  *Document
+		err  error
+	)
+	return func(w http.ResponseWriter, _ *http.Request) {
+		once.Do(func() {
+			doc, err = Build(router, apiVersion)
+		})
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		utils.WriteResponse(w, http.StatusOK, doc)
+	}
+}
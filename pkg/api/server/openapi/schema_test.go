@@ -0,0 +1,53 @@
+//go:build !remote && (linux || freebsd)
+
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBase struct {
+	ID      string `json:"Id"`
+	Created time.Time
+}
+
+type testObject struct {
+	testBase
+	Name     string            `json:"name"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Data     []byte
+	Size     int64
+	Children []*testObject
+	Ignored  string `json:"-"`
+}
+
+func TestSchemaFor(t *testing.T) {
+	g := newSchemaGenerator()
+	schema := g.schemaFor(reflect.TypeFor[[]testObject]())
+	assert.Equal(t, "array", schema.Type)
+	assert.Equal(t, componentsPrefix+"testObject", schema.Items.Ref)
+
+	obj, ok := g.components["testObject"]
+	require.True(t, ok)
+	assert.Equal(t, "object", obj.Type)
+	assert.Equal(t, map[string]*Schema{
+		"Id":       {Type: "string"},
+		"Created":  {Type: "string", Format: "date-time"},
+		"name":     {Type: "string"},
+		"labels":   {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		"Data":     {Type: "string", Format: "byte"},
+		"Size":     {Type: "integer", Format: "int64"},
+		"Children": {Type: "array", Items: &Schema{Ref: componentsPrefix + "testObject"}},
+	}, obj.Properties)
+}
+
+func TestOperationID(t *testing.T) {
+	assert.Equal(t, "GetLibpodOpenapi", operationID("GET", "/libpod/openapi"))
+	assert.Equal(t, "PostLibpodPlayKube", operationID("POST", "/libpod/play/kube"))
+	assert.Equal(t, "DeleteLibpodContainersName", operationID("DELETE", "/libpod/containers/{name}"))
+}
@@ -0,0 +1,72 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/podman/v6/pkg/api/server/openapi"
+	"go.podman.io/podman/v6/pkg/api/server/openapi/extract"
+)
+
+// TestOpenAPIConformance checks that every parameter accepted by a handler is
+// in the served OpenAPI document.  Run "make openapi" to regenerate the
+// operation table after changing a handler.
+func TestOpenAPIConformance(t *testing.T) {
+	router := mux.NewRouter().UseEncodedPath()
+	require.NoError(t, (&APIServer{}).registerHandlers(router))
+	doc, err := openapi.Build(router, "6.0.0")
+	require.NoError(t, err)
+
+	spec := make(map[string][]extract.Param)
+	for path, item := range doc.Paths {
+		for method, op := range *item {
+			var params []extract.Param
+			for _, p := range op.Parameters {
+				params = append(params, extract.Param{Name: p.Name, In: p.In})
+			}
+			spec[strings.ToUpper(method)+" "+path] = params
+		}
+	}
+
+	root, err := extract.FindRoot(".")
+	require.NoError(t, err)
+	accepted, err := extract.Load(root)
+	require.NoError(t, err)
+	require.NotEmpty(t, accepted)
+
+	for _, problem := range extract.Check(accepted, spec) {
+		t.Error(problem)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	router := mux.NewRouter().UseEncodedPath()
+	require.NoError(t, (&APIServer{}).registerHandlers(router))
+	doc, err := openapi.Build(router, "6.0.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	item, ok := doc.Paths["/libpod/containers/{name}/json"]
+	require.True(t, ok)
+	op := (*item)["get"]
+	require.NotNil(t, op)
+	assert.Equal(t, "ContainerInspectLibpod", op.OperationID)
+	assert.Contains(t, op.Parameters, openapi.Parameter{Name: "name", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}})
+	assert.Contains(t, op.Responses, "200")
+	assert.Contains(t, op.Responses, "default")
+
+	item, ok = doc.Paths["/libpod/containers/create"]
+	require.True(t, ok)
+	op = (*item)["post"]
+	require.NotNil(t, op)
+	require.NotNil(t, op.RequestBody)
+	schema := op.RequestBody.Content["application/json"].Schema
+	require.NotNil(t, schema)
+	assert.Equal(t, "#/components/schemas/SpecGenerator", schema.Ref)
+	assert.Contains(t, doc.Components.Schemas, "SpecGenerator")
+}
@@ -0,0 +1,409 @@
+//go:build !remote
+
+// Package ratelimit limits the request rate and the number of requests in
+// flight of each client of the API service.  Requests are sorted into
+// classes, each with its own limits, so a client pulling images does not use
+// up the budget of its other requests.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/api/server/authz"
+)
+
+// Class of requests sharing limits
+type Class string
+
+const (
+	// Pull is the class of image pulls
+	Pull Class = "pull"
+	// Build is the class of image builds
+	Build Class = "build"
+	// Default is the class of all other requests
+	Default Class = "default"
+)
+
+// Classes lists all request classes
+var Classes = []Class{Pull, Build, Default}
+
+var (
+	pullEndpoints = []authz.Endpoint{
+		{Methods: []string{http.MethodPost}, Path: "/images/create"},
+		{Methods: []string{http.MethodPost}, Path: "/libpod/images/pull"},
+		{Methods: []string{http.MethodPost}, Path: "/libpod/artifacts/pull"},
+	}
+	buildEndpoints = []authz.Endpoint{
+		{Methods: []string{http.MethodPost}, Path: "/build"},
+		{Methods: []string{http.MethodPost}, Path: "/libpod/build"},
+	}
+	// exemptEndpoints are never limited, health checks must keep working
+	// for a busy client
+	exemptEndpoints = []authz.Endpoint{
+		{Path: "/_ping"},
+		{Path: "/libpod/_ping"},
+	}
+	// longRunningEndpoints are not counted as in flight, a client waiting on
+	// a container would otherwise block its own requests.  Starts wait for
+	// the healthy dependencies and sidecars of the containers.
+	longRunningEndpoints = []authz.Endpoint{
+		{Path: "/containers/*/attach"},
+		{Path: "/containers/*/start"},
+		{Path: "/containers/*/wait"},
+		{Path: "/exec/*/start"},
+		{Path: "/libpod/containers/*/attach"},
+		{Path: "/libpod/containers/*/start"},
+		{Path: "/libpod/containers/*/wait"},
+		{Path: "/libpod/exec/*/start"},
+		{Path: "/libpod/pods/*/start"},
+		{Path: "/libpod/pods/*/wait"},
+	}
+	// waitingEndpoints are long running when the wait parameter is set
+	waitingEndpoints = []authz.Endpoint{
+		{Methods: []string{http.MethodPost}, Path: "/libpod/play/kube"},
+		{Methods: []string{http.MethodPost}, Path: "/libpod/kube/play"},
+	}
+)
+
+func matchAny(endpoints []authz.Endpoint, method, path string) bool {
+	return slices.ContainsFunc(endpoints, func(e authz.Endpoint) bool {
+		return e.Matches(method, path)
+	})
+}
+
+// ClassOf returns the class of a request
+func ClassOf(method, path string) Class {
+	switch {
+	case matchAny(pullEndpoints, method, path):
+		return Pull
+	case matchAny(buildEndpoints, method, path):
+		return Build
+	default:
+		return Default
+	}
+}
+
+// Exempt returns true if the request is not subject to any limit
+func Exempt(method, path string) bool {
+	return matchAny(exemptEndpoints, method, path)
+}
+
+// countsInFlight returns true if the request holds an in flight slot.  Reads
+// and streams are cheap for the service and may last for the lifetime of
+// the client, only the other requests are counted.
+func countsInFlight(method, path string, query url.Values) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return false
+	}
+	if matchAny(waitingEndpoints, method, path) {
+		wait, _ := strconv.ParseBool(query.Get("wait"))
+		return !wait
+	}
+	return !matchAny(longRunningEndpoints, method, path)
+}
+
+// Limits of one class of requests, zero values mean unlimited
+type Limits struct {
+	// Rate is the number of requests per second a client may make on
+	// average
+	Rate float64
+	// Burst is the number of requests a client may make at once
+	Burst int
+	// MaxInFlight is the number of requests of a client processed at the
+	// same time, further requests wait in a queue
+	MaxInFlight int
+}
+
+// ParseRate parses a rate of the form N/UNIT, UNIT being s, m or h.  It
+// returns the rate per second and N, the burst allowed by the rate.
+func ParseRate(s string) (float64, int, error) {
+	count, unit, found := strings.Cut(s, "/")
+	if !found {
+		unit = "s"
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n <= 0 {
+		return 0, 0, fmt.Errorf("invalid rate %q: count must be a positive integer", s)
+	}
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return 0, 0, fmt.Errorf("invalid rate %q: unit must be one of s, m or h", s)
+	}
+	return float64(n) / per.Seconds(), n, nil
+}
+
+// ParseLimits returns the limits of each class from the rates, in the form
+// accepted by ParseRate, and the in flight limits given by class name
+func ParseLimits(rates map[string]string, maxInFlight map[string]int) (map[Class]Limits, error) {
+	limits := make(map[Class]Limits, len(Classes))
+	for name, rate := range rates {
+		class, err := parseClass(name)
+		if err != nil {
+			return nil, err
+		}
+		l := limits[class]
+		if l.Rate, l.Burst, err = ParseRate(rate); err != nil {
+			return nil, err
+		}
+		limits[class] = l
+	}
+	for name, n := range maxInFlight {
+		class, err := parseClass(name)
+		if err != nil {
+			return nil, err
+		}
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid in flight limit %d for %s requests: must be a positive integer", n, class)
+		}
+		l := limits[class]
+		l.MaxInFlight = n
+		limits[class] = l
+	}
+	return limits, nil
+}
+
+func parseClass(name string) (Class, error) {
+	if !slices.Contains(Classes, Class(name)) {
+		return "", fmt.Errorf("invalid request class %q: must be one of pull, build or default", name)
+	}
+	return Class(name), nil
+}
+
+// LimitError is returned when a request is refused
+type LimitError struct {
+	// Class of the request
+	Class Class
+	// Reason the request was refused
+	Reason string
+	// RetryAfter is the time after which the request may succeed
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("too many %s requests: %s", e.Class, e.Reason)
+}
+
+// RetryAfterSeconds returns RetryAfter rounded up to whole seconds, as used
+// by the Retry-After header
+func (e *LimitError) RetryAfterSeconds() int {
+	return max(1, int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// QueueTracker is told about requests waiting in a queue
+type QueueTracker interface {
+	Enqueue()
+	Dequeue()
+}
+
+// sweepInterval is the interval at which the state of idle clients is
+// dropped
+const sweepInterval = time.Minute
+
+// Limiter enforces the limits of each client
+type Limiter struct {
+	limits       map[Class]Limits
+	queueTimeout time.Duration
+	tracker      QueueTracker
+
+	mu        sync.Mutex
+	clients   map[clientKey]*client
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type clientKey struct {
+	identity string
+	class    Class
+}
+
+type client struct {
+	tokens   float64
+	last     time.Time
+	inFlight int
+	// waiters are the queued requests in arrival order, a slot is handed
+	// over by closing the channel
+	waiters []chan struct{}
+}
+
+// NewLimiter creates a limiter.  Requests waiting longer than queueTimeout
+// for an in flight slot are refused, the tracker may be nil.
+func NewLimiter(limits map[Class]Limits, queueTimeout time.Duration, tracker QueueTracker) *Limiter {
+	return &Limiter{
+		limits:       limits,
+		queueTimeout: queueTimeout,
+		tracker:      tracker,
+		clients:      make(map[clientKey]*client),
+		now:          time.Now,
+	}
+}
+
+// Acquire admits a request of the client.  It waits while the client has
+// too many requests of the class in flight and returns a *LimitError if the
+// request is refused.  The returned function must be called when the request
+// is done.  The query decides if some requests are long running.
+func (l *Limiter) Acquire(ctx context.Context, identity, method, path string, query url.Values) (func(), error) {
+	if Exempt(method, path) {
+		return func() {}, nil
+	}
+	class := ClassOf(method, path)
+	limits := l.limits[class]
+	key := clientKey{identity: identity, class: class}
+
+	l.mu.Lock()
+	now := l.now()
+	l.sweep(now)
+	c := l.clients[key]
+	if c == nil {
+		c = &client{tokens: float64(limits.Burst), last: now}
+		l.clients[key] = c
+	}
+
+	if limits.Rate > 0 {
+		c.tokens = min(float64(limits.Burst), c.tokens+now.Sub(c.last).Seconds()*limits.Rate)
+		c.last = now
+		if c.tokens < 1 {
+			wait := time.Duration((1 - c.tokens) / limits.Rate * float64(time.Second))
+			l.mu.Unlock()
+			return nil, &LimitError{Class: class, Reason: "rate limit exceeded", RetryAfter: wait}
+		}
+		c.tokens--
+	}
+
+	if limits.MaxInFlight <= 0 || !countsInFlight(method, path, query) {
+		l.mu.Unlock()
+		return func() {}, nil
+	}
+	release := func() { l.release(key) }
+	if c.inFlight < limits.MaxInFlight {
+		c.inFlight++
+		l.mu.Unlock()
+		return release, nil
+	}
+
+	ready := make(chan struct{})
+	c.waiters = append(c.waiters, ready)
+	l.mu.Unlock()
+
+	if l.tracker != nil {
+		l.tracker.Enqueue()
+		defer l.tracker.Dequeue()
+	}
+
+	var timeout <-chan time.Time
+	if l.queueTimeout > 0 {
+		timer := time.NewTimer(l.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-ready:
+		return release, nil
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timeout:
+		err = &LimitError{Class: class, Reason: "too many requests in flight", RetryAfter: l.queueTimeout}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i := slices.Index(c.waiters, ready); i >= 0 {
+		c.waiters = slices.Delete(c.waiters, i, i+1)
+		return nil, err
+	}
+	// The slot was handed over while giving up, pass it on
+	l.releaseLocked(key)
+	return nil, err
+}
+
+func (l *Limiter) release(key clientKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.releaseLocked(key)
+}
+
+func (l *Limiter) releaseLocked(key clientKey) {
+	c := l.clients[key]
+	if c == nil {
+		return
+	}
+	if len(c.waiters) > 0 {
+		close(c.waiters[0])
+		c.waiters = c.waiters[1:]
+		return
+	}
+	c.inFlight--
+}
+
+// sweep drops the state of clients without requests in flight and with a
+// full token bucket, they are indistinguishable from new clients
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, c := range l.clients {
+		limits := l.limits[key.class]
+		if c.inFlight > 0 || len(c.waiters) > 0 {
+			continue
+		}
+		if limits.Rate > 0 && c.tokens+now.Sub(c.last).Seconds()*limits.Rate < float64(limits.Burst) {
+			continue
+		}
+		delete(l.clients, key)
+	}
+}
+
+// Info returns the configured limits and the current number of requests in
+// flight and queued
+func (l *Limiter) Info() *define.ServiceInfo {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	info := &define.ServiceInfo{Limits: make([]define.ServiceLimitInfo, 0, len(Classes))}
+	for _, class := range Classes {
+		limits := l.limits[class]
+		limitInfo := define.ServiceLimitInfo{
+			Class:       string(class),
+			Rate:        limits.Rate,
+			Burst:       limits.Burst,
+			MaxInFlight: limits.MaxInFlight,
+		}
+		for key, c := range l.clients {
+			if key.class != class {
+				continue
+			}
+			limitInfo.InFlight += c.inFlight
+			limitInfo.Queued += len(c.waiters)
+		}
+		info.QueueDepth += limitInfo.Queued
+		info.Limits = append(info.Limits, limitInfo)
+	}
+	return info
+}
+
+// IsLimitError returns the *LimitError in the chain of err, if any
+func IsLimitError(err error) (*LimitError, bool) {
+	var limitErr *LimitError
+	ok := errors.As(err, &limitErr)
+	return limitErr, ok
+}
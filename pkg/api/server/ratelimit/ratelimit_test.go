@@ -0,0 +1,177 @@
+//go:build !remote
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassOf(t *testing.T) {
+	for _, tc := range []struct {
+		method string
+		path   string
+		class  Class
+	}{
+		{http.MethodPost, "/v1.41/images/create", Pull},
+		{http.MethodPost, "/v6.0.0/libpod/images/pull", Pull},
+		{http.MethodPost, "/build", Build},
+		{http.MethodPost, "/v6.0.0/libpod/build", Build},
+		{http.MethodPost, "/v6.0.0/libpod/containers/create", Default},
+		{http.MethodGet, "/v6.0.0/libpod/images/pull", Default},
+	} {
+		assert.Equal(t, tc.class, ClassOf(tc.method, tc.path), tc.method+" "+tc.path)
+	}
+	assert.True(t, Exempt(http.MethodGet, "/_ping"))
+	assert.True(t, Exempt(http.MethodHead, "/v6.0.0/libpod/_ping"))
+	assert.False(t, Exempt(http.MethodGet, "/v6.0.0/libpod/info"))
+}
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits(map[string]string{"pull": "10/m", "default": "5"}, map[string]int{"build": 1})
+	require.NoError(t, err)
+	assert.Equal(t, Limits{Rate: 10.0 / 60, Burst: 10}, limits[Pull])
+	assert.Equal(t, Limits{Rate: 5, Burst: 5}, limits[Default])
+	assert.Equal(t, Limits{MaxInFlight: 1}, limits[Build])
+
+	for _, tc := range []struct {
+		rates       map[string]string
+		maxInFlight map[string]int
+		err         string
+	}{
+		{map[string]string{"push": "1/s"}, nil, `invalid request class "push"`},
+		{map[string]string{"pull": "0/s"}, nil, "count must be a positive integer"},
+		{map[string]string{"pull": "1/d"}, nil, "unit must be one of s, m or h"},
+		{nil, map[string]int{"pull": 0}, "must be a positive integer"},
+	} {
+		_, err := ParseLimits(tc.rates, tc.maxInFlight)
+		assert.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(map[Class]Limits{Pull: {Rate: 1, Burst: 2}}, 0, nil)
+	l.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 2 {
+		release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/images/pull", nil)
+		require.NoError(t, err)
+		release()
+	}
+	_, err := l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/images/pull", nil)
+	limitErr, ok := IsLimitError(err)
+	require.True(t, ok, "%v", err)
+	assert.Equal(t, Pull, limitErr.Class)
+	assert.Equal(t, 1, limitErr.RetryAfterSeconds())
+
+	// Other clients and other classes have their own budget
+	_, err = l.Acquire(ctx, "uid=1001", http.MethodPost, "/v6.0.0/libpod/images/pull", nil)
+	assert.NoError(t, err)
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/containers/create", nil)
+	assert.NoError(t, err)
+
+	now = now.Add(time.Second)
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/images/pull", nil)
+	assert.NoError(t, err)
+}
+
+type countingTracker struct {
+	queued chan int
+	depth  int
+}
+
+func (c *countingTracker) Enqueue() { c.depth++; c.queued <- c.depth }
+func (c *countingTracker) Dequeue() { c.depth-- }
+
+func TestInFlightLimit(t *testing.T) {
+	tracker := &countingTracker{queued: make(chan int, 1)}
+	l := NewLimiter(map[Class]Limits{Default: {MaxInFlight: 1}}, time.Minute, tracker)
+	ctx := context.Background()
+	const path = "/v6.0.0/libpod/containers/create"
+
+	release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, path, nil)
+	require.NoError(t, err)
+
+	// Reads and waits do not hold a slot
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodGet, "/v6.0.0/libpod/containers/json", nil)
+	require.NoError(t, err)
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/containers/abc/wait", nil)
+	require.NoError(t, err)
+
+	acquired := make(chan error)
+	go func() {
+		release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, path, nil)
+		if err == nil {
+			release()
+		}
+		acquired <- err
+	}()
+	assert.Equal(t, 1, <-tracker.queued)
+	info := l.Info()
+	assert.Equal(t, 1, info.QueueDepth)
+	for _, limit := range info.Limits {
+		if limit.Class == string(Default) {
+			assert.Equal(t, 1, limit.InFlight)
+			assert.Equal(t, 1, limit.Queued)
+			assert.Equal(t, 1, limit.MaxInFlight)
+		}
+	}
+
+	release()
+	require.NoError(t, <-acquired)
+	assert.Equal(t, 0, l.Info().QueueDepth)
+}
+
+func TestLongRunningRequests(t *testing.T) {
+	l := NewLimiter(map[Class]Limits{Default: {MaxInFlight: 1}}, 10*time.Millisecond, nil)
+	ctx := context.Background()
+	wait := url.Values{"wait": []string{"true"}}
+
+	// Requests blocked on a pod do not use up the slot
+	for _, path := range []string{
+		"/v6.0.0/libpod/pods/mypod/wait",
+		"/v6.0.0/libpod/pods/mypod/start",
+		"/v6.0.0/libpod/play/kube",
+		"/v6.0.0/libpod/kube/play",
+	} {
+		release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, path, wait)
+		require.NoError(t, err, path)
+		defer release()
+	}
+
+	release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/kube/play", nil)
+	require.NoError(t, err)
+	defer release()
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodPost, "/v6.0.0/libpod/containers/create", nil)
+	_, ok := IsLimitError(err)
+	assert.True(t, ok, "play kube without wait holds the slot: %v", err)
+}
+
+func TestQueueTimeout(t *testing.T) {
+	l := NewLimiter(map[Class]Limits{Build: {MaxInFlight: 1}}, 10*time.Millisecond, nil)
+	ctx := context.Background()
+
+	release, err := l.Acquire(ctx, "uid=1000", http.MethodPost, "/build", nil)
+	require.NoError(t, err)
+	defer release()
+
+	_, err = l.Acquire(ctx, "uid=1000", http.MethodPost, "/build", nil)
+	limitErr, ok := IsLimitError(err)
+	require.True(t, ok, "%v", err)
+	assert.Equal(t, "too many build requests: too many requests in flight", limitErr.Error())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = l.Acquire(cancelled, "uid=1000", http.MethodPost, "/build", nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 0, l.Info().QueueDepth)
+}
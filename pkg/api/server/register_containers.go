@@ -614,6 +614,66 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	r.HandleFunc(VersionedPath("/containers/{name}/attach"), s.APIHandler(compat.AttachContainer)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
 	r.HandleFunc("/containers/{name}/attach", s.APIHandler(compat.AttachContainer)).Methods(http.MethodPost)
+	// swagger:operation GET /containers/{name}/attach/ws compat ContainerAttachWebSocket
+	// ---
+	// tags:
+	//   - containers (compat)
+	// summary: Attach to a container over WebSocket
+	// description: |
+	//   Attach to a container over a WebSocket connection. The request must be a WebSocket handshake (RFC 6455).
+	//
+	//   The output of the container is sent as binary messages holding the raw stream, without the
+	//   headers used by the attach endpoint to multiplex stdout and stderr. Text and binary messages
+	//   sent by the client are written to the standard input of the container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: detachKeys
+	//    required: false
+	//    type: string
+	//    description: keys to use for detaching from the container
+	//  - in: query
+	//    name: logs
+	//    required: false
+	//    type: boolean
+	//    description: Stream all logs from the container across the connection. Happens before streaming attach (if requested). At least one of logs or stream must be set
+	//  - in: query
+	//    name: stream
+	//    required: false
+	//    type: boolean
+	//    default: true
+	//    description: Attach to the container. If unset, and logs is set, only the container's logs will be sent. At least one of stream or logs must be set
+	//  - in: query
+	//    name: stdout
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDOUT
+	//  - in: query
+	//    name: stderr
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDERR
+	//  - in: query
+	//    name: stdin
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDIN
+	// responses:
+	//   101:
+	//     description: No error, connection has been upgraded to WebSocket.
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/containers/{name}/attach/ws"), s.APIHandler(compat.AttachContainerWebSocket)).Methods(http.MethodGet)
+	// Added non version path to URI to support docker non versioned paths
+	r.HandleFunc("/containers/{name}/attach/ws", s.APIHandler(compat.AttachContainerWebSocket)).Methods(http.MethodGet)
 	// swagger:operation POST /containers/{name}/resize compat ContainerResize
 	// ---
 	// tags:
@@ -754,6 +814,12 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//      schema:
 	//        $ref: "#/definitions/SpecGenerator"
 	//      required: true
+	//    - in: header
+	//      name: Idempotency-Key
+	//      type: string
+	//      description: |
+	//        Key identifying the request, up to 255 printable ASCII characters.
+	//        If a container was created by an earlier request with the same key and body in the last 24 hours, it is returned instead of creating a new one and the response has the Idempotent-Replayed header set.
 	//   responses:
 	//     201:
 	//       $ref: "#/responses/containerCreateResponse"
@@ -763,6 +829,8 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//       $ref: "#/responses/containerNotFound"
 	//     409:
 	//       $ref: "#/responses/conflictError"
+	//     422:
+	//       $ref: "#/responses/idempotencyKeyReused"
 	//     500:
 	//       $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/create"), s.APIHandler(libpod.CreateContainer)).Methods(http.MethodPost)
@@ -842,6 +910,30 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/json"), s.APIHandler(libpod.ListContainers)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/containers/removed libpod ContainerListRemovedLibpod
+	// ---
+	// tags:
+	//  - containers
+	// summary: List removed containers
+	// description: |
+	//   Returns the tombstones kept for removed containers, most recently removed first.
+	//   A tombstone records the container's configuration summary, its final state and exit code,
+	//   when it ran, and the last lines it logged if its log driver kept them with the container.
+	// parameters:
+	//  - in: query
+	//    name: last
+	//    description: Return this number of most recently removed containers.
+	//    type: integer
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/containersListRemovedLibpod"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/removed"), s.APIHandler(libpod.ListRemovedContainers)).Methods(http.MethodGet)
 	// swagger:operation POST  /libpod/containers/prune libpod ContainerPruneLibpod
 	// ---
 	// tags:
@@ -1049,6 +1141,11 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   Get stdout and stderr logs from a container.
 	//
 	//   The stream format is the same as described in the attach endpoint.
+	//
+	//   If the Accept header asks for `text/event-stream`, the log lines are sent as Server-Sent Events
+	//   named after their stream (`stdout` or `stderr`), with the JSON encoded line as data. Each event
+	//   carries the time of the line as event ID, a client reconnecting with this ID as Last-Event-ID
+	//   header resumes after that line.
 	// parameters:
 	//  - in: path
 	//    name: name
@@ -1085,11 +1182,18 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: string
 	//    description: Only return this number of log lines from the end of the logs
 	//    default: all
+	//  - in: header
+	//    name: Last-Event-ID
+	//    type: string
+	//    description: Resume a Server-Sent Events stream after the line with this ID, overrides since and tail
 	// produces:
 	// - application/json
+	// - text/event-stream
 	// responses:
 	//   200:
 	//     description:  logs returned as a stream in response body.
+	//   400:
+	//      $ref: "#/responses/badParamError"
 	//   404:
 	//      $ref: "#/responses/containerNotFound"
 	//   500:
@@ -1208,7 +1312,11 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// tags:
 	//  - containers
 	// summary: Get stats for one or more containers
-	// description: Return a live stream of resource usage statistics of one or more container. If no container is specified, the statistics of all containers are returned.
+	// description: |
+	//   Return a live stream of resource usage statistics of one or more container. If no container is specified, the statistics of all containers are returned.
+	//
+	//   If the Accept header asks for `text/event-stream`, each report is sent as a Server-Sent Event named `stats`.
+	//   Statistics are sampled live, a reconnecting client receives the next reports.
 	// parameters:
 	//  - in: query
 	//    name: containers
@@ -1233,6 +1341,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    description: Provide statistics for all running containers
 	// produces:
 	// - application/json
+	// - text/event-stream
 	// responses:
 	//   200:
 	//     $ref: "#/responses/containerStats"
@@ -1549,6 +1658,64 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/attach"), s.APIHandler(compat.AttachContainer)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/containers/{name}/attach/ws libpod ContainerAttachWebSocketLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Attach to a container over WebSocket
+	// description: |
+	//   Attach to a container over a WebSocket connection. The request must be a WebSocket handshake (RFC 6455).
+	//
+	//   The output of the container is sent as binary messages holding the raw stream, without the
+	//   headers used by the attach endpoint to multiplex stdout and stderr. Text and binary messages
+	//   sent by the client are written to the standard input of the container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: detachKeys
+	//    required: false
+	//    type: string
+	//    description: keys to use for detaching from the container
+	//  - in: query
+	//    name: logs
+	//    required: false
+	//    type: boolean
+	//    description: Stream all logs from the container across the connection. Happens before streaming attach (if requested). At least one of logs or stream must be set
+	//  - in: query
+	//    name: stream
+	//    required: false
+	//    type: boolean
+	//    default: true
+	//    description: Attach to the container. If unset, and logs is set, only the container's logs will be sent. At least one of stream or logs must be set
+	//  - in: query
+	//    name: stdout
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDOUT
+	//  - in: query
+	//    name: stderr
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDERR
+	//  - in: query
+	//    name: stdin
+	//    required: false
+	//    type: boolean
+	//    description: Attach to container STDIN
+	// responses:
+	//   101:
+	//     description: No error, connection has been upgraded to WebSocket.
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/attach/ws"), s.APIHandler(compat.AttachContainerWebSocket)).Methods(http.MethodGet)
 	// swagger:operation POST /libpod/containers/{name}/resize libpod ContainerResizeLibpod
 	// ---
 	// tags:
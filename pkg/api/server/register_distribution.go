@@ -3,13 +3,53 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/gorilla/mux"
 	"go.podman.io/podman/v6/pkg/api/handlers/compat"
 )
 
 func (s *APIServer) registerDistributionHandlers(r *mux.Router) error {
-	r.HandleFunc(VersionedPath("/distribution/{name}/json"), compat.UnsupportedHandler)
+	// swagger:operation GET /distribution/{name}/json compat DistributionInspect
+	// ---
+	// tags:
+	//  - images (compat)
+	// summary: Get image information from the registry
+	// description: |
+	//   Return the manifest descriptor of an image and the platforms it supports by querying the registry.
+	//   The image is not pulled. Credentials for the registry can be passed via the X-Registry-Auth header.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: name of the image, optionally with tag or digest
+	//  - in: query
+	//    name: tlsVerify
+	//    type: boolean
+	//    default: true
+	//    description: Require HTTPS and verify certificates when contacting registries.
+	//  - in: header
+	//    name: X-Registry-Auth
+	//    type: string
+	//    description: "base-64 encoded auth config. Must include the following four values: username, password, email and server address OR simply just an identity token."
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/distributionInspectResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   401:
+	//     $ref: "#/responses/registryBadAuth"
+	//   403:
+	//     $ref: "#/responses/errorFromRegistry"
+	//   404:
+	//     $ref: "#/responses/imageNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/distribution/{name:.*}/json"), s.APIHandler(compat.InspectDistribution)).Methods(http.MethodGet)
 	// Added non version path to URI to support docker non versioned paths
-	r.HandleFunc("/distribution/{name}/json", compat.UnsupportedHandler)
+	r.Handle("/distribution/{name:.*}/json", s.APIHandler(compat.InspectDistribution)).Methods(http.MethodGet)
 	return nil
 }
@@ -44,9 +44,15 @@ func (s *APIServer) registerEventsHandlers(r *mux.Router) error {
 	// tags:
 	//   - system
 	// summary: Get events
-	// description: Returns events filtered on query parameters
+	// description: |
+	//   Returns events filtered on query parameters.
+	//
+	//   If the Accept header asks for `text/event-stream`, the events are sent as Server-Sent Events.
+	//   Each event is named after the event type and carries its time as event ID, a client
+	//   reconnecting with this ID as Last-Event-ID header resumes after that event.
 	// produces:
 	// - application/json
+	// - text/event-stream
 	// parameters:
 	// - name: since
 	//   type: string
@@ -65,9 +71,15 @@ func (s *APIServer) registerEventsHandlers(r *mux.Router) error {
 	//   in: query
 	//   default: true
 	//   description: when false, do not follow events
+	// - name: Last-Event-ID
+	//   type: string
+	//   in: header
+	//   description: resume a Server-Sent Events stream after the event with this ID, overrides since
 	// responses:
 	//   200:
 	//     description: returns a string of json data describing an event
+	//   400:
+	//     "$ref": "#/responses/badParamError"
 	//   500:
 	//     "$ref": "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/events"), s.APIHandler(compat.GetEvents)).Methods(http.MethodGet)
@@ -295,6 +295,45 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/exec/{id}/start"), s.APIHandler(compat.ExecStartHandler)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/exec/{id}/start/ws exec ExecStartWebSocketLibpod
+	// ---
+	// tags:
+	//   - exec
+	// summary: Start an exec instance over WebSocket
+	// description: |
+	//   Starts a previously set up exec instance attached over a WebSocket connection.
+	//   The request must be a WebSocket handshake (RFC 6455).
+	//
+	//   The output of the command is sent as binary messages holding the raw stream, without the
+	//   headers used by the start endpoint to multiplex stdout and stderr. Text and binary messages
+	//   sent by the client are written to the standard input of the command.
+	//   The connection is closed when the command exits.
+	// parameters:
+	//  - in: path
+	//    name: id
+	//    type: string
+	//    required: true
+	//    description: Exec instance ID
+	//  - in: query
+	//    name: h
+	//    type: integer
+	//    description: Height of the TTY session in characters, if the exec instance has a TTY.
+	//  - in: query
+	//    name: w
+	//    type: integer
+	//    description: Width of the TTY session in characters, if the exec instance has a TTY.
+	// responses:
+	//   101:
+	//     description: No error, connection has been upgraded to WebSocket.
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/execSessionNotFound"
+	//   409:
+	//	   description: container is not running.
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/exec/{id}/start/ws"), s.APIHandler(compat.ExecStartWebSocketHandler)).Methods(http.MethodGet)
 	// swagger:operation POST /libpod/exec/{id}/resize libpod ExecResizeLibpod
 	// ---
 	// tags:
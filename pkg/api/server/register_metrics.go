@@ -0,0 +1,40 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.podman.io/podman/v6/pkg/api/handlers/libpod"
+)
+
+func (s *APIServer) registerMetricsHandlers(r *mux.Router) error {
+	// swagger:operation GET /metrics system SystemMetrics
+	// ---
+	//   summary: Prometheus metrics
+	//   description: |
+	//     Return engine, container and pod metrics in the Prometheus text exposition format.
+	//     If the Accept header asks for `application/openmetrics-text`, the OpenMetrics text format is used instead.
+	//
+	//     The metrics include per-container and per-pod CPU, memory, block IO, network and PIDs counters of running
+	//     containers, the health and restart counts of containers, and the number of images, volumes, containers
+	//     and pods, the latter two by state.
+	//     Container labels passed to the service via `--metrics-label` are added as `label_<name>` labels to the
+	//     container metrics.
+	//     The `/metrics` endpoint is not versioned.
+	//   tags:
+	//   - system
+	//   produces:
+	//   - text/plain
+	//   - application/openmetrics-text
+	//   responses:
+	//     200:
+	//       description: Metrics in the Prometheus or OpenMetrics text format
+	//       schema:
+	//         type: string
+	//     500:
+	//       $ref: "#/responses/internalError"
+	r.Handle("/metrics", s.APIHandler(libpod.Metrics)).Methods(http.MethodGet)
+	return nil
+}
@@ -3,13 +3,118 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/gorilla/mux"
 	"go.podman.io/podman/v6/pkg/api/handlers/compat"
+	"go.podman.io/podman/v6/pkg/api/handlers/libpod"
 )
 
 func (s *APIServer) registerPluginsHandlers(r *mux.Router) error {
-	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.UnsupportedHandler))
+	// swagger:operation GET /plugins compat PluginList
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: List plugins
+	// description: |
+	//   Returns the volume plugins configured in containers.conf.  Installing and
+	//   managing plugins is not supported.
+	// parameters:
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a `map[string][]string`) to process on the plugin list. Available filters:
+	//        - `capability=<capability>` Matches plugins implementing the capability, e.g. `volumedriver`.
+	//        - `enabled=<bool>` Matches plugins that are (not) reachable.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginList"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
+	// Added non version path to URI to support docker non versioned paths
+	r.Handle("/plugins", s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
+	// swagger:operation GET /plugins/{name}/json compat PluginInspect
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Inspect a plugin
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginInspect"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name:.*}/json"), s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
 	// Added non version path to URI to support docker non versioned paths
-	r.Handle("/plugins", s.APIHandler(compat.UnsupportedHandler))
+	r.Handle("/plugins/{name:.*}/json", s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
+	// All other plugin operations manage plugins, which Podman does not support
+	r.PathPrefix(VersionedPath("/plugins")).Handler(s.APIHandler(compat.UnsupportedHandler))
+	r.PathPrefix("/plugins").Handler(s.APIHandler(compat.UnsupportedHandler))
+
+	/*
+		libpod endpoints
+	*/
+
+	// swagger:operation GET /libpod/plugins/json libpod PluginListLibpod
+	// ---
+	// tags:
+	//  - plugins
+	// summary: List volume plugins
+	// description: Returns the volume plugins configured in containers.conf
+	// parameters:
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a `map[string][]string`) to process on the plugin list. Available filters:
+	//        - `name=<name>` Matches the plugin name (accepts regex).
+	//        - `capability=<capability>` Matches plugins implementing the capability, e.g. `VolumeDriver`.
+	//        - `enabled=<bool>` Matches plugins that are (not) reachable.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginListLibpod"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/plugins/json"), s.APIHandler(libpod.ListPlugins)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/plugins/{name}/json libpod PluginInspectLibpod
+	// ---
+	// tags:
+	//  - plugins
+	// summary: Inspect a volume plugin
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginInspectLibpod"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/plugins/{name:.*}/json"), s.APIHandler(libpod.InspectPlugin)).Methods(http.MethodGet)
 	return nil
 }
@@ -50,6 +50,12 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   description: attributes for creating a pod
 	//   schema:
 	//     $ref: "#/definitions/PodSpecGenerator"
+	// - in: header
+	//   name: Idempotency-Key
+	//   type: string
+	//   description: |
+	//     Key identifying the request, up to 255 printable ASCII characters.
+	//     If a pod was created by an earlier request with the same key and body in the last 24 hours, it is returned instead of creating a new one and the response has the Idempotent-Replayed header set.
 	// responses:
 	//   201:
 	//     schema:
@@ -61,6 +67,8 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//     schema:
 	//       type: string
 	//       description: message describing error
+	//   422:
+	//     $ref: "#/responses/idempotencyKeyReused"
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/create"), s.APIHandler(libpod.PodCreate)).Methods(http.MethodPost)
@@ -175,6 +183,56 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/{name}/kill"), s.APIHandler(libpod.PodKill)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/pods/{name}/checkpoint pods PodCheckpointLibpod
+	// ---
+	// summary: Checkpoint a pod
+	// description: Checkpoint all running containers of a pod. The infra container keeps running.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: keep
+	//    type: boolean
+	//    description: keep all temporary checkpoint files
+	//  - in: query
+	//    name: leaveRunning
+	//    type: boolean
+	//    description: leave the containers running after writing the checkpoint to disk
+	//  - in: query
+	//    name: tcpEstablished
+	//    type: boolean
+	//    description: checkpoint containers with established TCP connections
+	//  - in: query
+	//    name: export
+	//    type: boolean
+	//    description: export the pod checkpoint, including the pod configuration, to a single tar archive
+	//  - in: query
+	//    name: ignoreRootFS
+	//    type: boolean
+	//    description: do not include root file-system changes when exporting. can only be used with export
+	//  - in: query
+	//    name: ignoreVolumes
+	//    type: boolean
+	//    description: do not include associated volumes. can only be used with export
+	//  - in: query
+	//    name: fileLocks
+	//    type: boolean
+	//    description: checkpoint containers with filelocks
+	// responses:
+	//   200:
+	//     description: tarball is returned in body if exported
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   409:
+	//     $ref: "#/responses/podCheckpointResponse"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/checkpoint"), s.APIHandler(libpod.PodCheckpoint)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/pods/{name}/pause pods PodPauseLibpod
 	// ---
 	// summary: Pause a pod
@@ -218,6 +276,77 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/{name}/restart"), s.APIHandler(libpod.PodRestart)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/pods/{name}/restore pods PodRestoreLibpod
+	// ---
+	// summary: Restore a pod
+	// description: |
+	//   Restore the checkpointed containers of a pod. With import, the pod, its infra
+	//   container and its containers are recreated from the pod checkpoint archive
+	//   sent in the request body and the name of the pod in the path is ignored.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    description: the name of the pod when restored from a tar. can only be used with import
+	//  - in: query
+	//    name: keep
+	//    type: boolean
+	//    description: keep all temporary checkpoint files
+	//  - in: query
+	//    name: tcpEstablished
+	//    type: boolean
+	//    description: restore containers with established TCP connections
+	//  - in: query
+	//    name: tcpClose
+	//    type: boolean
+	//    description: restore containers but close the TCP connections
+	//  - in: query
+	//    name: import
+	//    type: boolean
+	//    description: import the pod from a pod checkpoint tar
+	//  - in: query
+	//    name: ignoreRootFS
+	//    type: boolean
+	//    description: do not include root file-system changes. can only be used with import
+	//  - in: query
+	//    name: ignoreVolumes
+	//    type: boolean
+	//    description: do not restore associated volumes. can only be used with import
+	//  - in: query
+	//    name: ignoreStaticIP
+	//    type: boolean
+	//    description: ignore IP address if set statically
+	//  - in: query
+	//    name: ignoreStaticMAC
+	//    type: boolean
+	//    description: ignore MAC address if set statically
+	//  - in: query
+	//    name: fileLocks
+	//    type: boolean
+	//    description: restore containers with file locks
+	//  - in: query
+	//    name: publishPorts
+	//    type: array
+	//    items:
+	//      type: string
+	//    description: port mappings of the restored pod, replacing the checkpointed ones. can only be used with import
+	// responses:
+	//   200:
+	//     $ref: "#/responses/podRestoreResponse"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   409:
+	//     $ref: "#/responses/podRestoreResponse"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/restore"), s.APIHandler(libpod.PodRestore)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/pods/{name}/start pods PodStartLibpod
 	// ---
 	// summary: Start a pod
@@ -291,6 +420,131 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/{name}/unpause"), s.APIHandler(libpod.PodUnpause)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/pods/{name}/events pods PodEventsLibpod
+	// ---
+	// summary: Get pod events
+	// description: |
+	//   Returns the events of a pod and of all containers that are or ever were part of it,
+	//   including containers that have been removed since, filtered on query parameters.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: since
+	//    type: string
+	//    description: start streaming events from this time
+	//  - in: query
+	//    name: until
+	//    type: string
+	//    description: stop streaming events later than this
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: JSON encoded map[string][]string of constraints
+	//  - in: query
+	//    name: stream
+	//    type: boolean
+	//    default: true
+	//    description: when false, do not follow events
+	// responses:
+	//   200:
+	//     description: returns a string of json data describing an event
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/events"), s.APIHandler(libpod.PodEvents)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/pods/{name}/wait pods PodWaitLibpod
+	// ---
+	// summary: Wait on a pod
+	// description: |
+	//   Wait until the containers of a pod, except the infra and init containers, meet the given conditions.
+	//   When waiting for the containers to exit, the exit codes of the containers are aggregated following
+	//   the exit-code propagation and, if the exit policy of the pod is "stop", the infra container is waited for, too.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: condition
+	//    type: array
+	//    items:
+	//      type: string
+	//    description: "Conditions to wait for, e.g. exited, running or healthy. Defaults to exited."
+	//  - in: query
+	//    name: interval
+	//    type: string
+	//    default: "250ms"
+	//    description: Time Interval to wait before polling for completion.
+	//  - in: query
+	//    name: any
+	//    type: boolean
+	//    default: false
+	//    description: Return as soon as one container meets a condition instead of waiting for all of them.
+	//  - in: query
+	//    name: exitCodePropagation
+	//    type: string
+	//    description: |
+	//      How the exit codes are aggregated ("none", "any" or "all"). Defaults to the exit-code propagation
+	//      of the pod's service container if it has one, and "any" otherwise.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/podWaitResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/wait"), s.APIHandler(libpod.PodWait)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/pods/{name}/update pods PodUpdateLibpod
+	// ---
+	// summary: Update an existing pod
+	// description: Updates the cgroup resource limits, the restart policy and the labels of an existing pod. The restart policy is also applied to all containers in the pod except init containers.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: restartPolicy
+	//    type: string
+	//    required: false
+	//    description: New restart policy for the pod and its containers.
+	//  - in: query
+	//    name: restartRetries
+	//    type: integer
+	//    required: false
+	//    description: New amount of retries for the restart policy. Only allowed if restartPolicy is set to on-failure
+	//  - in: body
+	//    name: config
+	//    description: attributes for updating the pod
+	//    schema:
+	//      $ref: "#/definitions/PodUpdateEntities"
+	// responses:
+	//   201:
+	//     $ref: "#/responses/podUpdateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/update"), s.APIHandler(libpod.PodUpdate)).Methods(http.MethodPost)
 	// swagger:operation GET /libpod/pods/{name}/top pods PodTopLibpod
 	// ---
 	// summary: List processes
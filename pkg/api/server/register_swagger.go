@@ -7,6 +7,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"go.podman.io/podman/v6/pkg/api/handlers/libpod"
+	"go.podman.io/podman/v6/pkg/api/server/openapi"
+	"go.podman.io/podman/v6/version"
 )
 
 // registerSwaggerHandlers maps the swagger endpoint for the server
@@ -15,3 +17,11 @@ func (s *APIServer) registerSwaggerHandlers(r *mux.Router) error {
 	r.HandleFunc(VersionedPath("/libpod/swagger"), s.APIHandler(libpod.ServeSwagger)).Methods(http.MethodGet)
 	return nil
 }
+
+// registerOpenAPIHandlers maps the endpoint serving the OpenAPI 3 document
+// built from the registered routes
+func (s *APIServer) registerOpenAPIHandlers(r *mux.Router) error {
+	apiVersion := version.APIVersion[version.Libpod][version.CurrentAPI].String()
+	r.HandleFunc(VersionedPath("/libpod/openapi"), s.APIHandler(openapi.Handler(r, apiVersion))).Methods(http.MethodGet)
+	return nil
+}
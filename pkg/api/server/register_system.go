@@ -60,6 +60,57 @@ func (s *APIServer) registerSystemHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/system/check"), s.APIHandler(libpod.SystemCheck)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/system/gc libpod SystemGCLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Remove containers and images according to a policy
+	// description: |
+	//   Remove exited containers past their time to live, the containers left behind by interrupted builds and
+	//   images unused for too long or, while the file system holding the graph root is fuller than allowed, the
+	//   least recently used images. A "gc" system event is written for every removal.
+	// parameters:
+	//   - in: query
+	//     name: dry_run
+	//     type: boolean
+	//     description: Report what would be removed without removing it
+	//   - in: query
+	//     name: container_ttl
+	//     type: string
+	//     description: Remove containers which exited longer ago than this duration (e.g. `72h`)
+	//   - in: query
+	//     name: keep_label
+	//     type: string
+	//     description: Never remove containers with this label
+	//     default: io.podman.gc.keep
+	//   - in: query
+	//     name: image_unused_for
+	//     type: string
+	//     description: Remove images which have not been used by a container for longer than this duration (e.g. `720h`)
+	//   - in: query
+	//     name: protected_images
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: Never remove images with a reference matching one of these patterns (e.g. `registry.example.com/base/*`)
+	//   - in: query
+	//     name: max_disk_usage
+	//     type: integer
+	//     description: Remove the least recently used images while more than this percentage of the file system holding the graph root is used
+	//   - in: query
+	//     name: build_cache
+	//     type: boolean
+	//     description: Remove the containers left behind by interrupted builds
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: '#/responses/systemGCResponse'
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/system/gc"), s.APIHandler(libpod.SystemGC)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/system/prune libpod SystemPruneLibpod
 	// ---
 	// tags:
@@ -106,13 +157,128 @@ func (s *APIServer) registerSystemHandlers(r *mux.Router) error {
 	//   - system
 	// summary: Show disk usage
 	// description: Return information about disk usage for containers, images, and volumes
+	// parameters:
+	//   - in: query
+	//     name: group_by
+	//     type: string
+	//     description: |
+	//       Attribute disk usage to groups of containers, reported in the Groups field.
+	//       Containers are grouped by `pod`, by `project` (compose project, or service container of the pods created from a Kubernetes YAML) or by the value of a label (`label=<key>`).
+	//   - in: query
+	//     name: exclusive
+	//     type: boolean
+	//     default: false
+	//     description: Attribute image layers and volumes used by containers of several groups to none of them, instead of dividing them between the groups
 	// produces:
 	// - application/json
 	// responses:
 	//   200:
 	//     $ref: '#/responses/systemDiskUsage'
+	//   400:
+	//     $ref: "#/responses/badParamError"
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/system/df"), s.APIHandler(libpod.DiskUsage)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/system/monitor libpod SystemMonitorLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Monitor system usage
+	// description: |
+	//   Return a live stream of samples of the resource usage of the host and of the engine: the CPU, memory and process usage of all running containers,
+	//   the number of containers in each state, the number of running conmon processes, the usage of the graph root file system and the usage of the locks.
+	//
+	//   If the Accept header asks for `text/event-stream`, each sample is sent as a Server-Sent Event named `usage`.
+	//   Usage is sampled live, a reconnecting client receives the next samples.
+	// parameters:
+	//  - in: query
+	//    name: stream
+	//    type: boolean
+	//    default: true
+	//    description: Stream the output
+	//  - in: query
+	//    name: interval
+	//    type: integer
+	//    default: 5
+	//    description: Time in seconds between samples
+	// produces:
+	// - application/json
+	// - text/event-stream
+	// responses:
+	//   200:
+	//     $ref: '#/responses/systemMonitor'
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/system/monitor"), s.APIHandler(libpod.SystemMonitor)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/system/backup libpod SystemBackupLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Back up the engine state
+	// description: |
+	//   Return a tar archive with the configuration of all containers, pods, volumes, networks and secrets,
+	//   which can be restored on another host. The values of the secrets are only included with secretData.
+	// parameters:
+	//  - in: query
+	//    name: volumes
+	//    type: boolean
+	//    default: false
+	//    description: Include the contents of local volumes
+	//  - in: query
+	//    name: images
+	//    type: boolean
+	//    default: false
+	//    description: Include all images as an OCI layout
+	//  - in: query
+	//    name: secretData
+	//    type: boolean
+	//    default: false
+	//    description: Include the data of the secrets, otherwise they must be created before restoring
+	// produces:
+	// - application/x-tar
+	// responses:
+	//   200:
+	//     description: no error
+	//     schema:
+	//      type: string
+	//      format: binary
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/system/backup"), s.APIHandler(libpod.SystemBackup)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/system/restore libpod SystemRestoreLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Restore the engine state
+	// description: |
+	//   Recreate the containers, pods, volumes, networks and secrets of a backup archive. Locks are allocated anew,
+	//   and containers and pods whose ID is already in use are given a new one. Containers are restored in the created state.
+	//   Secrets backed up without their data must exist already. If the restore fails, the objects restored so far are removed.
+	// parameters:
+	//  - in: body
+	//    name: inputStream
+	//    description: |
+	//      A backup archive
+	//    schema:
+	//      type: string
+	//      format: binary
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: '#/responses/systemRestore'
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchSecret"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/system/restore"), s.APIHandler(libpod.SystemRestore)).Methods(http.MethodPost)
 	return nil
 }
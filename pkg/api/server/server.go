@@ -27,7 +27,10 @@ import (
 	"go.podman.io/podman/v6/pkg/api/grpcpb"
 	"go.podman.io/podman/v6/pkg/api/handlers"
 	grpchandlers "go.podman.io/podman/v6/pkg/api/handlers/grpc"
+	"go.podman.io/podman/v6/pkg/api/server/audit"
+	"go.podman.io/podman/v6/pkg/api/server/authz"
 	"go.podman.io/podman/v6/pkg/api/server/idle"
+	"go.podman.io/podman/v6/pkg/api/server/ratelimit"
 	"go.podman.io/podman/v6/pkg/api/types"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 	"go.podman.io/podman/v6/pkg/util/tlsutil"
@@ -49,6 +52,7 @@ type APIServer struct {
 	tlsCertFile        string        // TLS serving certificate PEM file
 	tlsKeyFile         string        // TLS serving certificate private key PEM file
 	tlsClientCAFile    string        // TLS client certifiicate CA bundle PEM file
+	auditSink          *audit.Sink   // Audit log of API requests
 }
 
 // Number of seconds to wait for next request, if exceeded shutdown server
@@ -77,6 +81,15 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	router := mux.NewRouter().UseEncodedPath()
 	tracker := idle.NewTracker(opts.Timeout)
 
+	var limiter *ratelimit.Limiter
+	if len(opts.RateLimits) > 0 || len(opts.MaxInFlight) > 0 {
+		limits, err := ratelimit.ParseLimits(opts.RateLimits, opts.MaxInFlight)
+		if err != nil {
+			return nil, err
+		}
+		limiter = ratelimit.NewLimiter(limits, opts.QueueTimeout, tracker)
+	}
+
 	serverProtocols := &http.Protocols{}
 	serverProtocols.SetHTTP1(true)
 	serverProtocols.SetHTTP2(true)
@@ -110,6 +123,9 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		ctx = context.WithValue(ctx, types.CompatDecoderKey, handlers.NewCompatAPIDecoder())
 		ctx = context.WithValue(ctx, types.RuntimeKey, runtime)
 		ctx = context.WithValue(ctx, types.IdleTrackerKey, tracker)
+		ctx = context.WithValue(ctx, types.MetricsLabelsKey, opts.MetricsLabels)
+		ctx = context.WithValue(ctx, types.RateLimiterKey, limiter)
+		ctx = context.WithValue(ctx, types.AllowedOriginKey, opts.CorsHeaders)
 		return ctx
 	}
 
@@ -128,6 +144,34 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	// Capture panics and print stack traces for diagnostics,
 	// additionally process X-Reference-Id Header to support event correlation
 	router.Use(panicHandler(), referenceIDHandler())
+	if opts.AuditLog != "" {
+		policy := new(audit.Policy)
+		if opts.AuditPolicy != "" {
+			var err error
+			if policy, err = audit.LoadPolicy(opts.AuditPolicy); err != nil {
+				return nil, err
+			}
+		}
+		sink, err := audit.NewSink(opts.AuditLog, opts.AuditLogMaxSize, opts.AuditLogMaxFile)
+		if err != nil {
+			return nil, err
+		}
+		logrus.Infof("API service recording audit log to %s", opts.AuditLog)
+		server.auditSink = sink
+		router.Use(auditHandler(policy, sink))
+	}
+	if opts.AuthzPolicy != "" {
+		policy, err := authz.Load(opts.AuthzPolicy)
+		if err != nil {
+			return nil, err
+		}
+		logrus.Infof("API service enforcing authorization policy %s", opts.AuthzPolicy)
+		router.Use(authorizationHandler(runtime, policy))
+	}
+	if limiter != nil {
+		logrus.Info("API service limiting requests of each client")
+		router.Use(rateLimitHandler(limiter))
+	}
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -144,36 +188,8 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		},
 	)
 
-	for _, fn := range []func(*mux.Router) error{
-		server.registerAuthHandlers,
-		server.registerArtifactHandlers,
-		server.registerArchiveHandlers,
-		server.registerContainersHandlers,
-		server.registerDistributionHandlers,
-		server.registerEventsHandlers,
-		server.registerExecHandlers,
-		server.registerGenerateHandlers,
-		server.registerHealthCheckHandlers,
-		server.registerImagesHandlers,
-		server.registerInfoHandlers,
-		server.registerManifestHandlers,
-		server.registerMonitorHandlers,
-		server.registerNetworkHandlers,
-		server.registerPingHandlers,
-		server.registerKubeHandlers,
-		server.registerPluginsHandlers,
-		server.registerPodsHandlers,
-		server.registerQuadletHandlers,
-		server.registerSecretHandlers,
-		server.registerSwaggerHandlers,
-		server.registerSwarmHandlers,
-		server.registerSystemHandlers,
-		server.registerVersionHandlers,
-		server.registerVolumeHandlers,
-	} {
-		if err := fn(router); err != nil {
-			return nil, err
-		}
+	if err := server.registerHandlers(router); err != nil {
+		return nil, err
 	}
 
 	grpcpb.RegisterNoopServer(server.grpc, grpchandlers.NewNoopServer(runtime)) // TODO: make this table-driven instead of a one-off?
@@ -198,6 +214,44 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	return &server, nil
 }
 
+// registerHandlers maps all endpoints of the API to their handlers
+func (s *APIServer) registerHandlers(router *mux.Router) error {
+	for _, fn := range []func(*mux.Router) error{
+		s.registerAuthHandlers,
+		s.registerArtifactHandlers,
+		s.registerArchiveHandlers,
+		s.registerContainersHandlers,
+		s.registerDistributionHandlers,
+		s.registerEventsHandlers,
+		s.registerExecHandlers,
+		s.registerGenerateHandlers,
+		s.registerHealthCheckHandlers,
+		s.registerImagesHandlers,
+		s.registerInfoHandlers,
+		s.registerManifestHandlers,
+		s.registerMetricsHandlers,
+		s.registerMonitorHandlers,
+		s.registerNetworkHandlers,
+		s.registerPingHandlers,
+		s.registerKubeHandlers,
+		s.registerPluginsHandlers,
+		s.registerPodsHandlers,
+		s.registerQuadletHandlers,
+		s.registerSecretHandlers,
+		s.registerSwaggerHandlers,
+		s.registerOpenAPIHandlers,
+		s.registerSwarmHandlers,
+		s.registerSystemHandlers,
+		s.registerVersionHandlers,
+		s.registerVolumeHandlers,
+	} {
+		if err := fn(router); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // setupSystemd notifies systemd API service is ready
 // If the NOTIFY_SOCKET is set, communicate the PID and readiness, and unset INVOCATION_ID
 // so conmon and containers are in the correct cgroup.  Also unset NOTIFY_SOCKET
@@ -335,6 +389,12 @@ func (s *APIServer) Shutdown(halt bool) error {
 			}
 		}()
 		<-ctx.Done()
+
+		if s.auditSink != nil {
+			if err := s.auditSink.Close(); err != nil {
+				logrus.Errorf("Failed to close audit log: %v", err)
+			}
+		}
 	})
 	return nil
 }
@@ -0,0 +1,80 @@
+//go:build !remote
+
+package websocket
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+)
+
+// ResponseWriter returns a ResponseWriter for handlers that hijack the
+// connection to stream, such as attach and exec.  Hijacking it returns the
+// WebSocket connection so the stream is carried in WebSocket frames.
+// If demux is set, the stream written by the handler is multiplexed with
+// 8 byte headers, which are removed as WebSocket clients expect the raw
+// output.
+func (c *Conn) ResponseWriter(demux bool) http.ResponseWriter {
+	var w io.Writer = c
+	if demux {
+		w = &demuxWriter{w: c}
+	}
+	return &hijackResponseWriter{conn: c, w: w, header: make(http.Header)}
+}
+
+type hijackResponseWriter struct {
+	conn   *Conn
+	w      io.Writer
+	header http.Header
+}
+
+func (h *hijackResponseWriter) Header() http.Header {
+	return h.header
+}
+
+func (h *hijackResponseWriter) Write(b []byte) (int, error) {
+	return h.w.Write(b)
+}
+
+// WriteHeader is a no-op, the status was sent with the handshake
+func (h *hijackResponseWriter) WriteHeader(int) {}
+
+func (h *hijackResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return h.conn, bufio.NewReadWriter(bufio.NewReader(h.conn), bufio.NewWriter(h.w)), nil
+}
+
+// demuxWriter strips the 8 byte stream headers from a multiplexed stream
+type demuxWriter struct {
+	w io.Writer
+	// header holds a partially written header
+	header [8]byte
+	hlen   int
+	// remaining is the payload length left in the current frame
+	remaining uint32
+}
+
+func (d *demuxWriter) Write(b []byte) (int, error) {
+	written := len(b)
+	for len(b) > 0 {
+		if d.remaining == 0 {
+			n := copy(d.header[d.hlen:], b)
+			d.hlen += n
+			b = b[n:]
+			if d.hlen < len(d.header) {
+				break
+			}
+			d.hlen = 0
+			d.remaining = binary.BigEndian.Uint32(d.header[4:])
+			continue
+		}
+		n := min(uint32(len(b)), d.remaining)
+		if _, err := d.w.Write(b[:n]); err != nil {
+			return 0, err
+		}
+		d.remaining -= n
+		b = b[n:]
+	}
+	return written, nil
+}
@@ -0,0 +1,356 @@
+//go:build !remote
+
+// Package websocket implements the server side of the WebSocket protocol
+// (RFC 6455) as needed to carry attach and exec streams.  Messages are not
+// exposed, a connection is a byte stream: writes are sent as binary frames
+// and reads return the payload of text and binary frames in order.
+package websocket
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // mandated by RFC 6455 for the handshake
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// acceptGUID is appended to the client key to compute the accept key
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxControlPayload is the largest payload of a control frame
+const maxControlPayload = 125
+
+// MaxFramePayload is the largest payload of a data frame accepted from a
+// peer.  Attach and exec input is small, larger frames are rejected.
+const MaxFramePayload = 1 << 20
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// Close status codes
+const (
+	CloseNormal         = 1000
+	CloseProtocolError  = 1002
+	CloseMessageTooBig  = 1009
+	CloseInternalError  = 1011
+	closeNoStatusRecved = 1005
+)
+
+// ErrNotWebSocket is returned by Upgrade if the request is not a valid
+// WebSocket handshake
+var ErrNotWebSocket = errors.New("not a websocket handshake")
+
+// ErrOriginNotAllowed is returned by Upgrade if the handshake comes from a
+// web page of another origin
+var ErrOriginNotAllowed = errors.New("websocket origin not allowed")
+
+// IsUpgrade returns true if the request asks for an upgrade to the WebSocket
+// protocol
+func IsUpgrade(r *http.Request) bool {
+	return headerContains(r.Header, "Connection", "upgrade") &&
+		headerContains(r.Header, "Upgrade", "websocket")
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, value := range h.Values(name) {
+		for v := range strings.SplitSeq(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AcceptKey computes the Sec-WebSocket-Accept value for the client key
+func AcceptKey(key string) string {
+	h := sha1.New() //nolint:gosec // mandated by RFC 6455
+	h.Write([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// CheckOrigin returns ErrOriginNotAllowed if the request was made by a web
+// page whose origin differs from the host of the request and is not
+// allowedOrigin, which may be "*" to allow every origin.  Browsers send the
+// Origin header with every WebSocket handshake, other clients usually don't.
+func CheckOrigin(r *http.Request, allowedOrigin string) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" || allowedOrigin == "*" || strings.EqualFold(origin, allowedOrigin) {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err == nil && u.Host != "" && strings.EqualFold(u.Host, r.Host) {
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrOriginNotAllowed, origin)
+}
+
+// Upgrade completes the WebSocket handshake and hijacks the connection.  If
+// the request is not a valid handshake, ErrNotWebSocket is returned, and if
+// it comes from a web page of another origin than allowedOrigin (see
+// CheckOrigin), ErrOriginNotAllowed; in both cases nothing has been written
+// to w so the caller can report the error.
+func Upgrade(w http.ResponseWriter, r *http.Request, allowedOrigin string) (*Conn, error) {
+	if r.Method != http.MethodGet || !IsUpgrade(r) {
+		return nil, fmt.Errorf("%w: GET with Connection: Upgrade and Upgrade: websocket required", ErrNotWebSocket)
+	}
+	if err := CheckOrigin(r, allowedOrigin); err != nil {
+		return nil, err
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrNotWebSocket, r.Header.Get("Sec-WebSocket-Version"))
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		return nil, fmt.Errorf("%w: invalid Sec-WebSocket-Key", ErrNotWebSocket)
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("unable to hijack connection")
+	}
+	netConn, buf, err := hijacker.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("hijacking connection: %w", err)
+	}
+
+	fmt.Fprintf(buf, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", AcceptKey(key))
+	if err := buf.Flush(); err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("writing websocket handshake: %w", err)
+	}
+	return &Conn{Conn: netConn, br: buf.Reader}, nil
+}
+
+// Conn is a WebSocket connection
+type Conn struct {
+	net.Conn
+	br *bufio.Reader
+
+	writeLock sync.Mutex
+	closeSent bool
+
+	// remaining is the unread payload of the current data frame
+	remaining int64
+	// fragmented is set while the frames of a fragmented message are read
+	fragmented bool
+	mask       [4]byte
+	maskPos    int
+	readErr    error
+}
+
+// Read reads the payload of the data frames sent by the peer.  Control
+// frames are handled transparently, a close frame ends the stream with
+// io.EOF.
+func (c *Conn) Read(b []byte) (int, error) {
+	for c.remaining == 0 {
+		if c.readErr != nil {
+			return 0, c.readErr
+		}
+		if err := c.nextFrame(); err != nil {
+			c.readErr = err
+			return 0, err
+		}
+	}
+	if int64(len(b)) > c.remaining {
+		b = b[:c.remaining]
+	}
+	n, err := c.br.Read(b)
+	c.unmask(b[:n])
+	c.remaining -= int64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (c *Conn) unmask(b []byte) {
+	for i := range b {
+		b[i] ^= c.mask[c.maskPos&3]
+		c.maskPos++
+	}
+}
+
+// nextFrame reads the header of the next frame.  Control frames are
+// consumed and answered, the payload of data frames is left to Read.
+func (c *Conn) nextFrame() error {
+	var header [2]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		return err
+	}
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	length := int64(header[1] & 0x7f)
+
+	if header[0]&0x70 != 0 {
+		return c.fail("reserved bits set")
+	}
+	if !masked {
+		return c.fail("client frames must be masked")
+	}
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return err
+		}
+		length = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return err
+		}
+		length = int64(binary.BigEndian.Uint64(ext[:]))
+		if length < 0 {
+			return c.fail("invalid payload length")
+		}
+	}
+	if length > MaxFramePayload {
+		return c.failWithStatus(CloseMessageTooBig, fmt.Sprintf("frame payload of %d bytes exceeds %d bytes", length, MaxFramePayload))
+	}
+
+	c.maskPos = 0
+	if _, err := io.ReadFull(c.br, c.mask[:]); err != nil {
+		return err
+	}
+
+	switch opcode {
+	case opContinuation, opText, opBinary:
+		// A fragmented message is a text or binary frame without
+		// FIN followed by continuation frames, the last one with FIN.
+		// Control frames may be interleaved, other messages may not.
+		if opcode == opContinuation {
+			if !c.fragmented {
+				return c.fail("continuation frame without a fragmented message")
+			}
+		} else if c.fragmented {
+			return c.fail("new message before the end of a fragmented message")
+		}
+		c.fragmented = !fin
+		c.remaining = length
+		return nil
+	case opClose, opPing, opPong:
+		if !fin || length > maxControlPayload {
+			return c.fail("invalid control frame")
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.br, payload); err != nil {
+			return err
+		}
+		c.unmask(payload)
+		switch opcode {
+		case opPing:
+			return c.writeFrame(opPong, payload)
+		case opClose:
+			code := closeNoStatusRecved
+			if len(payload) >= 2 {
+				code = int(binary.BigEndian.Uint16(payload))
+			}
+			if err := c.writeClose(code, ""); err != nil && !errors.Is(err, net.ErrClosed) {
+				return err
+			}
+			return io.EOF
+		}
+		return nil
+	default:
+		return c.fail(fmt.Sprintf("unknown opcode %d", opcode))
+	}
+}
+
+func (c *Conn) fail(reason string) error {
+	return c.failWithStatus(CloseProtocolError, reason)
+}
+
+func (c *Conn) failWithStatus(code int, reason string) error {
+	_ = c.writeClose(code, reason)
+	return fmt.Errorf("websocket protocol error: %s", reason)
+}
+
+// Write sends b as a binary frame
+func (c *Conn) Write(b []byte) (int, error) {
+	if err := c.writeFrame(opBinary, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if c.closeSent {
+		return net.ErrClosed
+	}
+	return c.writeFrameLocked(opcode, payload)
+}
+
+func (c *Conn) writeFrameLocked(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|opcode)
+
+	// Server frames are never masked
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+	frame = append(frame, payload...)
+	_, err := c.Conn.Write(frame)
+	return err
+}
+
+func (c *Conn) writeClose(code int, reason string) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if c.closeSent {
+		return nil
+	}
+	c.closeSent = true
+
+	var payload []byte
+	if code != closeNoStatusRecved {
+		payload = binary.BigEndian.AppendUint16(nil, uint16(code))
+		if len(reason) > maxControlPayload-2 {
+			reason = reason[:maxControlPayload-2]
+		}
+		payload = append(payload, reason...)
+	}
+	_ = c.Conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	return c.writeFrameLocked(opClose, payload)
+}
+
+// CloseWithStatus sends a close frame with the given status code and
+// reason, then closes the connection
+func (c *Conn) CloseWithStatus(code int, reason string) error {
+	if err := c.writeClose(code, reason); err != nil && !errors.Is(err, net.ErrClosed) {
+		c.Conn.Close()
+		return err
+	}
+	return c.Conn.Close()
+}
+
+// Close sends a normal close frame and closes the connection
+func (c *Conn) Close() error {
+	return c.CloseWithStatus(CloseNormal, "")
+}
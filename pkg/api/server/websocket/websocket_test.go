@@ -0,0 +1,288 @@
+//go:build !remote
+
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clientFrame encodes a masked client frame
+func clientFrame(opcode byte, payload []byte) []byte {
+	return clientFragment(true, opcode, payload)
+}
+
+// clientFragment encodes a masked client frame, with FIN set if fin is true
+func clientFragment(fin bool, opcode byte, payload []byte) []byte {
+	mask := [4]byte{1, 2, 3, 4}
+	frame := []byte{opcode}
+	if fin {
+		frame[0] |= 0x80
+	}
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, 0x80|byte(len(payload)))
+	default:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i&3])
+	}
+	return frame
+}
+
+// readServerFrame decodes an unmasked server frame
+func readServerFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	var header [2]byte
+	_, err := io.ReadFull(r, header[:])
+	require.NoError(t, err)
+	require.Zero(t, header[1]&0x80, "server frames must not be masked")
+	length := int(header[1] & 0x7f)
+	if length == 126 {
+		var ext [2]byte
+		_, err := io.ReadFull(r, ext[:])
+		require.NoError(t, err)
+		length = int(binary.BigEndian.Uint16(ext[:]))
+	}
+	payload := make([]byte, length)
+	_, err = io.ReadFull(r, payload)
+	require.NoError(t, err)
+	return header[0] & 0x0f, payload
+}
+
+func TestAcceptKey(t *testing.T) {
+	// Example from RFC 6455 section 1.3
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", AcceptKey("dGhlIHNhbXBsZSBub25jZQ=="))
+}
+
+func TestUpgradeRejectsPlainRequests(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/containers/abc/attach/ws", nil)
+	_, err := Upgrade(httptest.NewRecorder(), r, "")
+	assert.ErrorIs(t, err, ErrNotWebSocket)
+
+	r.Header.Set("Connection", "keep-alive, Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	r.Header.Set("Sec-WebSocket-Version", "8")
+	w := httptest.NewRecorder()
+	_, err = Upgrade(w, r, "")
+	assert.ErrorIs(t, err, ErrNotWebSocket)
+	assert.Equal(t, "13", w.Header().Get("Sec-WebSocket-Version"))
+}
+
+func TestConn(t *testing.T) {
+	received := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := Upgrade(w, r, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = conn.Write([]byte("hello"))
+		assert.NoError(t, err)
+		// a multiplexed stream loses its headers
+		mw := conn.ResponseWriter(true)
+		_, err = mw.Write([]byte{1, 0, 0, 0, 0, 0, 0, 3, 'o', 'u'})
+		assert.NoError(t, err)
+		_, err = mw.Write([]byte{'t', 2, 0, 0, 0})
+		assert.NoError(t, err)
+		_, err = mw.Write([]byte{0, 0, 0, 3, 'e', 'r', 'r'})
+		assert.NoError(t, err)
+
+		input, err := io.ReadAll(conn)
+		assert.NoError(t, err)
+		received <- input
+		assert.NoError(t, conn.Close())
+	}))
+	defer server.Close()
+
+	c, err := net.Dial("tcp", server.Listener.Addr().String())
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = io.WriteString(c, "GET /ws HTTP/1.1\r\nHost: localhost\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n"+
+		"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n\r\n")
+	require.NoError(t, err)
+
+	br := bufio.NewReader(c)
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", resp.Header.Get("Sec-WebSocket-Accept"))
+
+	for _, want := range []string{"hello", "ou", "t", "err"} {
+		opcode, payload := readServerFrame(t, br)
+		assert.Equal(t, byte(opBinary), opcode)
+		assert.Equal(t, want, string(payload))
+	}
+
+	// pings are answered and do not show up in the stream
+	_, err = c.Write(clientFrame(opText, []byte("ls ")))
+	require.NoError(t, err)
+	_, err = c.Write(clientFrame(opPing, []byte("ping")))
+	require.NoError(t, err)
+	opcode, payload := readServerFrame(t, br)
+	assert.Equal(t, byte(opPong), opcode)
+	assert.Equal(t, "ping", string(payload))
+
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = 'a'
+	}
+	_, err = c.Write(clientFrame(opBinary, long))
+	require.NoError(t, err)
+	_, err = c.Write(clientFrame(opClose, binary.BigEndian.AppendUint16(nil, CloseNormal)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "ls "+string(long), string(<-received))
+	opcode, payload = readServerFrame(t, br)
+	assert.Equal(t, byte(opClose), opcode)
+	assert.Equal(t, uint16(CloseNormal), binary.BigEndian.Uint16(payload))
+}
+
+func TestConnRejectsUnmaskedFrames(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	conn := &Conn{Conn: server, br: bufio.NewReader(server)}
+
+	go func() {
+		_, _ = client.Write([]byte{0x80 | opBinary, 1, 'x'})
+	}()
+	// net.Pipe is synchronous, the close frame must be read concurrently
+	closeFrame := make(chan []byte, 1)
+	go func() {
+		frame, _ := io.ReadAll(client)
+		closeFrame <- frame
+	}()
+
+	_, err := conn.Read(make([]byte, 1))
+	assert.ErrorContains(t, err, "client frames must be masked")
+	require.NoError(t, server.Close())
+	opcode, payload := readServerFrame(t, bytes.NewReader(<-closeFrame))
+	assert.Equal(t, byte(opClose), opcode)
+	assert.Equal(t, uint16(CloseProtocolError), binary.BigEndian.Uint16(payload))
+}
+
+func TestCheckOrigin(t *testing.T) {
+	for _, tc := range []struct {
+		origin  string
+		allowed string
+		ok      bool
+	}{
+		{"", "", true},
+		{"http://localhost:8080", "", true},
+		{"http://LOCALHOST:8080", "", true},
+		{"https://evil.example", "", false},
+		{"http://localhost", "", false},
+		{"null", "", false},
+		{"https://console.example", "https://console.example", true},
+		{"https://evil.example", "https://console.example", false},
+		{"https://evil.example", "*", true},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "http://localhost:8080/containers/abc/attach/ws", nil)
+		if tc.origin != "" {
+			r.Header.Set("Origin", tc.origin)
+		}
+		err := CheckOrigin(r, tc.allowed)
+		if tc.ok {
+			assert.NoError(t, err, "origin %q allowed %q", tc.origin, tc.allowed)
+		} else {
+			assert.ErrorIs(t, err, ErrOriginNotAllowed, "origin %q allowed %q", tc.origin, tc.allowed)
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "http://localhost:8080/containers/abc/attach/ws", nil)
+	r.Header.Set("Connection", "Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	r.Header.Set("Sec-WebSocket-Version", "13")
+	r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	r.Header.Set("Origin", "https://evil.example")
+	_, err := Upgrade(httptest.NewRecorder(), r, "")
+	assert.ErrorIs(t, err, ErrOriginNotAllowed)
+}
+
+// readWithFrames feeds the client frames to a connection and returns what
+// Read returns, with the close frame sent by the server if any
+func readWithFrames(t *testing.T, frames ...[]byte) ([]byte, []byte, error) {
+	server, client := net.Pipe()
+	defer client.Close()
+	conn := &Conn{Conn: server, br: bufio.NewReader(server)}
+
+	go func() {
+		for _, frame := range frames {
+			if _, err := client.Write(frame); err != nil {
+				return
+			}
+		}
+		_, _ = client.Write(clientFrame(opClose, binary.BigEndian.AppendUint16(nil, CloseNormal)))
+	}()
+	// net.Pipe is synchronous, the close frame must be read concurrently
+	closeFrame := make(chan []byte, 1)
+	go func() {
+		frame, _ := io.ReadAll(client)
+		closeFrame <- frame
+	}()
+
+	data, err := io.ReadAll(conn)
+	require.NoError(t, server.Close())
+	return data, <-closeFrame, err
+}
+
+func TestConnFragmentedMessages(t *testing.T) {
+	data, _, err := readWithFrames(t,
+		clientFragment(false, opText, []byte("frag")),
+		clientFrame(opPing, nil),
+		clientFragment(false, opContinuation, []byte("men")),
+		clientFragment(true, opContinuation, []byte("ted")),
+		clientFrame(opBinary, []byte(" whole")),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "fragmented whole", string(data))
+
+	for _, tc := range []struct {
+		name   string
+		frames [][]byte
+		err    string
+	}{
+		{
+			name:   "continuation without fragmented message",
+			frames: [][]byte{clientFrame(opContinuation, []byte("x"))},
+			err:    "continuation frame without a fragmented message",
+		},
+		{
+			name:   "continuation after final fragment",
+			frames: [][]byte{clientFragment(false, opText, []byte("x")), clientFrame(opContinuation, []byte("y")), clientFrame(opContinuation, []byte("z"))},
+			err:    "continuation frame without a fragmented message",
+		},
+		{
+			name:   "new message inside fragmented message",
+			frames: [][]byte{clientFragment(false, opText, []byte("x")), clientFrame(opBinary, []byte("y"))},
+			err:    "new message before the end of a fragmented message",
+		},
+	} {
+		_, closeFrame, err := readWithFrames(t, tc.frames...)
+		assert.ErrorContains(t, err, tc.err, tc.name)
+		opcode, payload := readServerFrame(t, bytes.NewReader(closeFrame))
+		assert.Equal(t, byte(opClose), opcode, tc.name)
+		assert.Equal(t, uint16(CloseProtocolError), binary.BigEndian.Uint16(payload), tc.name)
+	}
+}
+
+func TestConnRejectsLargeFrames(t *testing.T) {
+	// Only the header is sent, the payload length is checked first
+	header := []byte{0x80 | opBinary, 0x80 | 127}
+	header = binary.BigEndian.AppendUint64(header, 1<<40)
+	_, closeFrame, err := readWithFrames(t, header)
+	assert.ErrorContains(t, err, "exceeds")
+	opcode, payload := readServerFrame(t, bytes.NewReader(closeFrame))
+	assert.Equal(t, byte(opClose), opcode)
+	assert.Equal(t, uint16(CloseMessageTooBig), binary.BigEndian.Uint16(payload))
+}
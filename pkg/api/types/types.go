@@ -10,4 +10,7 @@ const (
 	IdleTrackerKey
 	ConnKey
 	CompatDecoderKey
+	MetricsLabelsKey
+	RateLimiterKey
+	AllowedOriginKey
 )
@@ -24,9 +24,23 @@ import (
 	"go.podman.io/podman/v6/pkg/util/tlsutil"
 	"go.podman.io/podman/v6/version"
 	"go.podman.io/storage/pkg/fileutils"
+	"go.podman.io/storage/pkg/stringid"
 	"golang.org/x/net/proxy"
 )
 
+// IdempotencyKeyHeader is the header carrying the key that lets the service
+// recognize a retried create request
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentHeaders returns headers holding a new idempotency key.  A create
+// request sent with them is safe to retry: the service returns the object
+// created by the first attempt instead of creating another.
+func IdempotentHeaders() http.Header {
+	headers := http.Header{}
+	headers.Set(IdempotencyKeyHeader, stringid.GenerateRandomID())
+	return headers
+}
+
 type APIResponse struct {
 	*http.Response
 	Request *http.Request
@@ -483,6 +497,12 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 	// Give the Do three chances in the case of a comm/service hiccup.
 	// Don't retry on context or timeout errors — those won't recover.
 	for i := 1; i <= 3; i++ {
+		if i > 1 && req.GetBody != nil {
+			// The failed attempt may have consumed the body
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
 		response, err = c.Client.Do(req) //nolint:bodyclose // The caller has to close the body.
 		if err == nil {
 			break
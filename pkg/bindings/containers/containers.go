@@ -45,6 +45,30 @@ func List(ctx context.Context, options *ListOptions) ([]types.ListContainer, err
 	return containers, response.Process(&containers)
 }
 
+// ListRemoved returns the tombstones of removed containers, most recently
+// removed first.
+func ListRemoved(ctx context.Context, options *ListRemovedOptions) ([]define.ContainerTombstone, error) {
+	if options == nil {
+		options = new(ListRemovedOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tombstones []define.ContainerTombstone
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/removed", params, nil)
+	if err != nil {
+		return tombstones, err
+	}
+	defer response.Body.Close()
+
+	return tombstones, response.Process(&tombstones)
+}
+
 // Prune removes stopped and exited containers from local storage.  The optional filters can be
 // used for more granular selection of containers.  The main error returned indicates if there were runtime
 // errors like finding containers.  Errors specific to the removal of a container are in the PruneContainerResponse
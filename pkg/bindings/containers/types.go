@@ -154,6 +154,13 @@ type ListOptions struct {
 	Sync      *bool
 }
 
+// ListRemovedOptions are optional options for listing removed containers
+//
+//go:generate go run ../generator/generator.go ListRemovedOptions
+type ListRemovedOptions struct {
+	Last *int
+}
+
 // PruneOptions are optional options for pruning containers
 //
 //go:generate go run ../generator/generator.go PruneOptions
@@ -0,0 +1,50 @@
+package plugins
+
+import (
+	"context"
+	"net/http"
+
+	"go.podman.io/podman/v6/pkg/bindings"
+	entitiesTypes "go.podman.io/podman/v6/pkg/domain/entities/types"
+)
+
+// Inspect returns the status of a volume plugin configured on the server.
+func Inspect(ctx context.Context, name string, options *InspectOptions) (*entitiesTypes.PluginReport, error) {
+	var report entitiesTypes.PluginReport
+	if options == nil {
+		options = new(InspectOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/plugins/%s/json", nil, nil, name)
+	if err != nil {
+		return &report, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// List returns the status of the volume plugins configured on the server.
+// Optionally, filters can be used to refine the list of plugins.
+func List(ctx context.Context, options *ListOptions) ([]*entitiesTypes.PluginReport, error) {
+	var reports []*entitiesTypes.PluginReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/plugins/json", params, nil)
+	if err != nil {
+		return reports, err
+	}
+	defer response.Body.Close()
+
+	return reports, response.Process(&reports)
+}
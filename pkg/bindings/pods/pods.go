@@ -2,11 +2,15 @@ package pods
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 
 	jsoniter "github.com/json-iterator/go"
+	"github.com/sirupsen/logrus"
 	"go.podman.io/podman/v6/pkg/api/handlers"
 	"go.podman.io/podman/v6/pkg/bindings"
 	entitiesTypes "go.podman.io/podman/v6/pkg/domain/entities/types"
@@ -27,7 +31,7 @@ func CreatePodFromSpec(ctx context.Context, spec *entitiesTypes.PodSpec) (*entit
 		return nil, err
 	}
 	stringReader := strings.NewReader(specString)
-	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/pods/create", nil, nil)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/pods/create", nil, bindings.IdempotentHeaders())
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +40,56 @@ func CreatePodFromSpec(ctx context.Context, spec *entitiesTypes.PodSpec) (*entit
 	return &pcr, response.Process(&pcr)
 }
 
+// Events allows you to monitor the events of a pod and of all containers
+// that are or ever were part of it. The events are passed to the eventChan
+// provided. The optional cancelChan can be used to cancel the read of events
+// and close down the HTTP connection.
+func Events(ctx context.Context, nameOrID string, eventChan chan entitiesTypes.Event, cancelChan chan bool, options *EventsOptions) error {
+	if options == nil {
+		options = new(EventsOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/pods/%s/events", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if cancelChan != nil {
+		go func() {
+			<-cancelChan
+			if err := response.Body.Close(); err != nil {
+				logrus.Errorf("Unable to close event response body: %v", err)
+			}
+		}()
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		return response.Process(nil)
+	}
+
+	go func() {
+		defer response.Body.Close()
+		defer close(eventChan)
+		dec := jsoniter.NewDecoder(response.Body)
+		for err = (error)(nil); err == nil; {
+			e := entitiesTypes.Event{}
+			err = dec.Decode(&e)
+			if err == nil {
+				eventChan <- e
+			}
+		}
+	}()
+	return nil
+}
+
 // Exists is a lightweight method to determine if a pod exists in local storage
 func Exists(ctx context.Context, nameOrID string, _ *ExistsOptions) (bool, error) {
 	conn, err := bindings.GetClient(ctx)
@@ -115,6 +169,118 @@ func Pause(ctx context.Context, nameOrID string, options *PauseOptions) (*entiti
 	return &report, response.ProcessWithError(&report, &errorhandling.PodConflictErrorModel{})
 }
 
+// Checkpoint checkpoints all running containers of a pod. If options.Export
+// is set, the pod checkpoint archive is written to that path on the client.
+func Checkpoint(ctx context.Context, nameOrID string, options *CheckpointOptions) (*entitiesTypes.PodCheckpointReport, error) {
+	var report entitiesTypes.PodCheckpointReport
+	if options == nil {
+		options = new(CheckpointOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	// "export" is a bool for the server, the path is only used locally.
+	export := false
+	if options.GetExport() != "" {
+		export = true
+		params.Set("export", "true")
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/pods/%s/checkpoint", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || !export {
+		return &report, response.ProcessWithError(&report, &errorhandling.PodConflictErrorModel{})
+	}
+
+	f, err := os.OpenFile(*options.Export, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, response.Body); err != nil {
+		return nil, err
+	}
+
+	return &entitiesTypes.PodCheckpointReport{}, nil
+}
+
+// Restore restores the checkpointed containers of a pod. If
+// options.ImportArchive is set, the pod is recreated from the given pod
+// checkpoint archive and nameOrID is ignored.
+func Restore(ctx context.Context, nameOrID string, options *RestoreOptions) (*entitiesTypes.PodRestoreReport, error) {
+	var report entitiesTypes.PodRestoreReport
+	if options == nil {
+		options = new(RestoreOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range options.PublishPorts {
+		params.Add("publishPorts", p)
+	}
+
+	// Open the to-be-imported archive if needed.
+	var r io.Reader
+	if i := options.GetImportArchive(); i != "" {
+		params.Set("import", "true")
+		f, err := os.Open(i)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+		// Hard-code the name since it will be ignored in any case.
+		nameOrID = "import"
+	}
+
+	response, err := conn.DoRequest(ctx, r, http.MethodPost, "/pods/%s/restore", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.ProcessWithError(&report, &errorhandling.PodConflictErrorModel{})
+}
+
+// Wait blocks until the containers of a pod meet the given conditions and
+// returns their aggregated exit code.
+func Wait(ctx context.Context, nameOrID string, options *WaitOptions) (*entitiesTypes.PodWaitReport, error) {
+	var report entitiesTypes.PodWaitReport
+	if options == nil {
+		options = new(WaitOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/pods/%s/wait", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
 // Prune by default removes all non-running pods in local storage.
 // And with force set true removes all pods.
 func Prune(ctx context.Context, options *PruneOptions) ([]*entitiesTypes.PodPruneReport, error) {
@@ -340,3 +506,43 @@ func Stats(ctx context.Context, namesOrIDs []string, options *StatsOptions) ([]*
 
 	return reports, response.Process(&reports)
 }
+
+// Update updates the cgroup configuration, restart policy and labels of the given pod.
+func Update(ctx context.Context, options *entitiesTypes.PodUpdateOptions) (string, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	if options.RestartPolicy != nil {
+		params.Set("restartPolicy", *options.RestartPolicy)
+		if options.RestartRetries != nil {
+			params.Set("restartRetries", strconv.Itoa(int(*options.RestartRetries)))
+		}
+	}
+
+	updateEntities := &handlers.PodUpdateEntities{
+		Labels:      options.Labels,
+		UnsetLabels: options.UnsetLabels,
+	}
+	if options.Resources != nil {
+		updateEntities.LinuxResources = *options.Resources
+	}
+	if options.DevicesLimits != nil {
+		updateEntities.UpdateContainerDevicesLimits = *options.DevicesLimits
+	}
+
+	requestData, err := jsoniter.MarshalToString(updateEntities)
+	if err != nil {
+		return "", err
+	}
+	stringReader := strings.NewReader(requestData)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/pods/%s/update", params, nil, options.NameOrID)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	return options.NameOrID, response.Process(nil)
+}
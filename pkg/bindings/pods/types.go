@@ -82,3 +82,59 @@ type RemoveOptions struct {
 //
 //go:generate go run ../generator/generator.go ExistsOptions
 type ExistsOptions struct{}
+
+// CheckpointOptions are optional options for checkpointing pods
+//
+//go:generate go run ../generator/generator.go CheckpointOptions
+type CheckpointOptions struct {
+	// Export is the path the pod checkpoint archive is written to.
+	Export         *string `schema:"-"`
+	IgnoreRootfs   *bool
+	IgnoreVolumes  *bool
+	Keep           *bool
+	LeaveRunning   *bool
+	TCPEstablished *bool
+	FileLocks      *bool
+}
+
+// RestoreOptions are optional options for restoring pods
+//
+//go:generate go run ../generator/generator.go RestoreOptions
+type RestoreOptions struct {
+	IgnoreRootfs    *bool
+	IgnoreVolumes   *bool
+	IgnoreStaticIP  *bool
+	IgnoreStaticMAC *bool
+	// ImportArchive is the path to an archive which contains the pod checkpoint data.
+	ImportArchive  *string `schema:"-"`
+	Keep           *bool
+	Name           *string
+	TCPEstablished *bool
+	TCPClose       *bool
+	PublishPorts   []string `schema:"-"`
+	FileLocks      *bool
+}
+
+// WaitOptions are optional options for waiting on the containers of a pod
+//
+//go:generate go run ../generator/generator.go WaitOptions
+type WaitOptions struct {
+	// Conditions to wait on, e.g. "exited", "running" or "healthy".
+	Conditions []string `schema:"condition"`
+	// Time interval to wait before polling for completion.
+	Interval *string
+	// Return as soon as one container meets a condition.
+	Any *bool
+	// How the exit codes of the containers are aggregated.
+	ExitCodePropagation *string
+}
+
+// EventsOptions are optional options for monitoring the events of a pod
+//
+//go:generate go run ../generator/generator.go EventsOptions
+type EventsOptions struct {
+	Filters map[string][]string
+	Since   *string
+	Stream  *bool
+	Until   *string
+}
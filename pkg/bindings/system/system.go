@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -99,6 +100,71 @@ func Check(ctx context.Context, options *CheckOptions) (*types.SystemCheckReport
 	return &report, response.Process(&report)
 }
 
+// GC removes containers and images according to the policy in options.
+func GC(ctx context.Context, options *GCOptions) (*types.SystemGCReport, error) {
+	var report types.SystemGCReport
+
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/system/gc", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
+// Backup writes a backup archive of the engine state to the writer.
+func Backup(ctx context.Context, backupTo io.Writer, options *BackupOptions) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/backup", params, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.IsSuccess() || response.IsRedirection() {
+		if _, err := io.Copy(backupTo, response.Body); err != nil {
+			return fmt.Errorf("writing backup archive: %w", err)
+		}
+	}
+	return response.Process(nil)
+}
+
+// Restore recreates the objects of a backup archive of the engine state.
+func Restore(ctx context.Context, restoreFrom io.Reader, options *RestoreOptions) (*types.SystemRestoreReport, error) {
+	var report types.SystemRestoreReport
+	if options == nil {
+		options = new(RestoreOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, restoreFrom, http.MethodPost, "/system/restore", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
 func Version(ctx context.Context, options *VersionOptions) (*types.SystemVersionReport, error) {
 	var (
 		component types.SystemComponentVersion
@@ -155,12 +221,15 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 	if options == nil {
 		options = new(DiskOptions)
 	}
-	_ = options
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", nil, nil)
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -168,3 +237,57 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 
 	return &report, response.Process(&report)
 }
+
+// Monitor samples the resource usage of the host and of the engine.  When
+// streaming, a sample is sent every interval until the context is cancelled.
+func Monitor(ctx context.Context, options *MonitorOptions) (chan types.SystemMonitorReport, error) {
+	if options == nil {
+		options = new(MonitorOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/monitor", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !response.IsSuccess() {
+		defer response.Body.Close()
+		return nil, response.Process(nil)
+	}
+
+	reportChan := make(chan types.SystemMonitorReport)
+
+	go func() {
+		defer close(reportChan)
+		defer response.Body.Close()
+
+		dec := json.NewDecoder(response.Body)
+		doStream := true
+		if options.Changed("Stream") {
+			doStream = options.GetStream()
+		}
+
+		for {
+			var report types.SystemMonitorReport
+			if err := dec.Decode(&report); err != nil {
+				report = types.SystemMonitorReport{Error: err}
+			}
+			select {
+			case reportChan <- report:
+			case <-ctx.Done():
+				return
+			}
+			if report.Error != nil || !doStream {
+				return
+			}
+		}
+	}()
+
+	return reportChan, nil
+}
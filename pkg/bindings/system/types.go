@@ -29,7 +29,10 @@ type VersionOptions struct{}
 // DiskOptions are optional options for getting storage consumption
 //
 //go:generate go run ../generator/generator.go DiskOptions
-type DiskOptions struct{}
+type DiskOptions struct {
+	GroupBy   *string `schema:"group_by"`
+	Exclusive *bool   `schema:"exclusive"`
+}
 
 // InfoOptions are optional options for getting info
 // about libpod
@@ -46,3 +49,40 @@ type CheckOptions struct {
 	RepairLossy                 *bool   `schema:"repair_lossy"`
 	UnreferencedLayerMaximumAge *string `schema:"unreferenced_layer_max_age"`
 }
+
+// GCOptions are optional options for removing containers and images
+// according to a policy
+//
+//go:generate go run ../generator/generator.go GCOptions
+type GCOptions struct {
+	DryRun          *bool     `schema:"dry_run"`
+	ContainerTTL    *string   `schema:"container_ttl"`
+	KeepLabel       *string   `schema:"keep_label"`
+	ImageUnusedFor  *string   `schema:"image_unused_for"`
+	ProtectedImages *[]string `schema:"protected_images"`
+	MaxDiskUsage    *int      `schema:"max_disk_usage"`
+	BuildCache      *bool     `schema:"build_cache"`
+}
+
+// MonitorOptions are optional options for monitoring the system usage
+//
+//go:generate go run ../generator/generator.go MonitorOptions
+type MonitorOptions struct {
+	Interval *int  `schema:"interval"`
+	Stream   *bool `schema:"stream"`
+}
+
+// BackupOptions are optional options for backing up the engine state
+//
+//go:generate go run ../generator/generator.go BackupOptions
+type BackupOptions struct {
+	Volumes    *bool `schema:"volumes"`
+	Images     *bool `schema:"images"`
+	SecretData *bool `schema:"secretData"`
+}
+
+// RestoreOptions are optional options for restoring a backup of the engine
+// state
+//
+//go:generate go run ../generator/generator.go RestoreOptions
+type RestoreOptions struct{}
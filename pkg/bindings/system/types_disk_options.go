@@ -16,3 +16,33 @@ func (o *DiskOptions) Changed(fieldName string) bool {
 func (o *DiskOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
+
+// WithGroupBy set field GroupBy to given value
+func (o *DiskOptions) WithGroupBy(value string) *DiskOptions {
+	o.GroupBy = &value
+	return o
+}
+
+// GetGroupBy returns value of field GroupBy
+func (o *DiskOptions) GetGroupBy() string {
+	if o.GroupBy == nil {
+		var z string
+		return z
+	}
+	return *o.GroupBy
+}
+
+// WithExclusive set field Exclusive to given value
+func (o *DiskOptions) WithExclusive(value bool) *DiskOptions {
+	o.Exclusive = &value
+	return o
+}
+
+// GetExclusive returns value of field Exclusive
+func (o *DiskOptions) GetExclusive() bool {
+	if o.Exclusive == nil {
+		var z bool
+		return z
+	}
+	return *o.Exclusive
+}
@@ -0,0 +1,237 @@
+//go:build !remote && (linux || freebsd)
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	metadata "github.com/checkpoint-restore/checkpointctl/lib"
+	"github.com/sirupsen/logrus"
+	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/specgen"
+	"go.podman.io/podman/v6/pkg/specgen/generate"
+	"go.podman.io/podman/v6/pkg/specgenutil"
+	"go.podman.io/storage/pkg/archive"
+	"go.podman.io/storage/pkg/chrootarchive"
+)
+
+// podCheckpointConfigFile is the name of the file in a pod checkpoint archive
+// holding the configuration needed to recreate the pod.
+const podCheckpointConfigFile = "pod.dump"
+
+// podCheckpointConfig describes the content of a pod checkpoint archive. Next
+// to this configuration the archive contains one container checkpoint archive
+// named <container ID>.tar for every checkpointed member of the pod.
+type podCheckpointConfig struct {
+	ID         string                    `json:"id"`
+	Name       string                    `json:"name"`
+	Spec       *specgen.PodSpecGenerator `json:"spec"`
+	Containers []podCheckpointContainer  `json:"containers"`
+}
+
+type podCheckpointContainer struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CRExportPodCheckpoint checkpoints all running containers of the pod and
+// writes their checkpoints, together with the configuration of the pod and
+// its infra container, into the single archive target.
+func CRExportPodCheckpoint(ctx context.Context, runtime *libpod.Runtime, pod *libpod.Pod, options libpod.ContainerCheckpointOptions, target string) (map[string]error, error) {
+	if !pod.HasInfraContainer() {
+		return nil, fmt.Errorf("cannot export checkpoint of pod %s without infra container: %w", pod.ID(), define.ErrNoSuchCtr)
+	}
+
+	// The pod configuration has to be retrieved before the checkpoint as
+	// the containers might be removed afterwards.
+	spec := specgen.NewPodSpecGenerator()
+	infraOptions := entities.ContainerCreateOptions{MemorySwappiness: -1}
+	if _, err := generate.PodConfigToSpec(runtime, spec, &infraOptions, pod.ID()); err != nil {
+		return nil, fmt.Errorf("retrieving configuration of pod %s: %w", pod.ID(), err)
+	}
+	spec.Name = pod.Name()
+	spec.Labels = pod.Labels()
+	// Let the infra container be named after the restored pod.
+	spec.InfraContainerSpec.Name = ""
+
+	ctrs, err := pod.AllContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "pod-checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	ctrErrors, err := pod.Checkpoint(ctx, options, dir)
+	if err != nil && !errors.Is(err, define.ErrPodPartialFail) {
+		return nil, err
+	}
+
+	podConfig := podCheckpointConfig{
+		ID:   pod.ID(),
+		Name: pod.Name(),
+		Spec: spec,
+	}
+	for _, ctr := range ctrs {
+		if ctr.IsInfra() {
+			continue
+		}
+		if _, ok := ctrErrors[ctr.ID()]; ok {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, ctr.ID()+".tar")); err != nil {
+			continue
+		}
+		podConfig.Containers = append(podConfig.Containers, podCheckpointContainer{ID: ctr.ID(), Name: ctr.Name()})
+	}
+	if len(podConfig.Containers) == 0 {
+		return ctrErrors, fmt.Errorf("no container of pod %s was checkpointed: %w", pod.ID(), define.ErrPodPartialFail)
+	}
+
+	if _, err := metadata.WriteJSONFile(podConfig, dir, podCheckpointConfigFile); err != nil {
+		return ctrErrors, err
+	}
+
+	input, err := archive.TarWithOptions(dir, &archive.TarOptions{
+		Compression: archive.Uncompressed,
+	})
+	if err != nil {
+		return ctrErrors, fmt.Errorf("creating pod checkpoint archive: %w", err)
+	}
+	defer input.Close()
+
+	outFile, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return ctrErrors, fmt.Errorf("creating pod checkpoint export file %q: %w", target, err)
+	}
+	defer outFile.Close()
+
+	if _, err := io.Copy(outFile, input); err != nil {
+		return ctrErrors, err
+	}
+
+	if len(ctrErrors) > 0 {
+		return ctrErrors, fmt.Errorf("checkpointing some containers: %w", define.ErrPodPartialFail)
+	}
+	return nil, nil
+}
+
+// CRImportPodCheckpoint recreates a pod from the archive written by
+// CRExportPodCheckpoint and imports the checkpointed containers into it.
+// The containers join the namespaces of the new infra container and still
+// have to be restored by the caller.
+// If restoreOptions.Name is set, the pod is created with that name and the
+// containers get new IDs and are named <pod name>-<container name>.
+func CRImportPodCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOptions entities.PodRestoreOptions) (_ *libpod.Pod, _ []*libpod.Container, finalErr error) {
+	dir, err := os.MkdirTemp("", "pod-restore")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	archiveFile, err := os.Open(restoreOptions.Import)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open pod checkpoint archive %s for import: %w", restoreOptions.Import, err)
+	}
+	defer archiveFile.Close()
+	if err := chrootarchive.Untar(archiveFile, dir, &archive.TarOptions{}); err != nil {
+		return nil, nil, fmt.Errorf("unpacking of pod checkpoint archive %s failed: %w", restoreOptions.Import, err)
+	}
+
+	podConfig := new(podCheckpointConfig)
+	if _, err := metadata.ReadJSONFile(podConfig, dir, podCheckpointConfigFile); err != nil {
+		return nil, nil, fmt.Errorf("%s is not a pod checkpoint archive: %w", restoreOptions.Import, err)
+	}
+	if podConfig.Spec == nil || podConfig.Spec.InfraContainerSpec == nil {
+		return nil, nil, fmt.Errorf("pod checkpoint archive %s does not contain a pod configuration", restoreOptions.Import)
+	}
+
+	spec := podConfig.Spec
+	if restoreOptions.Name != "" {
+		spec.Name = restoreOptions.Name
+	}
+	if restoreOptions.IgnoreStaticIP || restoreOptions.IgnoreStaticMAC {
+		for _, networks := range []map[string]nettypes.PerNetworkOptions{spec.Networks, spec.InfraContainerSpec.Networks} {
+			for net, opts := range networks {
+				if restoreOptions.IgnoreStaticIP {
+					opts.StaticIPs = nil
+				}
+				if restoreOptions.IgnoreStaticMAC {
+					opts.StaticMAC = nil
+				}
+				networks[net] = opts
+			}
+		}
+	}
+	if len(restoreOptions.PublishPorts) > 0 {
+		ports, err := specgenutil.CreatePortBindings(restoreOptions.PublishPorts)
+		if err != nil {
+			return nil, nil, err
+		}
+		spec.PortMappings = ports
+		spec.InfraContainerSpec.PortMappings = ports
+	}
+
+	pod, err := generate.MakePod(&entities.PodSpec{PodSpecGen: *spec}, runtime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recreating pod %s: %w", podConfig.Name, err)
+	}
+	defer func() {
+		if finalErr != nil {
+			if _, err := runtime.RemovePod(context.Background(), pod, true, true, nil); err != nil {
+				logrus.Errorf("Removing pod %s after failed restore: %v", pod.ID(), err)
+			}
+		}
+	}()
+
+	// The containers are restored into the namespaces of the infra
+	// container, so it has to run before the first one is imported.
+	infra, err := pod.InfraContainer()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := infra.Start(ctx, false); err != nil {
+		return nil, nil, fmt.Errorf("starting infra container of pod %s: %w", pod.ID(), err)
+	}
+
+	ctrs := make([]*libpod.Container, 0, len(podConfig.Containers))
+	for _, ctr := range podConfig.Containers {
+		ctrRestoreOptions := entities.RestoreOptions{
+			Import:          filepath.Join(dir, ctr.ID+".tar"),
+			IgnoreRootFS:    restoreOptions.IgnoreRootFS,
+			IgnoreVolumes:   restoreOptions.IgnoreVolumes,
+			IgnoreStaticIP:  restoreOptions.IgnoreStaticIP,
+			IgnoreStaticMAC: restoreOptions.IgnoreStaticMAC,
+			Pod:             pod.ID(),
+		}
+		if restoreOptions.Name != "" {
+			ctrRestoreOptions.Name = restoreOptions.Name + "-" + ctr.Name
+		}
+		imported, err := CRImportCheckpointTar(ctx, runtime, ctrRestoreOptions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("importing checkpoint of container %s: %w", ctr.Name, err)
+		}
+		ctrs = append(ctrs, imported...)
+	}
+
+	return pod, ctrs, nil
+}
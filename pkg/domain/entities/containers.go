@@ -344,6 +344,16 @@ type ContainerListOptions struct {
 	Watch     uint
 }
 
+// ContainerListRemovedOptions describes the options for listing removed
+// containers
+type ContainerListRemovedOptions struct {
+	// Last limits the list to the most recently removed containers.
+	Last int
+}
+
+// ContainerTombstone describes a removed container
+type ContainerTombstone = define.ContainerTombstone
+
 // ContainerRunOptions describes the options needed
 // to run a container from the CLI
 type ContainerRunOptions struct {
@@ -496,6 +506,9 @@ type ContainerCloneOptions struct {
 	RawImageName string
 	Run          bool
 	Force        bool
+	// CopyChanges copies the writable layer and anonymous volumes of
+	// the container to the clone
+	CopyChanges bool
 }
 
 // ContainerUpdateOptions containers options for updating an existing containers cgroup configuration
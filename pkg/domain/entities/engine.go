@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 	"go.podman.io/common/pkg/config"
 )
@@ -65,4 +67,7 @@ type PodmanConfig struct {
 	TransientStore bool
 	GraphRoot      string
 	PullOptions    []string
+
+	TombstoneCount  int           // number of removed containers to keep tombstones of
+	TombstoneMaxAge time.Duration // time to keep tombstones of removed containers for
 }
@@ -19,6 +19,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	Config(ctx context.Context) (*config.Config, error)
 	ContainerAttach(ctx context.Context, nameOrID string, options AttachOptions) error
 	ContainerCheckpoint(ctx context.Context, namesOrIds []string, options CheckpointOptions) ([]*CheckpointReport, error)
+	ContainerCheckStorageQuota(ctx context.Context, nameOrID string) (bool, error)
 	ContainerCleanup(ctx context.Context, namesOrIds []string, options ContainerCleanupOptions) ([]*ContainerCleanupReport, error)
 	ContainerClone(ctx context.Context, ctrClone ContainerCloneOptions) (*ContainerCreateReport, error)
 	ContainerCommit(ctx context.Context, nameOrID string, options CommitOptions) (*CommitReport, error)
@@ -35,6 +36,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	ContainerKill(ctx context.Context, namesOrIds []string, options KillOptions) ([]*KillReport, error)
 	ContainerList(ctx context.Context, options ContainerListOptions) ([]ListContainer, error)
 	ContainerListExternal(ctx context.Context) ([]ListContainer, error)
+	ContainerListRemoved(ctx context.Context, options ContainerListRemovedOptions) ([]ContainerTombstone, error)
 	ContainerLogs(ctx context.Context, containers []string, options ContainerLogsOptions) error
 	ContainerMount(ctx context.Context, nameOrIDs []string, options ContainerMountOptions) ([]*ContainerMountReport, error)
 	ContainerPause(ctx context.Context, namesOrIds []string, options PauseUnPauseOptions) ([]*PauseUnpauseReport, error)
@@ -65,7 +67,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	HealthCheckRun(ctx context.Context, nameOrID string, options HealthCheckOptions) (*define.HealthCheckResults, error)
 	Info(ctx context.Context) (*define.Info, error)
 	KubeApply(ctx context.Context, body io.Reader, opts ApplyOptions) error
-	Locks(ctx context.Context) (*LocksReport, error)
+	Locks(ctx context.Context, options LocksOptions) (*LocksReport, error)
 	Migrate(ctx context.Context, options SystemMigrateOptions) error
 	NetworkConnect(ctx context.Context, networkname string, options NetworkConnectOptions) error
 	NetworkCreate(ctx context.Context, network netTypes.Network, createOptions *netTypes.NetworkCreateOptions) (*netTypes.Network, error)
@@ -79,8 +81,12 @@ type ContainerEngine interface { //nolint:interfacebloat
 	NetworkRm(ctx context.Context, namesOrIds []string, options NetworkRmOptions) ([]*NetworkRmReport, error)
 	PlayKube(ctx context.Context, body io.Reader, opts PlayKubeOptions) (*PlayKubeReport, error)
 	PlayKubeDown(ctx context.Context, body io.Reader, opts PlayKubeDownOptions) (*PlayKubeReport, error)
+	PluginInspect(ctx context.Context, names []string) ([]*PluginReport, []error, error)
+	PluginList(ctx context.Context, opts PluginListOptions) ([]*PluginReport, error)
+	PodCheckpoint(ctx context.Context, namesOrIds []string, options PodCheckpointOptions) ([]*PodCheckpointReport, error)
 	PodCreate(ctx context.Context, specg PodSpec) (*PodCreateReport, error)
 	PodClone(ctx context.Context, podClone PodCloneOptions) (*PodCloneReport, error)
+	PodEvents(ctx context.Context, nameOrID string, options PodEventsOptions) error
 	PodExists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	PodInspect(ctx context.Context, namesOrID []string, options InspectOptions) ([]*PodInspectReport, []error, error)
 	PodKill(ctx context.Context, namesOrIds []string, options PodKillOptions) ([]*PodKillReport, error)
@@ -89,12 +95,15 @@ type ContainerEngine interface { //nolint:interfacebloat
 	PodPrune(ctx context.Context, options PodPruneOptions) ([]*PodPruneReport, error)
 	PodPs(ctx context.Context, options PodPSOptions) ([]*ListPodsReport, error)
 	PodRestart(ctx context.Context, namesOrIds []string, options PodRestartOptions) ([]*PodRestartReport, error)
+	PodRestore(ctx context.Context, namesOrIds []string, options PodRestoreOptions) ([]*PodRestoreReport, error)
 	PodRm(ctx context.Context, namesOrIds []string, options PodRmOptions) ([]*PodRmReport, error)
 	PodStart(ctx context.Context, namesOrIds []string, options PodStartOptions) ([]*PodStartReport, error)
 	PodStats(ctx context.Context, namesOrIds []string, options PodStatsOptions) ([]*PodStatsReport, error)
 	PodStop(ctx context.Context, namesOrIds []string, options PodStopOptions) ([]*PodStopReport, error)
 	PodTop(ctx context.Context, options PodTopOptions) (*StringSliceReport, error)
 	PodUnpause(ctx context.Context, namesOrIds []string, options PodunpauseOptions) ([]*PodUnpauseReport, error)
+	PodUpdate(ctx context.Context, options *PodUpdateOptions) (string, error)
+	PodWait(ctx context.Context, namesOrIds []string, options PodWaitOptions) ([]*PodWaitReport, error)
 	QuadletExists(ctx context.Context, name string) (*BoolReport, error)
 	QuadletInstall(ctx context.Context, pathsOrURLs []string, options QuadletInstallOptions) (*QuadletInstallReport, error)
 	QuadletList(ctx context.Context, options QuadletListOptions) ([]*ListQuadlet, error)
@@ -109,8 +118,12 @@ type ContainerEngine interface { //nolint:interfacebloat
 	SecretRm(ctx context.Context, nameOrID []string, opts SecretRmOptions) ([]*SecretRmReport, error)
 	SecretExists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	Shutdown(ctx context.Context)
+	SystemBackup(ctx context.Context, options SystemBackupOptions) error
 	SystemDf(ctx context.Context, options SystemDfOptions) (*SystemDfReport, error)
+	SystemGC(ctx context.Context, options SystemGCOptions) (*SystemGCReport, error)
 	SystemCheck(ctx context.Context, options SystemCheckOptions) (*SystemCheckReport, error)
+	SystemMonitor(ctx context.Context, options SystemMonitorOptions) (chan SystemMonitorReport, error)
+	SystemRestore(ctx context.Context, options SystemRestoreOptions) (*SystemRestoreReport, error)
 	Unshare(ctx context.Context, args []string, options SystemUnshareOptions) error
 	Version(ctx context.Context) (*SystemVersionReport, error)
 	VolumeCreate(ctx context.Context, opts VolumeCreateOptions) (*IDOrNameResponse, error)
@@ -0,0 +1,11 @@
+package entities
+
+import "go.podman.io/podman/v6/pkg/domain/entities/types"
+
+// PluginListOptions describes the options for listing plugins
+type PluginListOptions struct {
+	Filter map[string][]string
+}
+
+// PluginReport describes a volume plugin configured in containers.conf
+type PluginReport = types.PluginReport
@@ -3,13 +3,16 @@ package entities
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 	commonFlag "go.podman.io/common/pkg/flag"
 	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/libpod/events"
 	"go.podman.io/podman/v6/pkg/domain/entities/types"
 	"go.podman.io/podman/v6/pkg/specgen"
 	"go.podman.io/podman/v6/pkg/util"
+	"go.podman.io/storage/pkg/archive"
 )
 
 type PodKillOptions struct {
@@ -74,6 +77,74 @@ type PodRmReport = types.PodRmReport
 
 type PodSpec = types.PodSpec
 
+// PodUpdateOptions contains options for updating an existing pod's cgroup
+// configuration, restart policy and labels
+type PodUpdateOptions = types.PodUpdateOptions
+
+// PodCheckpointOptions contains the options for checkpointing all running
+// containers of a pod.
+type PodCheckpointOptions struct {
+	All            bool
+	Export         string
+	IgnoreRootFS   bool
+	IgnoreVolumes  bool
+	Keep           bool
+	Latest         bool
+	LeaveRunning   bool
+	TCPEstablished bool
+	Compression    archive.Compression
+	FileLocks      bool
+}
+
+type PodCheckpointReport = types.PodCheckpointReport
+
+// PodRestoreOptions contains the options for restoring a checkpointed pod,
+// either in place or from an archive created with PodCheckpointOptions.Export.
+type PodRestoreOptions struct {
+	All             bool
+	IgnoreRootFS    bool
+	IgnoreVolumes   bool
+	IgnoreStaticIP  bool
+	IgnoreStaticMAC bool
+	Import          string
+	Keep            bool
+	Latest          bool
+	Name            string
+	TCPEstablished  bool
+	TCPClose        bool
+	PublishPorts    []string
+	FileLocks       bool
+}
+
+type PodRestoreReport = types.PodRestoreReport
+
+// PodWaitOptions are arguments for waiting for the containers of a pod.
+type PodWaitOptions struct {
+	// Conditions to wait on, e.g. "exited", "running" or "healthy".
+	Conditions []string
+	// Time interval to wait before polling for completion.
+	Interval time.Duration
+	// Return as soon as one container meets a condition.
+	Any bool
+	// ExitCodePropagation overrides how the exit codes of the containers
+	// are aggregated ("none", "any" or "all").
+	ExitCodePropagation string
+	Latest              bool
+}
+
+type PodWaitReport = types.PodWaitReport
+
+// PodEventsOptions are the options for reading the events of a pod and its
+// containers. See EventsOptions.
+type PodEventsOptions struct {
+	FromStart bool
+	EventChan chan events.ReadResult
+	Filter    []string
+	Stream    bool
+	Since     string
+	Until     string
+}
+
 // PodCreateOptions provides all possible options for creating a pod and its infra container.
 // The JSON tags below are made to match the respective field in ContainerCreateOptions for the purpose of mapping.
 // swagger:model PodCreateOptions
@@ -231,6 +302,7 @@ type ContainerCreateOptions struct {
 	SdNotifyMode         string
 	ShmSize              string
 	ShmSizeSystemd       string
+	Sidecar              bool
 	SignaturePolicy      string
 	StartupHCCmd         string
 	StartupHCInterval    string
@@ -240,6 +312,8 @@ type ContainerCreateOptions struct {
 	StopSignal           string
 	StopTimeout          uint
 	StorageOpts          []string
+	StorageQuota         string
+	StorageQuotaAction   string
 	SubGIDName           string
 	SubUIDName           string
 	Sysctl               []string `json:"sysctl,omitempty"`
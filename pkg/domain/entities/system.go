@@ -9,14 +9,24 @@ type (
 	ServiceOptions          = types.ServiceOptions
 	SystemPruneOptions      = types.SystemPruneOptions
 	SystemPruneReport       = types.SystemPruneReport
+	SystemGCOptions         = types.SystemGCOptions
+	SystemGCReport          = types.SystemGCReport
+	SystemGCRemoval         = types.SystemGCRemoval
 	SystemMigrateOptions    = types.SystemMigrateOptions
+	SystemBackupOptions     = types.SystemBackupOptions
+	SystemRestoreOptions    = types.SystemRestoreOptions
+	SystemRestoreReport     = types.SystemRestoreReport
 	SystemCheckOptions      = types.SystemCheckOptions
 	SystemCheckReport       = types.SystemCheckReport
+	SystemCheckIssue        = types.SystemCheckIssue
 	SystemDfOptions         = types.SystemDfOptions
 	SystemDfReport          = types.SystemDfReport
 	SystemDfImageReport     = types.SystemDfImageReport
 	SystemDfContainerReport = types.SystemDfContainerReport
 	SystemDfVolumeReport    = types.SystemDfVolumeReport
+	SystemDfGroupReport     = types.SystemDfGroupReport
+	SystemMonitorOptions    = types.SystemMonitorOptions
+	SystemMonitorReport     = types.SystemMonitorReport
 	SystemVersionReport     = types.SystemVersionReport
 	SystemUnshareOptions    = types.SystemUnshareOptions
 	ComponentVersion        = types.SystemComponentVersion
@@ -24,7 +34,10 @@ type (
 )
 
 type (
-	AuthConfig  = types.AuthConfig
-	AuthReport  = types.AuthReport
-	LocksReport = types.LocksReport
+	AuthConfig       = types.AuthConfig
+	AuthReport       = types.AuthReport
+	LocksReport      = types.LocksReport
+	LocksOptions     = types.LocksOptions
+	LockHolderReport = types.LockHolderReport
+	LockHolder       = types.LockHolder
 )
@@ -0,0 +1,8 @@
+package types
+
+import "go.podman.io/podman/v6/libpod/define"
+
+// PluginReport describes a volume plugin configured in containers.conf
+type PluginReport struct {
+	define.VolumePluginInfo
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/pkg/specgen"
 )
@@ -52,6 +53,44 @@ type PodCloneReport struct {
 	Id string
 }
 
+type PodCheckpointReport struct {
+	Errs     []error
+	Id       string
+	RawInput string
+}
+
+type PodRestoreReport struct {
+	Errs     []error
+	Id       string
+	RawInput string
+}
+
+type PodWaitReport struct {
+	Id string
+	// ExitCode is the aggregated exit code of the containers of the pod.
+	// It is -1 unless the containers were waited for to exit.
+	ExitCode int32
+	Err      error `json:"-"`
+}
+
+// PodUpdateOptions contains the options for updating the configuration of
+// an existing pod.
+type PodUpdateOptions struct {
+	NameOrID string
+	// Resources are merged into the pod cgroup limits set at creation.
+	Resources     *specs.LinuxResources
+	DevicesLimits *define.UpdateContainerDevicesLimits
+	// RestartPolicy and RestartRetries are applied to the pod and to
+	// all of its member containers, with the exception of init containers.
+	RestartPolicy  *string
+	RestartRetries *uint
+	// Labels are added to the pod labels, replacing existing values.
+	Labels map[string]string
+	// UnsetLabels are removed from the pod labels.
+	UnsetLabels []string
+	Latest      bool
+}
+
 // PodStatsReport includes pod-resource statistics data.
 type PodStatsReport struct {
 	// Percentage of CPU utilized by pod
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"io"
 	"time"
 
 	"go.podman.io/podman/v6/libpod/define"
@@ -9,13 +10,22 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type ServiceOptions struct {
-	CorsHeaders     string        // Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr       string        // Network address to bind pprof profiles service
-	Timeout         time.Duration // Duration of inactivity the service should wait before shutting down
-	URI             string        // Path to unix domain socket service should listen on
-	TLSCertFile     string        // Path to serving certificate PEM file
-	TLSKeyFile      string        // Path to serving certificate key PEM file
-	TLSClientCAFile string        // Path to client certificate authority
+	CorsHeaders     string            // Cross-Origin Resource Sharing (CORS) headers
+	PProfAddr       string            // Network address to bind pprof profiles service
+	Timeout         time.Duration     // Duration of inactivity the service should wait before shutting down
+	URI             string            // Path to unix domain socket service should listen on
+	TLSCertFile     string            // Path to serving certificate PEM file
+	TLSKeyFile      string            // Path to serving certificate key PEM file
+	TLSClientCAFile string            // Path to client certificate authority
+	MetricsLabels   []string          // Container labels exposed as labels of the container metrics
+	AuthzPolicy     string            // Path to the authorization policy file
+	AuditLog        string            // Path to the audit log of API requests
+	AuditLogMaxSize int64             // Size of the audit log triggering a rotation, 0 disables rotation
+	AuditLogMaxFile int               // Number of rotated audit log files kept
+	AuditPolicy     string            // Path to the audit policy file
+	RateLimits      map[string]string // Request rate allowed to each client by request class, N/UNIT
+	MaxInFlight     map[string]int    // Requests of each client processed at the same time by request class
+	QueueTimeout    time.Duration     // Time a request waits for an in flight slot before it is refused
 }
 
 // SystemCheckOptions provides options for checking storage consistency.
@@ -38,6 +48,62 @@ type SystemCheckReport struct {
 	RemovedImages     map[string][]string // image ID → names
 	Containers        map[string][]string // container ID → what was detected
 	RemovedContainers map[string]string   // container ID → name
+	Libpod            []SystemCheckIssue  // inconsistencies in the libpod database
+}
+
+// SystemCheckIssue describes an inconsistency in the libpod database, and
+// whether it was repaired.
+type SystemCheckIssue struct {
+	Check       string // identifier of the check that failed, such as "dangling-dependency"
+	Type        string // type of the affected object: container, pod or volume
+	ID          string // ID of the affected object, or the name of a volume
+	Name        string // name of the affected object
+	Message     string // what was detected
+	RepairLossy bool   // repairing removes the object, which requires RepairLossy
+	Repaired    bool   // the problem was repaired
+	RepairError string // why repairing failed
+}
+
+// SystemBackupOptions provides options for backing up the engine state.
+type SystemBackupOptions struct {
+	Output  io.Writer // where the backup archive is written
+	Volumes bool      // include the contents of local volumes
+	Images  bool      // include all images as an OCI layout
+	// include the data of the secrets, otherwise they must be created
+	// before restoring
+	SecretData bool
+}
+
+// SystemRestoreOptions provides options for restoring a backup of the
+// engine state.
+type SystemRestoreOptions struct {
+	Input io.Reader // backup archive to restore
+}
+
+// SystemRestoreReport lists the objects recreated from a backup.
+type SystemRestoreReport struct {
+	Containers     []string          // container IDs
+	Pods           []string          // pod IDs
+	Volumes        []string          // volume names
+	Networks       []string          // names of the networks created
+	ReusedNetworks []string          // names of networks which existed and match the backup
+	Secrets        []string          // secret IDs
+	Images         []string          // image IDs
+	RemappedIDs    map[string]string // ID in the backup → new ID, for IDs already in use on this host
+}
+
+// SystemMonitorOptions provides options for monitoring the system
+type SystemMonitorOptions struct {
+	Interval int  // seconds between samples
+	Stream   bool // keep sampling until cancelled
+}
+
+// SystemMonitorReport is used for streaming samples of the system usage
+type SystemMonitorReport struct {
+	// Error from sampling the usage
+	Error error
+	// Usage, set when there is no error
+	Usage *define.SystemUsage
 }
 
 // SystemPruneOptions provides options to prune system.
@@ -59,6 +125,55 @@ type SystemPruneReport struct {
 	ReclaimedSpace        uint64
 }
 
+// SystemGCOptions describes the policy `podman system gc` removes containers
+// and images by.  Zero values disable the respective part of the policy.
+type SystemGCOptions struct {
+	// DryRun reports what would be removed without removing it
+	DryRun bool
+	// ContainerTTL is the time after which exited containers are removed
+	ContainerTTL time.Duration
+	// KeepLabel is the label which exempts containers from removal
+	KeepLabel string
+	// ImageUnusedFor is the time after which images not used by any
+	// container are removed
+	ImageUnusedFor time.Duration
+	// ProtectedImages are the patterns of the references of images which
+	// are never removed
+	ProtectedImages []string
+	// MaxDiskUsage is the usage of the file system holding the graph root,
+	// in percent, which unused images are removed to stay below
+	MaxDiskUsage int
+	// BuildCache removes the containers left behind by interrupted builds
+	BuildCache bool
+}
+
+// SystemGCReport describes the result of `podman system gc`
+type SystemGCReport struct {
+	DryRun  bool
+	Removed []*SystemGCRemoval
+	// ReclaimedSpace is the combined size of the removed containers and
+	// images
+	ReclaimedSpace uint64
+	// Usage of the file system holding the graph root before and after
+	// the removals; StorageAfter is not set by a dry run
+	StorageBefore *define.StorageUsage `json:",omitempty"`
+	StorageAfter  *define.StorageUsage `json:",omitempty"`
+}
+
+// SystemGCRemoval describes a container or image removed by `podman system gc`
+type SystemGCRemoval struct {
+	// Type is "container", "image" or "build-container"
+	Type string
+	ID   string
+	Name string
+	// Reason is the part of the policy the removal is due to:
+	// "container-ttl", "image-unused", "disk-usage" or "build-cache"
+	Reason string
+	Size   uint64
+	// Error is set if the removal failed
+	Error string `json:",omitempty"`
+}
+
 // SystemMigrateOptions describes the options needed for the
 // cli to migrate runtimes of containers
 type SystemMigrateOptions struct {
@@ -70,6 +185,12 @@ type SystemMigrateOptions struct {
 type SystemDfOptions struct {
 	Format  string
 	Verbose bool
+	// GroupBy attributes disk usage to groups of containers: "pod",
+	// "project" or "label=KEY"
+	GroupBy string
+	// Exclusive attributes layers and volumes shared between groups to
+	// none of them, instead of proportionally to all of them
+	Exclusive bool
 }
 
 // SystemDfReport describes the response for df information
@@ -78,6 +199,7 @@ type SystemDfReport struct {
 	Images     []*SystemDfImageReport
 	Containers []*SystemDfContainerReport
 	Volumes    []*SystemDfVolumeReport
+	Groups     []*SystemDfGroupReport `json:",omitempty"`
 }
 
 // SystemDfImageReport describes an image for use with df
@@ -103,6 +225,11 @@ type SystemDfContainerReport struct {
 	Created      time.Time
 	Status       string
 	Names        string
+
+	// Files kept outside of the container's root file system
+	LogSize            int64
+	CheckpointSize     int64
+	HealthCheckLogSize int64
 }
 
 // SystemDfVolumeReport describes a volume and its size
@@ -113,6 +240,21 @@ type SystemDfVolumeReport struct {
 	ReclaimableSize int64
 }
 
+// SystemDfGroupReport describes the disk usage attributed to a group of
+// containers.  Group is the pod name, project name or label value shared by the
+// containers, and is empty for containers which are not part of any group.
+type SystemDfGroupReport struct {
+	Group              string
+	Containers         int
+	ImagesSize         int64
+	RWSize             int64
+	VolumesSize        int64
+	LogSize            int64
+	CheckpointSize     int64
+	HealthCheckLogSize int64
+	TotalSize          int64
+}
+
 // SystemVersionReport describes version information about the running Podman service
 type SystemVersionReport struct {
 	// Always populated
@@ -143,4 +285,47 @@ type AuthReport struct {
 type LocksReport struct {
 	LockConflicts map[uint32][]string
 	LocksHeld     []uint32
+	// Holders describes the processes holding and waiting for locks
+	Holders []LockHolderReport
+	// Cycles are lock-order cycles: every lock in a cycle is held by a
+	// process waiting for the next one, and the last lock by a process
+	// waiting for the first
+	Cycles [][]uint32
+	// Broken are the locks released because their holder had exited
+	Broken []uint32
+	// RecordingHolders is whether the processes holding and waiting for
+	// locks are recorded, Holders is only complete if they are
+	RecordingHolders bool
+}
+
+// LocksOptions are the options for podman system locks
+type LocksOptions struct {
+	// Break releases locks whose holder has exited
+	Break bool
+	// RecordHolders enables or disables recording the processes holding
+	// and waiting for locks, for the processes started afterwards
+	RecordHolders *bool
+}
+
+// LockHolderReport describes who holds a lock and who is waiting for it
+type LockHolderReport struct {
+	LockID uint32
+	// Held is whether the lock is presently locked
+	Held bool
+	// Users are the containers, pods and volumes using the lock
+	Users []string
+	// Holder is the process recorded as holding the lock, if any
+	Holder *LockHolder
+	// Waiters are the processes blocked acquiring the lock
+	Waiters []LockHolder
+}
+
+// LockHolder describes a process holding or waiting for a lock
+type LockHolder struct {
+	PID     int
+	Command string
+	// Since is when the lock was acquired, or waiting started
+	Since time.Time
+	// Alive is whether the process is still running
+	Alive bool
 }
@@ -0,0 +1,47 @@
+//go:build !remote && (linux || freebsd)
+
+package filters
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/util"
+)
+
+// PluginFilter is a function to determine whether a volume plugin is
+// included in command output.
+type PluginFilter func(*define.VolumePluginInfo) bool
+
+func GeneratePluginFilters(filter string, filterValues []string) (PluginFilter, error) {
+	switch filter {
+	case "name":
+		return func(p *define.VolumePluginInfo) bool {
+			return util.StringMatchRegexSlice(p.Name, filterValues)
+		}, nil
+	case "capability":
+		return func(p *define.VolumePluginInfo) bool {
+			return slices.ContainsFunc(filterValues, func(val string) bool {
+				return slices.ContainsFunc(p.Implements, func(impl string) bool {
+					return strings.EqualFold(val, impl)
+				})
+			})
+		}, nil
+	case "enabled":
+		var enabled []bool
+		for _, val := range filterValues {
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid enabled filter value %q: %w", val, err)
+			}
+			enabled = append(enabled, b)
+		}
+		return func(p *define.VolumePluginInfo) bool {
+			return slices.Contains(enabled, p.Reachable)
+		}, nil
+	}
+	return nil, fmt.Errorf("%q is an invalid plugin filter", filter)
+}
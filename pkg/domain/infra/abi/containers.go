@@ -1155,6 +1155,21 @@ func (ic *ContainerEngine) ContainerListExternal(_ context.Context) ([]entities.
 	return ps.GetExternalContainerLists(ic.Libpod)
 }
 
+func (ic *ContainerEngine) ContainerListRemoved(_ context.Context, options entities.ContainerListRemovedOptions) ([]entities.ContainerTombstone, error) {
+	tombstones, err := ic.Libpod.ContainerTombstones()
+	if err != nil {
+		return nil, err
+	}
+	if options.Last > 0 && options.Last < len(tombstones) {
+		tombstones = tombstones[:options.Last]
+	}
+	reports := make([]entities.ContainerTombstone, 0, len(tombstones))
+	for _, tombstone := range tombstones {
+		reports = append(reports, *tombstone)
+	}
+	return reports, nil
+}
+
 // Diff provides changes to given container
 func (ic *ContainerEngine) Diff(_ context.Context, namesOrIDs []string, opts entities.DiffOptions) (*entities.DiffReport, error) {
 	var (
@@ -1380,6 +1395,14 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, namesOrIds []strin
 	return nil
 }
 
+func (ic *ContainerEngine) ContainerCheckStorageQuota(ctx context.Context, nameOrID string) (bool, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return false, err
+	}
+	return ctr.CheckStorageQuota(ctx)
+}
+
 func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []string, options entities.ContainerCleanupOptions) ([]*entities.ContainerCleanupReport, error) {
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
 	if err != nil {
@@ -1843,6 +1866,23 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 		spec.Name = generate.CheckName(ic.Libpod, n, true)
 	}
 
+	if ctrCloneOpts.CopyChanges {
+		// The clone gets new anonymous volumes which the contents of
+		// ours are copied to, instead of sharing them
+		for _, v := range spec.Volumes {
+			if v.Name == "" {
+				continue
+			}
+			vol, err := ic.Libpod.LookupVolume(v.Name)
+			if err != nil {
+				return nil, err
+			}
+			if vol.Anonymous() {
+				v.Name = ""
+			}
+		}
+	}
+
 	rtSpec, spec, opts, err := generate.MakeContainer(context.Background(), ic.Libpod, spec, true, c)
 	if err != nil {
 		return nil, err
@@ -1852,13 +1892,17 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 		return nil, err
 	}
 
-	if ctrCloneOpts.Destroy {
-		var time *uint
-		err = ic.Libpod.RemoveContainer(context.Background(), c, ctrCloneOpts.Force, false, time)
-		if err != nil {
-			return nil, err
+	// Remove the clone again if copying changes or destroying the
+	// original container fails, so that the original stays the only one
+	tx := ic.Libpod.NewTransaction()
+	tx.AddContainer(ctr)
+	if err := ic.finishClone(c, ctr, ctrCloneOpts); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			logrus.Errorf("Removing clone %s: %v", ctr.ID(), rbErr)
 		}
+		return nil, err
 	}
+	tx.Commit()
 
 	if ctrCloneOpts.Run {
 		if err := ctr.Start(ctx, true); err != nil {
@@ -1869,6 +1913,23 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 	return &entities.ContainerCreateReport{Id: ctr.ID()}, nil
 }
 
+// finishClone copies the changes of container c to its clone and destroys c,
+// as requested by the clone options.
+func (ic *ContainerEngine) finishClone(c, clone *libpod.Container, ctrCloneOpts entities.ContainerCloneOptions) error {
+	if ctrCloneOpts.CopyChanges {
+		if err := c.CopyChangesTo(clone); err != nil {
+			return err
+		}
+	}
+	if ctrCloneOpts.Destroy {
+		var time *uint
+		if err := ic.Libpod.RemoveContainer(context.Background(), c, ctrCloneOpts.Force, false, time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ContainerUpdate finds and updates the given container's cgroup config with the specified options
 func (ic *ContainerEngine) ContainerUpdate(_ context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
 	updateOptions.ProcessSpecgen()
@@ -13,3 +13,12 @@ func (ic *ContainerEngine) Events(ctx context.Context, opts entities.EventsOptio
 	readOpts := events.ReadOptions{FromStart: opts.FromStart, Stream: opts.Stream, Filters: opts.Filter, EventChannel: opts.EventChan, Since: opts.Since, Until: opts.Until}
 	return ic.Libpod.Events(ctx, readOpts)
 }
+
+func (ic *ContainerEngine) PodEvents(ctx context.Context, nameOrID string, opts entities.PodEventsOptions) error {
+	pod, err := ic.Libpod.LookupPod(nameOrID)
+	if err != nil {
+		return err
+	}
+	readOpts := events.ReadOptions{FromStart: opts.FromStart, Stream: opts.Stream, Filters: opts.Filter, EventChannel: opts.EventChan, Since: opts.Since, Until: opts.Until}
+	return pod.Events(ctx, readOpts)
+}
@@ -239,6 +239,20 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 	report := &entities.PlayKubeReport{}
 	validKinds := 0
 
+	// Remove the pods, volumes and secrets created so far if playing
+	// fails halfway, so that the YAML can be played again once the
+	// problem is fixed.  Objects which existed before are kept.
+	tx := ic.Libpod.NewTransaction()
+	defer func() {
+		if finalErr == nil {
+			tx.Commit()
+			return
+		}
+		if err := tx.Rollback(context.Background()); err != nil {
+			logrus.Errorf("Removing objects created before kube play failed: %v", err)
+		}
+	}()
+
 	// when no network options are specified, create a common network for all the pods
 	if len(options.Networks) == 0 {
 		_, err := ic.NetworkCreate(
@@ -334,20 +348,9 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				return nil, err
 			}
 			serviceContainer = ctr
-			// Make sure to remove the container in case something goes wrong below.
-			defer func() {
-				if finalErr == nil {
-					return
-				}
-				if err := ic.Libpod.RemoveContainer(ctx, ctr, true, true, nil); err != nil {
-					// Log this in debug mode so that we don't print out an error and confuse the user
-					// when the service container can't be removed because the pod still exists
-					// This can happen when an error happens during kube play and we are trying to
-					// clean up after the error. The service container will be removed as part of the
-					// teardown function.
-					logrus.Debugf("Error cleaning up service container after failure: %v", err)
-				}
-			}()
+			// The pods using the service container are created later,
+			// so they are rolled back before it.
+			tx.AddContainer(ctr)
 		}
 
 		switch kind {
@@ -375,7 +378,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				return nil, err
 			}
 
-			r, proxies, err := ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options, &ipIndex, podYAML.Annotations, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubePod(ctx, tx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options, &ipIndex, podYAML.Annotations, configMaps, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -394,7 +397,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			}
 			report.ValidationWarnings = append(report.ValidationWarnings, warnings...)
 
-			r, proxies, err := ic.playKubeDaemonSet(ctx, &daemonSetYAML, options, &ipIndex, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubeDaemonSet(ctx, tx, &daemonSetYAML, options, &ipIndex, configMaps, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -413,7 +416,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			}
 			report.ValidationWarnings = append(report.ValidationWarnings, warnings...)
 
-			r, proxies, err := ic.playKubeDeployment(ctx, &deploymentYAML, options, &ipIndex, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubeDeployment(ctx, tx, &deploymentYAML, options, &ipIndex, configMaps, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -432,7 +435,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			}
 			report.ValidationWarnings = append(report.ValidationWarnings, warnings...)
 
-			r, proxies, err := ic.playKubeJob(ctx, &jobYAML, options, &ipIndex, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubeJob(ctx, tx, &jobYAML, options, &ipIndex, configMaps, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -464,7 +467,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				}
 			}
 
-			r, err := ic.playKubePVC(ctx, "", &pvcYAML)
+			r, err := ic.playKubePVC(ctx, tx, "", &pvcYAML)
 			if err != nil {
 				return nil, err
 			}
@@ -489,7 +492,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			}
 			report.ValidationWarnings = append(report.ValidationWarnings, warnings...)
 
-			r, err := ic.playKubeSecret(&secret)
+			r, err := ic.playKubeSecret(tx, &secret)
 			if err != nil {
 				return nil, err
 			}
@@ -601,7 +604,7 @@ func decodeKubeObject(kind string, document []byte, obj any) error {
 	return nil
 }
 
-func (ic *ContainerEngine) playKubeDaemonSet(ctx context.Context, daemonSetYAML *v1apps.DaemonSet, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubeDaemonSet(ctx context.Context, tx *libpod.Transaction, daemonSetYAML *v1apps.DaemonSet, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		daemonSetName string
 		podSpec       v1.PodTemplateSpec
@@ -615,7 +618,7 @@ func (ic *ContainerEngine) playKubeDaemonSet(ctx context.Context, daemonSetYAML
 	podSpec = daemonSetYAML.Spec.Template
 
 	podName := fmt.Sprintf("%s-pod", daemonSetName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, daemonSetYAML.Annotations, configMaps, serviceContainer)
+	podReport, proxies, err := ic.playKubePod(ctx, tx, podName, &podSpec, options, ipIndex, daemonSetYAML.Annotations, configMaps, serviceContainer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
 	}
@@ -625,7 +628,7 @@ func (ic *ContainerEngine) playKubeDaemonSet(ctx context.Context, daemonSetYAML
 	return &report, proxies, nil
 }
 
-func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, tx *libpod.Transaction, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		deploymentName string
 		podSpec        v1.PodTemplateSpec
@@ -647,7 +650,7 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	podSpec = deploymentYAML.Spec.Template
 
 	podName := fmt.Sprintf("%s-pod", deploymentName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, deploymentYAML.Annotations, configMaps, serviceContainer)
+	podReport, proxies, err := ic.playKubePod(ctx, tx, podName, &podSpec, options, ipIndex, deploymentYAML.Annotations, configMaps, serviceContainer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
 	}
@@ -657,7 +660,7 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	return &report, proxies, nil
 }
 
-func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubeJob(ctx context.Context, tx *libpod.Transaction, jobYAML *v1.Job, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		jobName string
 		podSpec v1.PodTemplateSpec
@@ -671,7 +674,7 @@ func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, opt
 	podSpec = jobYAML.Spec.Template
 
 	podName := fmt.Sprintf("%s-pod", jobName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, jobYAML.Annotations, configMaps, serviceContainer)
+	podReport, proxies, err := ic.playKubePod(ctx, tx, podName, &podSpec, options, ipIndex, jobYAML.Annotations, configMaps, serviceContainer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
 	}
@@ -681,7 +684,7 @@ func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, opt
 	return &report, proxies, nil
 }
 
-func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubePod(ctx context.Context, tx *libpod.Transaction, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	cfg, err := ic.Libpod.GetConfigNoCopy()
 	if err != nil {
 		return nil, nil, err
@@ -858,17 +861,18 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 				libpod.WithVolumeMountLabel(mountLabel),
 			}
 			vol, err := ic.Libpod.NewVolume(ctx, volumeOptions...)
-			if err != nil {
-				if errors.Is(err, define.ErrVolumeExists) {
-					// Volume for this configmap already exists do not
-					// error out instead reuse the current volume.
-					vol, err = ic.Libpod.GetVolume(v.Source)
-					if err != nil {
-						return nil, nil, fmt.Errorf("cannot reuse local volume for volume from configmap %q: %w", v.Source, err)
-					}
-				} else {
-					return nil, nil, fmt.Errorf("cannot create a local volume for volume from configmap %q: %w", v.Source, err)
+			switch {
+			case err == nil:
+				tx.AddVolume(vol)
+			case errors.Is(err, define.ErrVolumeExists):
+				// Volume for this configmap already exists do not
+				// error out instead reuse the current volume.
+				vol, err = ic.Libpod.GetVolume(v.Source)
+				if err != nil {
+					return nil, nil, fmt.Errorf("cannot reuse local volume for volume from configmap %q: %w", v.Source, err)
 				}
+			default:
+				return nil, nil, fmt.Errorf("cannot create a local volume for volume from configmap %q: %w", v.Source, err)
 			}
 			mountPoint, err := vol.MountPoint()
 			if err != nil || mountPoint == "" {
@@ -979,6 +983,8 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	if err != nil {
 		return nil, nil, err
 	}
+	// Removing the pod removes its containers as well
+	tx.AddPod(pod)
 
 	podInfraID, err := pod.InfraContainerID()
 	if err != nil {
@@ -1014,8 +1020,11 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			return nil, nil, fmt.Errorf("the pod %q is invalid; duplicate container name %q detected", podName, initCtr.Name)
 		}
 		ctrNames[initCtr.Name] = ""
+		// Init containers with restartPolicy Always are native sidecars. They
+		// keep running alongside the regular containers and may have probes.
+		isSidecar := initCtr.RestartPolicy != nil && *initCtr.RestartPolicy == v1.ContainerRestartPolicyAlways
 		// Init containers cannot have either of lifecycle, livenessProbe, readinessProbe, or startupProbe set
-		if initCtr.Lifecycle != nil || initCtr.LivenessProbe != nil || initCtr.ReadinessProbe != nil || initCtr.StartupProbe != nil {
+		if !isSidecar && (initCtr.Lifecycle != nil || initCtr.LivenessProbe != nil || initCtr.ReadinessProbe != nil || initCtr.StartupProbe != nil) {
 			return nil, nil, fmt.Errorf("cannot create an init container that has either of lifecycle, livenessProbe, readinessProbe, or startupProbe set")
 		}
 		pulledImage, labels, err := ic.getImageAndLabelInfo(ctx, cwd, annotations, writer, initCtr, options)
@@ -1029,6 +1038,11 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		if initCtrType == "" {
 			initCtrType = define.OneShotInitContainer
 		}
+		restartPolicy := define.RestartPolicyNo
+		if isSidecar {
+			initCtrType = ""
+			restartPolicy = define.RestartPolicyAlways
+		}
 
 		automountImages, err := ic.prepareAutomountImages(ctx, initCtr.Name, annotations)
 		if err != nil {
@@ -1057,10 +1071,11 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			PodName:                podName,
 			PodSecurityContext:     podYAML.Spec.SecurityContext,
 			ReadOnly:               readOnly,
-			RestartPolicy:          define.RestartPolicyNo,
+			RestartPolicy:          restartPolicy,
 			SeccompAnnotationPaths: seccompAnnotationPaths,
 			SeccompProfileRoot:     options.SeccompProfileRoot,
 			SecretsManager:         secretsManager,
+			Sidecar:                isSidecar,
 			UserNSIsHost:           p.Userns.IsHost(),
 			Volumes:                volumes,
 			VolumesFrom:            volumesFrom,
@@ -1421,7 +1436,7 @@ func (ic *ContainerEngine) getImageAndLabelInfo(ctx context.Context, cwd string,
 }
 
 // playKubePVC creates a podman volume from a kube persistent volume claim.
-func (ic *ContainerEngine) playKubePVC(ctx context.Context, mountLabel string, pvcYAML *v1.PersistentVolumeClaim) (*entities.PlayKubeReport, error) {
+func (ic *ContainerEngine) playKubePVC(ctx context.Context, tx *libpod.Transaction, mountLabel string, pvcYAML *v1.PersistentVolumeClaim) (*entities.PlayKubeReport, error) {
 	var report entities.PlayKubeReport
 	opts := make(map[string]string)
 
@@ -1492,6 +1507,12 @@ func (ic *ContainerEngine) playKubePVC(ctx context.Context, mountLabel string, p
 		defer tarFile.Close()
 	}
 
+	// An existing volume is reused, and must be kept if the play fails.
+	existed, err := ic.Libpod.HasVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create volume.
 	vol, err := ic.Libpod.NewVolume(ctx, volOptions...)
 	if err != nil {
@@ -1508,6 +1529,9 @@ func (ic *ContainerEngine) playKubePVC(ctx context.Context, mountLabel string, p
 			return nil, err
 		}
 	}
+	if !existed {
+		tx.AddVolume(vol)
+	}
 
 	report.Volumes = append(report.Volumes, entitiesTypes.PlayKubeVolume{
 		Name: vol.Name(),
@@ -1918,7 +1942,7 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 }
 
 // playKubeSecret allows users to create and store a kubernetes secret as a podman secret
-func (ic *ContainerEngine) playKubeSecret(secret *v1.Secret) (*entities.SecretCreateReport, error) {
+func (ic *ContainerEngine) playKubeSecret(tx *libpod.Transaction, secret *v1.Secret) (*entities.SecretCreateReport, error) {
 	r := &entities.SecretCreateReport{}
 
 	// Create the secret manager before hand
@@ -1946,10 +1970,17 @@ func (ic *ContainerEngine) playKubeSecret(secret *v1.Secret) (*entities.SecretCr
 				return nil, fmt.Errorf("cannot remove colliding secret as it is set to immutable")
 			}
 		}
+		// Keep the contents of the colliding secret to restore it if
+		// playing the YAML fails
+		_, oldData, err := secretsManager.LookupSecretData(s.ID)
+		if err != nil {
+			return nil, err
+		}
 		_, err = secretsManager.Delete(s.Name)
 		if err != nil {
 			return nil, err
 		}
+		tx.AddRemovedSecret(s, oldData)
 	}
 
 	// now we have either removed the old secret w/ the same name or
@@ -1969,6 +2000,7 @@ func (ic *ContainerEngine) playKubeSecret(secret *v1.Secret) (*entities.SecretCr
 	if err != nil {
 		return nil, err
 	}
+	tx.AddSecret(secretID)
 
 	r.ID = secretID
 
@@ -0,0 +1,55 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/domain/filters"
+)
+
+func (ic *ContainerEngine) PluginInspect(_ context.Context, names []string) ([]*entities.PluginReport, []error, error) {
+	var (
+		reports = make([]*entities.PluginReport, 0, len(names))
+		errs    = []error{}
+	)
+	for _, name := range names {
+		info, err := ic.Libpod.VolumePlugin(name)
+		if err != nil {
+			if errors.Is(err, define.ErrMissingPlugin) {
+				errs = append(errs, fmt.Errorf("no such plugin %q", name))
+				continue
+			}
+			return nil, nil, err
+		}
+		reports = append(reports, &entities.PluginReport{VolumePluginInfo: *info})
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) PluginList(_ context.Context, opts entities.PluginListOptions) ([]*entities.PluginReport, error) {
+	pluginFilters := make([]filters.PluginFilter, 0, len(opts.Filter))
+	for filter, value := range opts.Filter {
+		filterFunc, err := filters.GeneratePluginFilters(filter, value)
+		if err != nil {
+			return nil, err
+		}
+		pluginFilters = append(pluginFilters, filterFunc)
+	}
+
+	reports := []*entities.PluginReport{}
+outer:
+	for _, info := range ic.Libpod.VolumePlugins() {
+		for _, filterFunc := range pluginFilters {
+			if !filterFunc(info) {
+				continue outer
+			}
+		}
+		reports = append(reports, &entities.PluginReport{VolumePluginInfo: *info})
+	}
+	return reports, nil
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.podman.io/podman/v6/libpod"
 	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/checkpoint"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 	dfilters "go.podman.io/podman/v6/pkg/domain/filters"
 	"go.podman.io/podman/v6/pkg/signal"
 	"go.podman.io/podman/v6/pkg/specgen"
 	"go.podman.io/podman/v6/pkg/specgen/generate"
+	"go.podman.io/podman/v6/pkg/specgenutil"
 )
 
 // getPodsByContext returns a slice of pods. Note that all, latest and pods are
@@ -189,6 +191,30 @@ func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string,
 	return reports, nil
 }
 
+// PodUpdate finds and updates the given pod's cgroup config, restart policy and labels with the specified options
+func (ic *ContainerEngine) PodUpdate(ctx context.Context, updateOptions *entities.PodUpdateOptions) (string, error) {
+	pods, err := getPodsByContext(false, updateOptions.Latest, []string{updateOptions.NameOrID}, ic.Libpod)
+	if err != nil {
+		return "", err
+	}
+	if len(pods) != 1 {
+		return "", fmt.Errorf("pod not found")
+	}
+	pod := pods[0]
+
+	if updateOptions.Resources != nil && updateOptions.DevicesLimits != nil {
+		updateOptions.Resources, err = specgenutil.UpdateMajorAndMinorNumbers(updateOptions.Resources, updateOptions.DevicesLimits)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := pod.Update(ctx, updateOptions); err != nil {
+		return "", err
+	}
+	return pod.ID(), nil
+}
+
 func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, options entities.PodStopOptions) ([]*entities.PodStopReport, error) {
 	reports := []*entities.PodStopReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
@@ -274,6 +300,140 @@ func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
+func (ic *ContainerEngine) PodCheckpoint(ctx context.Context, namesOrIds []string, options entities.PodCheckpointOptions) ([]*entities.PodCheckpointReport, error) {
+	checkOpts := libpod.ContainerCheckpointOptions{
+		Keep:           options.Keep,
+		KeepRunning:    options.LeaveRunning,
+		TCPEstablished: options.TCPEstablished,
+		IgnoreRootfs:   options.IgnoreRootFS,
+		IgnoreVolumes:  options.IgnoreVolumes,
+		Compression:    options.Compression,
+		FileLocks:      options.FileLocks,
+	}
+	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	if options.Export != "" && len(pods) != 1 {
+		return nil, fmt.Errorf("--export can only be used with a single pod: %w", define.ErrInvalidArg)
+	}
+
+	reports := make([]*entities.PodCheckpointReport, 0, len(pods))
+	for _, p := range pods {
+		report := entities.PodCheckpointReport{
+			Id:       p.ID(),
+			RawInput: p.Name(),
+		}
+		var errs map[string]error
+		if options.Export != "" {
+			errs, err = checkpoint.CRExportPodCheckpoint(ctx, ic.Libpod, p, checkOpts, options.Export)
+		} else {
+			errs, err = p.Checkpoint(ctx, checkOpts, "")
+		}
+		if err != nil && !errors.Is(err, define.ErrPodPartialFail) {
+			report.Errs = []error{err}
+			reports = append(reports, &report)
+			continue
+		}
+		for id, v := range errs {
+			report.Errs = append(report.Errs, fmt.Errorf("checkpointing container %s: %w", id, v))
+		}
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) PodRestore(ctx context.Context, namesOrIds []string, options entities.PodRestoreOptions) ([]*entities.PodRestoreReport, error) {
+	restoreOpts := libpod.ContainerCheckpointOptions{
+		Keep:            options.Keep,
+		TCPEstablished:  options.TCPEstablished,
+		TCPClose:        options.TCPClose,
+		IgnoreRootfs:    options.IgnoreRootFS,
+		IgnoreVolumes:   options.IgnoreVolumes,
+		IgnoreStaticIP:  options.IgnoreStaticIP,
+		IgnoreStaticMAC: options.IgnoreStaticMAC,
+		FileLocks:       options.FileLocks,
+	}
+
+	if options.Import != "" {
+		pod, ctrs, err := checkpoint.CRImportPodCheckpoint(ctx, ic.Libpod, options)
+		if err != nil {
+			return nil, err
+		}
+		report := entities.PodRestoreReport{
+			Id:       pod.ID(),
+			RawInput: pod.Name(),
+		}
+		restoreOpts.Pod = pod.ID()
+		for _, c := range ctrs {
+			if _, _, err := c.Restore(ctx, restoreOpts); err != nil {
+				report.Errs = append(report.Errs, fmt.Errorf("restoring container %s: %w", c.ID(), err))
+				break
+			}
+		}
+		// Do not leave a partially restored pod behind, so the import
+		// can be retried
+		if len(report.Errs) > 0 {
+			timeout := uint(0)
+			if _, err := ic.Libpod.RemovePod(ctx, pod, true, true, &timeout); err != nil {
+				report.Errs = append(report.Errs, fmt.Errorf("removing pod %s after failed restore: %w", pod.ID(), err))
+			}
+		}
+		return []*entities.PodRestoreReport{&report}, nil
+	}
+
+	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*entities.PodRestoreReport, 0, len(pods))
+	for _, p := range pods {
+		report := entities.PodRestoreReport{
+			Id:       p.ID(),
+			RawInput: p.Name(),
+		}
+		errs, err := p.Restore(ctx, restoreOpts)
+		if err != nil && !errors.Is(err, define.ErrPodPartialFail) {
+			report.Errs = []error{err}
+			reports = append(reports, &report)
+			continue
+		}
+		for id, v := range errs {
+			report.Errs = append(report.Errs, fmt.Errorf("restoring container %s: %w", id, v))
+		}
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) PodWait(ctx context.Context, namesOrIds []string, options entities.PodWaitOptions) ([]*entities.PodWaitReport, error) {
+	waitOptions := libpod.PodWaitOptions{
+		Conditions: options.Conditions,
+		Interval:   options.Interval,
+		Any:        options.Any,
+	}
+	if options.ExitCodePropagation != "" {
+		ecp, err := define.ParseKubeExitCodePropagation(options.ExitCodePropagation)
+		if err != nil {
+			return nil, err
+		}
+		waitOptions.ExitCodePropagation = ecp
+	}
+
+	pods, err := getPodsByContext(false, options.Latest, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.PodWaitReport, 0, len(pods))
+	for _, p := range pods {
+		report := entities.PodWaitReport{Id: p.ID()}
+		report.ExitCode, report.Err = p.Wait(ctx, waitOptions)
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
 func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, options entities.PodRmOptions) ([]*entities.PodRmReport, error) {
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
 	if err != nil && (!options.Ignore || !errors.Is(err, define.ErrNoSuchPod)) {
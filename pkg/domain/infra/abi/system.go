@@ -12,7 +12,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"go.podman.io/podman/v6/libpod/define"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 	"go.podman.io/podman/v6/pkg/domain/entities/reports"
@@ -183,7 +185,15 @@ func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.Sys
 	return systemPruneReport, nil
 }
 
-func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+	var groups *dfGroups
+	if options.GroupBy != "" {
+		var err error
+		if groups, err = newDfGroups(ic.Libpod, options); err != nil {
+			return nil, err
+		}
+	}
+
 	dfImages := []*entities.SystemDfImageReport{}
 
 	imageStats, totalImageSize, err := ic.Libpod.LibimageRuntime().DiskUsage(ctx)
@@ -234,18 +244,44 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOpti
 			}
 			return nil, fmt.Errorf("failed to get read/write size of container %s: %w", c.ID(), err)
 		}
+		logSize, err := c.LogSize()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get log size of container %s: %w", c.ID(), err)
+		}
+		checkpointSize, err := c.CheckpointSize()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get checkpoint size of container %s: %w", c.ID(), err)
+		}
+		healthCheckLogSize, err := c.HealthCheckLogSize()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get healthcheck log size of container %s: %w", c.ID(), err)
+		}
 		report := entities.SystemDfContainerReport{
-			ContainerID:  c.ID(),
-			Image:        iid,
-			Command:      c.Command(),
-			LocalVolumes: len(c.UserVolumes()),
-			RWSize:       rwsize,
-			Size:         conSize,
-			Created:      c.CreatedTime(),
-			Status:       state.String(),
-			Names:        c.Name(),
+			ContainerID:        c.ID(),
+			Image:              iid,
+			Command:            c.Command(),
+			LocalVolumes:       len(c.UserVolumes()),
+			RWSize:             rwsize,
+			Size:               conSize,
+			Created:            c.CreatedTime(),
+			Status:             state.String(),
+			Names:              c.Name(),
+			LogSize:            logSize,
+			CheckpointSize:     checkpointSize,
+			HealthCheckLogSize: healthCheckLogSize,
 		}
 		dfContainers = append(dfContainers, &report)
+		if groups != nil {
+			if err := groups.addContainer(c, &report); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Get volumes and iterate over them
@@ -297,14 +333,21 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOpti
 			ReclaimableSize: reclaimableSize,
 		}
 		dfVolumes = append(dfVolumes, &report)
+		if groups != nil {
+			groups.addVolume(volSize, inUse)
+		}
 	}
 
-	return &entities.SystemDfReport{
+	report := &entities.SystemDfReport{
 		ImagesSize: totalImageSize,
 		Images:     dfImages,
 		Containers: dfContainers,
 		Volumes:    dfVolumes,
-	}, nil
+	}
+	if groups != nil {
+		report.Groups = groups.reports()
+	}
+	return report, nil
 }
 
 func (ic *ContainerEngine) Reset(ctx context.Context) error {
@@ -351,14 +394,33 @@ func (ic *ContainerEngine) Version(_ context.Context) (*entities.SystemVersionRe
 	return &report, err
 }
 
-func (ic *ContainerEngine) Locks(_ context.Context) (*entities.LocksReport, error) {
+func (ic *ContainerEngine) Locks(_ context.Context, options entities.LocksOptions) (*entities.LocksReport, error) {
 	var report entities.LocksReport
+	if options.RecordHolders != nil {
+		if err := ic.Libpod.RecordLockHolders(*options.RecordHolders); err != nil {
+			return nil, err
+		}
+	}
+	report.RecordingHolders = ic.Libpod.RecordsLockHolders()
+	if options.Break {
+		broken, err := ic.Libpod.BreakLocks()
+		if err != nil {
+			return nil, err
+		}
+		report.Broken = broken
+	}
 	conflicts, held, err := ic.Libpod.LockConflicts()
 	if err != nil {
 		return nil, err
 	}
 	report.LockConflicts = conflicts
 	report.LocksHeld = held
+	holders, cycles, err := ic.Libpod.LockHolders()
+	if err != nil {
+		return nil, err
+	}
+	report.Holders = holders
+	report.Cycles = cycles
 	return &report, nil
 }
 
@@ -369,3 +431,50 @@ func (ic *ContainerEngine) SystemCheck(ctx context.Context, options entities.Sys
 	}
 	return &report, nil
 }
+
+func (ic *ContainerEngine) SystemBackup(ctx context.Context, options entities.SystemBackupOptions) error {
+	return ic.Libpod.SystemBackup(ctx, options)
+}
+
+func (ic *ContainerEngine) SystemRestore(ctx context.Context, options entities.SystemRestoreOptions) (*entities.SystemRestoreReport, error) {
+	return ic.Libpod.SystemRestore(ctx, options)
+}
+
+// SystemMonitor samples the usage of the host and of the engine every
+// interval until the context is cancelled, or once if not streaming.
+func (ic *ContainerEngine) SystemMonitor(ctx context.Context, options entities.SystemMonitorOptions) (chan entities.SystemMonitorReport, error) {
+	if options.Interval < 1 {
+		return nil, errors.New("invalid interval, must be a positive number greater zero")
+	}
+	reportChan := make(chan entities.SystemMonitorReport, 1)
+
+	go func() {
+		defer close(reportChan)
+		previous := make(map[string]*define.ContainerStats)
+		ticker := time.NewTicker(time.Second * time.Duration(options.Interval))
+		defer ticker.Stop()
+
+		for {
+			report := entities.SystemMonitorReport{}
+			report.Usage, report.Error = ic.Libpod.SystemUsage(previous)
+			select {
+			case reportChan <- report:
+			case <-ctx.Done():
+				logrus.Debugf("System monitor stopped: context cancelled")
+				return
+			}
+			if report.Error != nil || !options.Stream {
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				logrus.Debugf("System monitor stopped: context cancelled")
+				return
+			}
+		}
+	}()
+
+	return reportChan, nil
+}
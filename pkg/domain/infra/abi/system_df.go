@@ -0,0 +1,196 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.podman.io/podman/v6/libpod"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/storage"
+)
+
+// composeProjectLabel is set on containers by docker-compose and podman-compose
+// to the name of the project they are part of.
+const composeProjectLabel = "com.docker.compose.project"
+
+// dfGroups attributes the disk usage of containers, and of the image layers
+// and volumes they share, to groups of containers.
+type dfGroups struct {
+	runtime   *libpod.Runtime
+	groupBy   string
+	exclusive bool
+	// groups by name, and the name of the group of each container by ID
+	groups  map[string]*entities.SystemDfGroupReport
+	groupOf map[string]string
+	// podGroups caches the group of the containers of a pod by pod ID
+	podGroups map[string]string
+	// imageLayers caches the layer sizes of images by image ID
+	imageLayers map[string]map[string]int64
+	layerSizes  map[string]int64
+	layerUsers  map[string][]string
+}
+
+func newDfGroups(runtime *libpod.Runtime, options entities.SystemDfOptions) (*dfGroups, error) {
+	switch {
+	case options.GroupBy == "pod", options.GroupBy == "project":
+	case strings.HasPrefix(options.GroupBy, "label=") && len(options.GroupBy) > len("label="):
+	default:
+		return nil, fmt.Errorf("invalid group %q, must be pod, project or label=KEY: %w", options.GroupBy, define.ErrInvalidArg)
+	}
+	return &dfGroups{
+		runtime:     runtime,
+		groupBy:     options.GroupBy,
+		exclusive:   options.Exclusive,
+		groups:      make(map[string]*entities.SystemDfGroupReport),
+		groupOf:     make(map[string]string),
+		podGroups:   make(map[string]string),
+		imageLayers: make(map[string]map[string]int64),
+		layerSizes:  make(map[string]int64),
+		layerUsers:  make(map[string][]string),
+	}, nil
+}
+
+// group returns the name of the group the container is part of.
+func (g *dfGroups) group(c *libpod.Container) (string, error) {
+	switch g.groupBy {
+	case "pod":
+		return g.podGroup(c.PodID(), false)
+	case "project":
+		if project := c.Labels()[composeProjectLabel]; project != "" {
+			return project, nil
+		}
+		return g.podGroup(c.PodID(), true)
+	default:
+		return c.Labels()[strings.TrimPrefix(g.groupBy, "label=")], nil
+	}
+}
+
+// podGroup returns the name of the group the containers of a pod are part of:
+// the name of the pod, or the name of its service container when grouping by
+// project, as the pods created from a Kubernetes YAML share it.
+func (g *dfGroups) podGroup(podID string, service bool) (string, error) {
+	if podID == "" {
+		return "", nil
+	}
+	if group, ok := g.podGroups[podID]; ok {
+		return group, nil
+	}
+	pod, err := g.runtime.LookupPod(podID)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchPod) {
+			return "", nil
+		}
+		return "", err
+	}
+	group := pod.Name()
+	if service {
+		group = ""
+		ctr, err := pod.ServiceContainer()
+		if err == nil {
+			group = ctr.Name()
+		} else if !errors.Is(err, define.ErrNoSuchCtr) {
+			return "", err
+		}
+	}
+	g.podGroups[podID] = group
+	return group, nil
+}
+
+// addContainer attributes the container's own disk usage to its group, and
+// records it as a user of the layers of its image.
+func (g *dfGroups) addContainer(c *libpod.Container, report *entities.SystemDfContainerReport) error {
+	group, err := g.group(c)
+	if err != nil {
+		return fmt.Errorf("getting group of container %s: %w", c.ID(), err)
+	}
+	g.groupOf[c.ID()] = group
+	groupReport, ok := g.groups[group]
+	if !ok {
+		groupReport = &entities.SystemDfGroupReport{Group: group}
+		g.groups[group] = groupReport
+	}
+	groupReport.Containers++
+	groupReport.RWSize += report.RWSize
+	groupReport.LogSize += report.LogSize
+	groupReport.CheckpointSize += report.CheckpointSize
+	groupReport.HealthCheckLogSize += report.HealthCheckLogSize
+
+	if report.Image == "" {
+		return nil
+	}
+	layers, ok := g.imageLayers[report.Image]
+	if !ok {
+		layers, err = g.runtime.ImageLayerSizes(report.Image)
+		if err != nil && !errors.Is(err, storage.ErrImageUnknown) {
+			return fmt.Errorf("getting layers of image %s: %w", report.Image, err)
+		}
+		g.imageLayers[report.Image] = layers
+	}
+	for id, size := range layers {
+		g.layerSizes[id] = size
+		g.layerUsers[id] = append(g.layerUsers[id], c.ID())
+	}
+	return nil
+}
+
+// addVolume attributes the size of a volume to the groups of the containers
+// using it.  All containers must have been added before.
+func (g *dfGroups) addVolume(size int64, users []string) {
+	g.attribute(size, users, func(r *entities.SystemDfGroupReport, size int64) {
+		r.VolumesSize += size
+	})
+}
+
+// attribute divides size between the groups of the given containers in
+// proportion to the number of them in each group, or attributes all of it to
+// their group when exclusive attribution is requested and they are all part
+// of the same one.
+func (g *dfGroups) attribute(size int64, users []string, add func(*entities.SystemDfGroupReport, int64)) {
+	counts := make(map[string]int64)
+	var total int64
+	for _, id := range users {
+		group, ok := g.groupOf[id]
+		if !ok {
+			continue
+		}
+		counts[group]++
+		total++
+	}
+	if total == 0 {
+		return
+	}
+	if g.exclusive {
+		if len(counts) == 1 {
+			for group := range counts {
+				add(g.groups[group], size)
+			}
+		}
+		return
+	}
+	for group, n := range counts {
+		add(g.groups[group], size*n/total)
+	}
+}
+
+// reports returns the disk usage of all groups, sorted by name.
+func (g *dfGroups) reports() []*entities.SystemDfGroupReport {
+	for id, size := range g.layerSizes {
+		g.attribute(size, g.layerUsers[id], func(r *entities.SystemDfGroupReport, size int64) {
+			r.ImagesSize += size
+		})
+	}
+	reports := make([]*entities.SystemDfGroupReport, 0, len(g.groups))
+	for _, r := range g.groups {
+		r.TotalSize = r.ImagesSize + r.RWSize + r.VolumesSize + r.LogSize + r.CheckpointSize + r.HealthCheckLogSize
+		reports = append(reports, r)
+	}
+	slices.SortFunc(reports, func(a, b *entities.SystemDfGroupReport) int {
+		return strings.Compare(a.Group, b.Group)
+	})
+	return reports
+}
@@ -0,0 +1,46 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+func TestDfGroupsAttribute(t *testing.T) {
+	tests := []struct {
+		name      string
+		exclusive bool
+		users     []string
+		expected  map[string]int64
+	}{
+		{"proportional", false, []string{"a1", "a2", "b1", "unknown"}, map[string]int64{"a": 600, "b": 300}},
+		{"proportional single group", false, []string{"a1"}, map[string]int64{"a": 900, "b": 0}},
+		{"exclusive shared", true, []string{"a1", "b1"}, map[string]int64{"a": 0, "b": 0}},
+		{"exclusive single group", true, []string{"a1", "a2"}, map[string]int64{"a": 900, "b": 0}},
+		{"no users", false, nil, map[string]int64{"a": 0, "b": 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := newDfGroups(nil, entities.SystemDfOptions{GroupBy: "pod", Exclusive: tt.exclusive})
+			assert.NoError(t, err)
+			for id, group := range map[string]string{"a1": "a", "a2": "a", "b1": "b"} {
+				groups.groupOf[id] = group
+				groups.groups[group] = &entities.SystemDfGroupReport{Group: group}
+			}
+			groups.addVolume(900, tt.users)
+			for group, size := range tt.expected {
+				assert.Equal(t, size, groups.groups[group].VolumesSize, group)
+			}
+		})
+	}
+}
+
+func TestNewDfGroupsInvalid(t *testing.T) {
+	for _, groupBy := range []string{"label=", "label", "image"} {
+		_, err := newDfGroups(nil, entities.SystemDfOptions{GroupBy: groupBy})
+		assert.Error(t, err, groupBy)
+	}
+}
@@ -0,0 +1,353 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/libimage"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/domain/entities/reports"
+)
+
+// Reasons for removals by SystemGC.
+const (
+	gcReasonContainerTTL = "container-ttl"
+	gcReasonImageUnused  = "image-unused"
+	gcReasonDiskUsage    = "disk-usage"
+	gcReasonBuildCache   = "build-cache"
+)
+
+// gcImage is an image which SystemGC may remove.
+type gcImage struct {
+	image    *libimage.Image
+	lastUsed time.Time
+	size     int64
+}
+
+// SystemGC removes the containers and images selected by the policy in
+// options, in this order: exited containers past their TTL, the build
+// cache, images unused for too long and, while the file system holding the
+// graph root is fuller than allowed, the least recently used remaining images.
+func (ic *ContainerEngine) SystemGC(ctx context.Context, options entities.SystemGCOptions) (*entities.SystemGCReport, error) {
+	if options.MaxDiskUsage < 0 || options.MaxDiskUsage > 100 {
+		return nil, fmt.Errorf("maximum disk usage must be between 0 and 100 percent: %w", define.ErrInvalidArg)
+	}
+	for _, pattern := range options.ProtectedImages {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid protected image pattern %q: %w", pattern, define.ErrInvalidArg)
+		}
+	}
+	if options.KeepLabel == "" {
+		options.KeepLabel = define.GCKeepLabel
+	}
+	// The last use of an image is only known while the tombstone of the
+	// last container using it is kept, so a longer time cannot be told
+	// apart from the image not having been used since it was stored.
+	if count, age := ic.Libpod.TombstoneRetention(); options.ImageUnusedFor > 0 {
+		switch {
+		case count == 0:
+			logrus.Warnf("Tombstones of removed containers are disabled, so images are considered last used when they were pulled or built")
+		case age > 0 && options.ImageUnusedFor > age:
+			return nil, fmt.Errorf("image unused time %s is longer than tombstones of removed containers are kept for (%s), which record when images were last used: %w", options.ImageUnusedFor, age, define.ErrInvalidArg)
+		}
+	}
+
+	report := &entities.SystemGCReport{DryRun: options.DryRun}
+	var err error
+	if report.StorageBefore, err = ic.Libpod.StorageUsage(); err != nil {
+		return nil, err
+	}
+
+	if options.ContainerTTL > 0 {
+		if err := ic.gcContainers(ctx, options, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.BuildCache {
+		var buildReports []*reports.PruneReport
+		if options.DryRun {
+			buildReports, err = ic.Libpod.BuildContainers()
+		} else {
+			buildReports, err = ic.Libpod.PruneBuildContainers()
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range buildReports {
+			removal := &entities.SystemGCRemoval{
+				Type:   "build-container",
+				ID:     r.Id,
+				Reason: gcReasonBuildCache,
+				Size:   r.Size,
+			}
+			ic.gcRemoved(report, removal, r.Err)
+		}
+	}
+
+	if options.ImageUnusedFor > 0 || options.MaxDiskUsage > 0 {
+		if err := ic.gcImages(ctx, options, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if !options.DryRun {
+		if report.StorageAfter, err = ic.Libpod.StorageUsage(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// gcRemoved records a removal in the report, and writes an event for it
+// unless it failed or was not actually done.
+func (ic *ContainerEngine) gcRemoved(report *entities.SystemGCReport, removal *entities.SystemGCRemoval, err error) {
+	if err != nil {
+		removal.Error = err.Error()
+	} else {
+		report.ReclaimedSpace += removal.Size
+		if !report.DryRun {
+			ic.Libpod.NewGarbageCollectEvent(removal.Type, removal.ID, removal.Name, removal.Reason)
+		}
+	}
+	report.Removed = append(report.Removed, removal)
+}
+
+// gcContainers removes the exited containers which exited longer than the
+// TTL ago.  Containers of pods and those carrying the keep label are kept.
+func (ic *ContainerEngine) gcContainers(ctx context.Context, options entities.SystemGCOptions, report *entities.SystemGCReport) error {
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, c := range ctrs {
+		if c.PodID() != "" || c.IsService() {
+			continue
+		}
+		if _, keep := c.Labels()[options.KeepLabel]; keep {
+			continue
+		}
+		state, err := c.State()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return err
+		}
+		if state != define.ContainerStateExited && state != define.ContainerStateStopped {
+			continue
+		}
+		finished, err := c.FinishedTime()
+		if err != nil {
+			return err
+		}
+		if now.Sub(finished) < options.ContainerTTL {
+			continue
+		}
+		size, err := c.RWSize()
+		if err != nil {
+			logrus.Debugf("Getting size of container %s: %v", c.ID(), err)
+		}
+		removal := &entities.SystemGCRemoval{
+			Type:   "container",
+			ID:     c.ID(),
+			Name:   c.Name(),
+			Reason: gcReasonContainerTTL,
+			Size:   uint64(max(size, 0)),
+		}
+		if !options.DryRun {
+			err = ic.Libpod.RemoveContainer(ctx, c, false, false, nil)
+		}
+		ic.gcRemoved(report, removal, err)
+	}
+	return nil
+}
+
+// gcImages removes the images which are not used by any container and have
+// not been used for longer than allowed, and then the least recently used
+// ones until the disk usage is below the maximum.  Protected and read-only
+// images, manifest lists and the parents of other images are kept.
+func (ic *ContainerEngine) gcImages(ctx context.Context, options entities.SystemGCOptions, report *entities.SystemGCReport) error {
+	candidates, err := ic.gcImageCandidates(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var remaining []*gcImage
+	for _, img := range candidates {
+		if options.ImageUnusedFor > 0 && now.Sub(img.lastUsed) >= options.ImageUnusedFor {
+			ic.gcRemoveImage(ctx, report, img, gcReasonImageUnused)
+		} else {
+			remaining = append(remaining, img)
+		}
+	}
+
+	if options.MaxDiskUsage == 0 {
+		return nil
+	}
+	usage := *report.StorageBefore
+	if usage.GraphRootUsed > report.ReclaimedSpace {
+		usage.GraphRootUsed -= report.ReclaimedSpace
+	}
+	slices.SortFunc(remaining, func(a, b *gcImage) int {
+		return a.lastUsed.Compare(b.lastUsed)
+	})
+	for _, img := range remaining {
+		if !options.DryRun {
+			current, err := ic.Libpod.StorageUsage()
+			if err != nil {
+				return err
+			}
+			usage = *current
+		}
+		if !exceedsDiskUsage(&usage, options.MaxDiskUsage) {
+			break
+		}
+		removal := ic.gcRemoveImage(ctx, report, img, gcReasonDiskUsage)
+		if removal.Error == "" && options.DryRun {
+			usage.GraphRootUsed -= min(removal.Size, usage.GraphRootUsed)
+		}
+	}
+	return nil
+}
+
+// gcImageCandidates returns the images gcImages may remove, along with the
+// time they were last used, which is when the last container using them was
+// removed.  That time is only known from the tombstones of the containers,
+// which are pruned over time, so images without one are considered last used
+// when they were pulled or built.
+func (ic *ContainerEngine) gcImageCandidates(ctx context.Context, options entities.SystemGCOptions) ([]*gcImage, error) {
+	lastUsed := make(map[string]time.Time)
+	tombstones, err := ic.Libpod.ContainerTombstones()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tombstones {
+		if t.Removed.After(lastUsed[t.ImageID]) {
+			lastUsed[t.ImageID] = t.Removed
+		}
+	}
+
+	sizes := make(map[string]int64)
+	usage, _, err := ic.Libpod.LibimageRuntime().DiskUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range usage {
+		sizes[u.ID] = u.UniqueSize
+	}
+
+	images, err := ic.Libpod.LibimageRuntime().ListImages(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*gcImage
+	for _, img := range images {
+		if img.IsReadOnly() || protectedImage(img.Names(), options.ProtectedImages) {
+			continue
+		}
+		if isManifestList, err := img.IsManifestList(ctx); err != nil || isManifestList {
+			continue
+		}
+		containers, err := img.Containers()
+		if err != nil || len(containers) > 0 {
+			continue
+		}
+		if hasChildren, err := img.HasChildren(ctx); err != nil || hasChildren {
+			continue
+		}
+		used, err := ic.Libpod.ImageStoredTime(img.ID())
+		if err != nil {
+			logrus.Debugf("Getting the time image %s was stored: %v", img.ID(), err)
+			continue
+		}
+		if removed := lastUsed[img.ID()]; removed.After(used) {
+			used = removed
+		}
+		candidates = append(candidates, &gcImage{image: img, lastUsed: used, size: sizes[img.ID()]})
+	}
+	return candidates, nil
+}
+
+// gcRemoveImage removes the image, unless doing a dry run, and records it in
+// the report along with the parent images removed with it.
+func (ic *ContainerEngine) gcRemoveImage(ctx context.Context, report *entities.SystemGCReport, img *gcImage, reason string) *entities.SystemGCRemoval {
+	removal := &entities.SystemGCRemoval{
+		Type:   "image",
+		ID:     img.image.ID(),
+		Reason: reason,
+		Size:   uint64(max(img.size, 0)),
+	}
+	if names := img.image.Names(); len(names) > 0 {
+		removal.Name = names[0]
+	}
+	if report.DryRun {
+		ic.gcRemoved(report, removal, nil)
+		return removal
+	}
+
+	// The image is not used by any container, so forcing the removal only
+	// removes all of its names at once.
+	rmReports, rmErrors := ic.Libpod.LibimageRuntime().RemoveImages(ctx, []string{img.image.ID()}, &libimage.RemoveImagesOptions{
+		Force:    true,
+		WithSize: true,
+	})
+	if len(rmErrors) > 0 {
+		ic.gcRemoved(report, removal, errors.Join(rmErrors...))
+		return removal
+	}
+	for _, r := range rmReports {
+		if !r.Removed {
+			continue
+		}
+		if r.ID == removal.ID {
+			removal.Size = uint64(max(r.Size, 0))
+			ic.gcRemoved(report, removal, nil)
+			continue
+		}
+		// A dangling parent image removed along with the image
+		ic.gcRemoved(report, &entities.SystemGCRemoval{
+			Type:   "image",
+			ID:     r.ID,
+			Reason: reason,
+			Size:   uint64(max(r.Size, 0)),
+		}, nil)
+	}
+	return removal
+}
+
+// protectedImage returns true if one of the names of an image matches one of
+// the patterns, as a whole or without its tag and digest.
+func protectedImage(names, patterns []string) bool {
+	for _, name := range names {
+		candidates := []string{name}
+		if named, err := reference.ParseNormalizedNamed(name); err == nil {
+			candidates = append(candidates, named.Name())
+		}
+		for _, pattern := range patterns {
+			for _, candidate := range candidates {
+				if matched, _ := filepath.Match(pattern, candidate); matched {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// exceedsDiskUsage returns true if more than maxPercent of the file system
+// holding the graph root is used.
+func exceedsDiskUsage(usage *define.StorageUsage, maxPercent int) bool {
+	return usage.GraphRootUsed*100 > usage.GraphRootAllocated*uint64(maxPercent)
+}
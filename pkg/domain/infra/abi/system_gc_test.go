@@ -0,0 +1,55 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/podman/v6/libpod/define"
+)
+
+func TestProtectedImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		patterns []string
+		expected bool
+	}{
+		{"no patterns", []string{"quay.io/libpod/alpine:latest"}, nil, false},
+		{"no names", nil, []string{"*"}, false},
+		{"full name", []string{"quay.io/libpod/alpine:latest"}, []string{"quay.io/libpod/alpine:latest"}, true},
+		{"name without tag", []string{"quay.io/libpod/alpine:latest"}, []string{"quay.io/libpod/alpine"}, true},
+		{"wildcard repository", []string{"quay.io/libpod/alpine:latest"}, []string{"quay.io/libpod/*"}, true},
+		{"wildcard tag", []string{"quay.io/libpod/alpine:3.10"}, []string{"quay.io/libpod/alpine:3.*"}, true},
+		{"other tag", []string{"quay.io/libpod/alpine:latest"}, []string{"quay.io/libpod/alpine:3.*"}, false},
+		{"other repository", []string{"quay.io/libpod/alpine:latest"}, []string{"docker.io/*"}, false},
+		{"short name", []string{"docker.io/library/busybox:latest"}, []string{"docker.io/library/busybox"}, true},
+		{"any name", []string{"localhost/a:1", "quay.io/libpod/alpine:latest"}, []string{"quay.io/*/*"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, protectedImage(tt.names, tt.patterns))
+		})
+	}
+}
+
+func TestExceedsDiskUsage(t *testing.T) {
+	tests := []struct {
+		used, allocated uint64
+		maxPercent      int
+		expected        bool
+	}{
+		{80, 100, 80, false},
+		{81, 100, 80, true},
+		{0, 100, 0, false},
+		{1, 100, 0, true},
+		{100, 100, 100, false},
+		{7999, 10000, 80, false},
+		{8001, 10000, 80, true},
+	}
+	for _, tt := range tests {
+		usage := &define.StorageUsage{GraphRootUsed: tt.used, GraphRootAllocated: tt.allocated}
+		assert.Equal(t, tt.expected, exceedsDiskUsage(usage, tt.maxPercent), "%d/%d at %d%%", tt.used, tt.allocated, tt.maxPercent)
+	}
+}
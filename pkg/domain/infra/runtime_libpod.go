@@ -127,6 +127,9 @@ func getRuntime(ctx context.Context, fs *flag.FlagSet, opts *engineOpts) (*libpo
 	if fs.Changed("transient-store") {
 		options = append(options, libpod.WithTransientStore(cfg.TransientStore))
 	}
+	if fs.Changed("tombstone-count") || fs.Changed("tombstone-max-age") {
+		options = append(options, libpod.WithTombstoneRetention(cfg.TombstoneCount, cfg.TombstoneMaxAge))
+	}
 
 	if opts.reset {
 		options = append(options, libpod.WithReset())
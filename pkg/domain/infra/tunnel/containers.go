@@ -885,6 +885,11 @@ func (ic *ContainerEngine) ContainerListExternal(_ context.Context) ([]entities.
 	return containers.List(ic.ClientCtx, options)
 }
 
+func (ic *ContainerEngine) ContainerListRemoved(_ context.Context, opts entities.ContainerListRemovedOptions) ([]entities.ContainerTombstone, error) {
+	options := new(containers.ListRemovedOptions).WithLast(opts.Last)
+	return containers.ListRemoved(ic.ClientCtx, options)
+}
+
 func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.ContainerRunOptions) (*entities.ContainerRunReport, error) {
 	if opts.Spec != nil && !reflect.ValueOf(opts.Spec).IsNil() && opts.Spec.RawImageName != "" {
 		// If this is a checkpoint image, restore it.
@@ -996,6 +1001,10 @@ func (ic *ContainerEngine) Diff(_ context.Context, namesOrIDs []string, opts ent
 	return &entities.DiffReport{Changes: changes}, err
 }
 
+func (ic *ContainerEngine) ContainerCheckStorageQuota(_ context.Context, _ string) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
 func (ic *ContainerEngine) ContainerCleanup(_ context.Context, _ []string, _ entities.ContainerCleanupOptions) ([]*entities.ContainerCleanupReport, error) {
 	return nil, errors.New("not implemented")
 }
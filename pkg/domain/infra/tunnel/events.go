@@ -6,33 +6,57 @@ import (
 	"strings"
 
 	"go.podman.io/podman/v6/libpod/events"
+	"go.podman.io/podman/v6/pkg/bindings/pods"
 	"go.podman.io/podman/v6/pkg/bindings/system"
 	"go.podman.io/podman/v6/pkg/domain/entities"
 )
 
 func (ic *ContainerEngine) Events(_ context.Context, opts entities.EventsOptions) error {
-	filters := make(map[string][]string)
-	if len(opts.Filter) > 0 {
-		for _, filter := range opts.Filter {
-			split := strings.Split(filter, "=")
-			if len(split) < 2 {
-				return fmt.Errorf("invalid filter %q", filter)
-			}
-			filters[split[0]] = append(filters[split[0]], strings.Join(split[1:], "="))
-		}
+	filters, err := eventFilters(opts.Filter)
+	if err != nil {
+		return err
 	}
 	binChan := make(chan entities.Event)
-	go func() {
-		for e := range binChan {
-			event, err := entities.ConvertToLibpodEvent(e)
-			if err != nil {
-				opts.EventChan <- events.ReadResult{Error: fmt.Errorf("converting event from server: %w", err)}
-				continue
-			}
-			opts.EventChan <- events.ReadResult{Event: event}
-		}
-		close(opts.EventChan)
-	}()
+	go forwardEvents(binChan, opts.EventChan)
 	options := new(system.EventsOptions).WithFilters(filters).WithSince(opts.Since).WithStream(opts.Stream).WithUntil(opts.Until)
 	return system.Events(ic.ClientCtx, binChan, nil, options)
 }
+
+func (ic *ContainerEngine) PodEvents(_ context.Context, nameOrID string, opts entities.PodEventsOptions) error {
+	filters, err := eventFilters(opts.Filter)
+	if err != nil {
+		return err
+	}
+	binChan := make(chan entities.Event)
+	go forwardEvents(binChan, opts.EventChan)
+	options := new(pods.EventsOptions).WithFilters(filters).WithSince(opts.Since).WithStream(opts.Stream).WithUntil(opts.Until)
+	return pods.Events(ic.ClientCtx, nameOrID, binChan, nil, options)
+}
+
+// eventFilters converts the key=value event filters into the map expected
+// by the bindings.
+func eventFilters(filter []string) (map[string][]string, error) {
+	filters := make(map[string][]string)
+	for _, f := range filter {
+		split := strings.Split(f, "=")
+		if len(split) < 2 {
+			return nil, fmt.Errorf("invalid filter %q", f)
+		}
+		filters[split[0]] = append(filters[split[0]], strings.Join(split[1:], "="))
+	}
+	return filters, nil
+}
+
+// forwardEvents converts the events received from the server and passes them
+// on to eventChan, which is closed once binChan is closed.
+func forwardEvents(binChan chan entities.Event, eventChan chan events.ReadResult) {
+	for e := range binChan {
+		event, err := entities.ConvertToLibpodEvent(e)
+		if err != nil {
+			eventChan <- events.ReadResult{Error: fmt.Errorf("converting event from server: %w", err)}
+			continue
+		}
+		eventChan <- events.ReadResult{Event: event}
+	}
+	close(eventChan)
+}
@@ -0,0 +1,39 @@
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.podman.io/podman/v6/pkg/bindings/plugins"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+	"go.podman.io/podman/v6/pkg/errorhandling"
+)
+
+func (ic *ContainerEngine) PluginInspect(_ context.Context, names []string) ([]*entities.PluginReport, []error, error) {
+	var (
+		reports = make([]*entities.PluginReport, 0, len(names))
+		errs    = []error{}
+	)
+	for _, name := range names {
+		data, err := plugins.Inspect(ic.ClientCtx, name, nil)
+		if err != nil {
+			var errModel *errorhandling.ErrorModel
+			if !errors.As(err, &errModel) {
+				return nil, nil, err
+			}
+			if errModel.ResponseCode == 404 {
+				errs = append(errs, fmt.Errorf("no such plugin %q", name))
+				continue
+			}
+			return nil, nil, err
+		}
+		reports = append(reports, data)
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) PluginList(_ context.Context, opts entities.PluginListOptions) ([]*entities.PluginReport, error) {
+	options := new(plugins.ListOptions).WithFilters(opts.Filter)
+	return plugins.List(ic.ClientCtx, options)
+}
@@ -100,6 +100,11 @@ func (ic *ContainerEngine) PodUnpause(_ context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
+// PodUpdate finds and updates the given pod's cgroup config, restart policy and labels with the specified options
+func (ic *ContainerEngine) PodUpdate(_ context.Context, updateOptions *entities.PodUpdateOptions) (string, error) {
+	return pods.Update(ic.ClientCtx, updateOptions)
+}
+
 func (ic *ContainerEngine) PodStop(_ context.Context, namesOrIds []string, opts entities.PodStopOptions) ([]*entities.PodStopReport, error) {
 	timeout := -1
 	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, opts.Ignore, namesOrIds)
@@ -170,6 +175,96 @@ func (ic *ContainerEngine) PodStart(_ context.Context, namesOrIds []string, opti
 	return reports, nil
 }
 
+func (ic *ContainerEngine) PodCheckpoint(_ context.Context, namesOrIds []string, opts entities.PodCheckpointOptions) ([]*entities.PodCheckpointReport, error) {
+	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, false, namesOrIds)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Export != "" && len(foundPods) != 1 {
+		return nil, fmt.Errorf("--export can only be used with a single pod: %w", define.ErrInvalidArg)
+	}
+	options := new(pods.CheckpointOptions)
+	options.WithExport(opts.Export)
+	options.WithFileLocks(opts.FileLocks)
+	options.WithIgnoreRootfs(opts.IgnoreRootFS)
+	options.WithIgnoreVolumes(opts.IgnoreVolumes)
+	options.WithKeep(opts.Keep)
+	options.WithLeaveRunning(opts.LeaveRunning)
+	options.WithTCPEstablished(opts.TCPEstablished)
+
+	reports := make([]*entities.PodCheckpointReport, 0, len(foundPods))
+	for _, p := range foundPods {
+		report, err := pods.Checkpoint(ic.ClientCtx, p.Id, options)
+		if err != nil {
+			report = &entities.PodCheckpointReport{Errs: []error{err}}
+		}
+		report.Id = p.Id
+		report.RawInput = p.Name
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) PodRestore(_ context.Context, namesOrIds []string, opts entities.PodRestoreOptions) ([]*entities.PodRestoreReport, error) {
+	options := new(pods.RestoreOptions)
+	options.WithFileLocks(opts.FileLocks)
+	options.WithIgnoreRootfs(opts.IgnoreRootFS)
+	options.WithIgnoreVolumes(opts.IgnoreVolumes)
+	options.WithIgnoreStaticIP(opts.IgnoreStaticIP)
+	options.WithIgnoreStaticMAC(opts.IgnoreStaticMAC)
+	options.WithKeep(opts.Keep)
+	options.WithName(opts.Name)
+	options.WithTCPEstablished(opts.TCPEstablished)
+	options.WithTCPClose(opts.TCPClose)
+	options.WithPublishPorts(opts.PublishPorts)
+
+	if opts.Import != "" {
+		options.WithImportArchive(opts.Import)
+		report, err := pods.Restore(ic.ClientCtx, "", options)
+		if err != nil {
+			return nil, err
+		}
+		return []*entities.PodRestoreReport{report}, nil
+	}
+
+	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, false, namesOrIds)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.PodRestoreReport, 0, len(foundPods))
+	for _, p := range foundPods {
+		report, err := pods.Restore(ic.ClientCtx, p.Id, options)
+		if err != nil {
+			report = &entities.PodRestoreReport{Errs: []error{err}}
+		}
+		report.Id = p.Id
+		report.RawInput = p.Name
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) PodWait(_ context.Context, namesOrIds []string, opts entities.PodWaitOptions) ([]*entities.PodWaitReport, error) {
+	foundPods, err := getPodsByContext(ic.ClientCtx, false, false, namesOrIds)
+	if err != nil {
+		return nil, err
+	}
+	options := new(pods.WaitOptions).WithConditions(opts.Conditions).WithInterval(opts.Interval.String()).WithAny(opts.Any)
+	if opts.ExitCodePropagation != "" {
+		options.WithExitCodePropagation(opts.ExitCodePropagation)
+	}
+	reports := make([]*entities.PodWaitReport, 0, len(foundPods))
+	for _, p := range foundPods {
+		report, err := pods.Wait(ic.ClientCtx, p.Id, options)
+		if err != nil {
+			report = &entities.PodWaitReport{ExitCode: -1, Err: err}
+		}
+		report.Id = p.Id
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
 func (ic *ContainerEngine) PodRm(_ context.Context, namesOrIds []string, opts entities.PodRmOptions) ([]*entities.PodRmReport, error) {
 	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, opts.Ignore, namesOrIds)
 	if err != nil {
@@ -32,6 +32,15 @@ func (ic *ContainerEngine) SystemCheck(_ context.Context, opts entities.SystemCh
 	return system.Check(ic.ClientCtx, options)
 }
 
+func (ic *ContainerEngine) SystemBackup(_ context.Context, opts entities.SystemBackupOptions) error {
+	options := new(system.BackupOptions).WithVolumes(opts.Volumes).WithImages(opts.Images).WithSecretData(opts.SecretData)
+	return system.Backup(ic.ClientCtx, opts.Output, options)
+}
+
+func (ic *ContainerEngine) SystemRestore(_ context.Context, opts entities.SystemRestoreOptions) (*entities.SystemRestoreReport, error) {
+	return system.Restore(ic.ClientCtx, opts.Input, nil)
+}
+
 func (ic *ContainerEngine) Migrate(_ context.Context, _ entities.SystemMigrateOptions) error {
 	return errors.New("runtime migration is not supported on remote clients")
 }
@@ -44,8 +53,29 @@ func (ic *ContainerEngine) Reset(_ context.Context) error {
 	return errors.New("system reset is not supported on remote clients")
 }
 
-func (ic *ContainerEngine) SystemDf(_ context.Context, _ entities.SystemDfOptions) (*entities.SystemDfReport, error) {
-	return system.DiskUsage(ic.ClientCtx, nil)
+func (ic *ContainerEngine) SystemDf(_ context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+	diskOptions := new(system.DiskOptions).WithExclusive(options.Exclusive)
+	if options.GroupBy != "" {
+		diskOptions.WithGroupBy(options.GroupBy)
+	}
+	return system.DiskUsage(ic.ClientCtx, diskOptions)
+}
+
+func (ic *ContainerEngine) SystemGC(_ context.Context, options entities.SystemGCOptions) (*entities.SystemGCReport, error) {
+	gcOptions := new(system.GCOptions).WithDryRun(options.DryRun).WithMaxDiskUsage(options.MaxDiskUsage).WithBuildCache(options.BuildCache)
+	if options.ContainerTTL > 0 {
+		gcOptions.WithContainerTTL(options.ContainerTTL.String())
+	}
+	if options.KeepLabel != "" {
+		gcOptions.WithKeepLabel(options.KeepLabel)
+	}
+	if options.ImageUnusedFor > 0 {
+		gcOptions.WithImageUnusedFor(options.ImageUnusedFor.String())
+	}
+	if len(options.ProtectedImages) > 0 {
+		gcOptions.WithProtectedImages(options.ProtectedImages)
+	}
+	return system.GC(ic.ClientCtx, gcOptions)
 }
 
 func (ic *ContainerEngine) Unshare(_ context.Context, _ []string, _ entities.SystemUnshareOptions) error {
@@ -56,6 +86,11 @@ func (ic *ContainerEngine) Version(_ context.Context) (*entities.SystemVersionRe
 	return system.Version(ic.ClientCtx, nil)
 }
 
-func (ic *ContainerEngine) Locks(_ context.Context) (*entities.LocksReport, error) {
+func (ic *ContainerEngine) Locks(_ context.Context, _ entities.LocksOptions) (*entities.LocksReport, error) {
 	return nil, errors.New("locks is not supported on remote clients")
 }
+
+func (ic *ContainerEngine) SystemMonitor(_ context.Context, opts entities.SystemMonitorOptions) (chan entities.SystemMonitorReport, error) {
+	options := new(system.MonitorOptions).WithInterval(opts.Interval).WithStream(opts.Stream)
+	return system.Monitor(ic.ClientCtx, options)
+}
@@ -1,8 +1,13 @@
 package define
 
 // ContainerSize holds the size of the container's root filesystem and top
-// read-write layer.
+// read-write layer, and its storage quota usage if it has one.
 type ContainerSize struct {
 	RootFsSize int64 `json:"rootFsSize"`
 	RwSize     int64 `json:"rwSize"`
+	// StorageQuota is the maximum size of the writable layer and the
+	// anonymous volumes, and StorageQuotaUsage their current size. The
+	// usage is unset if it could not be determined.
+	StorageQuota      uint64  `json:"storageQuota,omitempty"`
+	StorageQuotaUsage *uint64 `json:"storageQuotaUsage,omitempty"`
 }
@@ -265,6 +265,16 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 
 			size.RootFsSize = rootFsSize
 			size.RwSize = rwSize
+
+			if quota := c.StorageQuota(); quota > 0 {
+				size.StorageQuota = quota
+				usage, err := c.StorageQuotaUsage()
+				if err != nil {
+					logrus.Errorf("Getting storage quota usage for %q: %v", c.ID(), err)
+				} else {
+					size.StorageQuotaUsage = &usage
+				}
+			}
 		}
 
 		if opts.Pod && len(conConfig.Pod) > 0 {
@@ -83,6 +83,13 @@ func (s *SpecGenerator) Validate() error {
 	if s.ContainerStorageConfig.ShmSize != nil && (s.ContainerStorageConfig.IpcNS.IsHost() || s.ContainerStorageConfig.IpcNS.IsNone()) {
 		return fmt.Errorf("cannot set shmsize when running in the %s IPC Namespace", s.ContainerStorageConfig.IpcNS)
 	}
+	// a storage quota needs the writable layer of an image
+	if s.ContainerStorageConfig.StorageQuota != nil && len(s.ContainerStorageConfig.Rootfs) > 0 {
+		return exclusiveOptions("storage quota", "rootfs")
+	}
+	if len(s.ContainerStorageConfig.StorageQuotaAction) > 0 && s.ContainerStorageConfig.StorageQuota == nil {
+		return fmt.Errorf("storage quota action requires a storage quota: %w", ErrInvalidSpecConfig)
+	}
 
 	//
 	// ContainerSecurityConfig
@@ -568,6 +568,10 @@ func ConfigToSpec(rt *libpod.Runtime, specg *specgen.SpecGenerator, containerID
 	specg.HostDeviceList = conf.DeviceHostSrc
 	specg.ShmSize = &conf.ShmSize
 	specg.ShmSizeSystemd = &conf.ShmSizeSystemd
+	if conf.StorageQuota > 0 {
+		specg.StorageQuota = &conf.StorageQuota
+		specg.StorageQuotaAction = conf.StorageQuotaAction
+	}
 	specg.UseImageHostname = &conf.UseImageHostname
 	specg.UseImageHosts = &conf.UseImageHosts
 
@@ -258,6 +258,9 @@ func MakeContainer(ctx context.Context, rt *libpod.Runtime, s *specgen.SpecGener
 	if containerType := s.InitContainerType; len(containerType) > 0 {
 		options = append(options, libpod.WithInitCtrType(containerType))
 	}
+	if s.Sidecar {
+		options = append(options, libpod.WithSidecar())
+	}
 	if len(s.Name) > 0 {
 		logrus.Debugf("setting container name %s", s.Name)
 		options = append(options, libpod.WithName(s.Name))
@@ -550,6 +553,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	if len(s.ContainerStorageConfig.StorageOpts) > 0 {
 		options = append(options, libpod.WithStorageOpts(s.StorageOpts))
 	}
+	if s.StorageQuota != nil {
+		options = append(options, libpod.WithStorageQuota(*s.StorageQuota, s.StorageQuotaAction))
+	}
 	// If the user did not specify a workdir on the CLI, let's extract it
 	// from the image.
 	if s.WorkDir == "" && imageData != nil {
@@ -714,14 +720,27 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 
 	if len(s.DependencyContainers) > 0 {
 		deps := make([]*libpod.Container, 0, len(s.DependencyContainers))
-		for _, ctr := range s.DependencyContainers {
+		healthyDeps := []*libpod.Container{}
+		for _, dep := range s.DependencyContainers {
+			// A dependency may carry a condition, e.g. "db:healthy".
+			ctr, condition, _ := strings.Cut(dep, ":")
 			depCtr, err := rt.LookupContainer(ctr)
 			if err != nil {
 				return nil, fmt.Errorf("%q is not a valid container, cannot be used as a dependency: %w", ctr, err)
 			}
 			deps = append(deps, depCtr)
+			switch condition {
+			case "", "running":
+			case define.HealthCheckHealthy:
+				healthyDeps = append(healthyDeps, depCtr)
+			default:
+				return nil, fmt.Errorf("invalid condition %q for dependency %q, must be one of \"running\" or \"healthy\": %w", condition, ctr, define.ErrInvalidArg)
+			}
 		}
 		options = append(options, libpod.WithDependencyCtrs(deps))
+		if len(healthyDeps) > 0 {
+			options = append(options, libpod.WithHealthyDependencyCtrs(healthyDeps))
+		}
 	}
 	if s.PidFile != "" {
 		options = append(options, libpod.WithPidFile(s.PidFile))
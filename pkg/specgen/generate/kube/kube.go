@@ -197,6 +197,9 @@ type CtrSpecGenOptions struct {
 	// InitContainerType sets what type the init container is
 	// Note: When playing a kube yaml, the inti container type will be set to "always" only
 	InitContainerType string
+	// Sidecar indicates the container is a native sidecar, an init
+	// container with restartPolicy Always
+	Sidecar bool
 	// PodSecurityContext is the security context specified for the pod
 	PodSecurityContext *v1.PodSecurityContext
 	// TerminationGracePeriodSeconds is the grace period given to a container to stop before being forcefully killed
@@ -292,6 +295,7 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 	}
 
 	s.InitContainerType = opts.InitContainerType
+	s.Sidecar = opts.Sidecar
 
 	err = setupSecurityContext(s, opts.Container.SecurityContext, opts.PodSecurityContext, opts.SeccompProfileRoot, opts.SeccompAnnotationPaths, opts.Container.Name)
 	if err != nil {
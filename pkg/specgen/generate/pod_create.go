@@ -20,13 +20,17 @@ import (
 	"go.podman.io/podman/v6/pkg/specgenutil"
 )
 
-func MakePod(p *entities.PodSpec, rt *libpod.Runtime) (_ *libpod.Pod, finalErr error) {
-	var createdPod *libpod.Pod
+// MakePod creates a pod, and its infra container, from the spec.  The given
+// options are applied to the pod after the ones derived from the spec.
+func MakePod(p *entities.PodSpec, rt *libpod.Runtime, extraOptions ...libpod.PodCreateOption) (_ *libpod.Pod, finalErr error) {
+	tx := rt.NewTransaction()
 	defer func() {
-		if finalErr != nil && createdPod != nil {
-			if _, err := rt.RemovePod(context.Background(), createdPod, true, true, nil); err != nil {
-				logrus.Errorf("Removing pod: %v", err)
-			}
+		if finalErr == nil {
+			tx.Commit()
+			return
+		}
+		if err := tx.Rollback(context.Background()); err != nil {
+			logrus.Errorf("Removing pod after failed creation: %v", err)
 		}
 	}()
 	if err := p.PodSpecGen.Validate(); err != nil {
@@ -66,12 +70,14 @@ func MakePod(p *entities.PodSpec, rt *libpod.Runtime) (_ *libpod.Pod, finalErr e
 	if err != nil {
 		return nil, err
 	}
+	options = append(options, extraOptions...)
 
 	pod, err := rt.NewPod(context.Background(), p.PodSpecGen, options...)
 	if err != nil {
 		return nil, err
 	}
-	createdPod = pod
+	// Removing the pod removes its infra container as well
+	tx.AddPod(pod)
 
 	if !p.PodSpecGen.NoInfra && p.PodSpecGen.InfraContainerSpec != nil {
 		if p.PodSpecGen.InfraContainerSpec.Name == "" {
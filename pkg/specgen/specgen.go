@@ -194,6 +194,8 @@ type ContainerBasicConfig struct {
 	// DependencyContainers is an array of containers this container
 	// depends on. Dependency containers must be started before this
 	// container. Dependencies can be specified by name or full/partial ID.
+	// A dependency suffixed with ":healthy" must also be healthy before
+	// this container is started.
 	// Optional.
 	DependencyContainers []string `json:"dependencyContainers,omitempty"`
 	// PidFile is the file that saves container's PID.
@@ -207,6 +209,11 @@ type ContainerBasicConfig struct {
 	// and if so, what type: always or once.
 	// Optional.
 	InitContainerType string `json:"init_container_type"`
+	// Sidecar indicates that this container is a sidecar of its pod. It
+	// is started before and stopped after all other containers of the pod.
+	// Requires that the container is part of a pod.
+	// Optional.
+	Sidecar bool `json:"sidecar,omitempty"`
 	// Personality allows users to configure different execution domains.
 	// Execution domains tell Linux how to map signal numbers into signal actions.
 	// The execution domain system allows Linux to provide limited support
@@ -350,6 +357,15 @@ type ContainerStorageConfig struct {
 	// StorageOpts is the container's storage options
 	// Optional.
 	StorageOpts map[string]string `json:"storage_opts,omitempty"`
+	// StorageQuota is the maximum size in bytes of the writable layer and
+	// the anonymous volumes of the container.
+	// Optional.
+	StorageQuota *uint64 `json:"storage_quota,omitempty"`
+	// StorageQuotaAction is the action taken when the storage quota is
+	// exceeded and not enforced by project quotas: "pause" or "stop".
+	// Defaults to "stop".
+	// Optional.
+	StorageQuotaAction string `json:"storage_quota_action,omitempty"`
 	// RootfsPropagation is the rootfs propagation mode for the container.
 	// If not set, the default of rslave will be used.
 	// Optional.
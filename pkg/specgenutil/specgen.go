@@ -549,6 +549,23 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		}
 		s.StorageOpts = opts
 	}
+	if c.StorageQuota != "" {
+		val, err := units.RAMInBytes(c.StorageQuota)
+		if err != nil {
+			return fmt.Errorf("unable to translate --storage-quota: %w", err)
+		}
+		if val <= 0 {
+			return errors.New("--storage-quota must be greater than 0")
+		}
+		quota := uint64(val)
+		s.StorageQuota = &quota
+	}
+	if c.StorageQuotaAction != "" {
+		if err := define.ValidateStorageQuotaAction(c.StorageQuotaAction); err != nil {
+			return err
+		}
+		s.StorageQuotaAction = c.StorageQuotaAction
+	}
 	if len(s.WorkDir) == 0 {
 		s.WorkDir = c.Workdir
 	}
@@ -957,6 +974,9 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	if len(s.InitContainerType) == 0 || len(c.InitContainerType) != 0 {
 		s.InitContainerType = c.InitContainerType
 	}
+	if !s.Sidecar || c.Sidecar {
+		s.Sidecar = c.Sidecar
+	}
 
 	t := true
 	if s.Passwd == nil {
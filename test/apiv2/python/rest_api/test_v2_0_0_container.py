@@ -1,7 +1,11 @@
+import base64
+import hashlib
 import io
+import json
 import multiprocessing
 import queue
 import random
+import socket
 import subprocess
 import tarfile
 import threading
@@ -197,6 +201,95 @@ class ContainerTestCase(APITestCase):
             self.podman_url + f"/v1.40/containers/{payload['Id']}?force=true"
         )
 
+    def test_attach_websocket(self):
+        r = requests.post(
+            self.podman_url + "/v1.40/containers/create?name=wscontainer",
+            json={"Cmd": ["sh", "-c", "echo podman; echo error >&2"], "Image": "alpine:latest"},
+        )
+        self.assertEqual(r.status_code, 201, r.text)
+        container_id = r.json()["Id"]
+
+        r = requests.post(self.podman_url + f"/v1.40/containers/{container_id}/start")
+        self.assertEqual(r.status_code, 204, r.text)
+        r = requests.post(self.podman_url + f"/v1.40/containers/{container_id}/wait")
+        self.assertEqual(r.status_code, 200, r.text)
+
+        # plain requests are not upgraded
+        r = requests.get(self.podman_url + f"/v1.40/containers/{container_id}/attach/ws?logs=true&stream=false")
+        self.assertEqual(r.status_code, 400, r.text)
+
+        key = base64.b64encode(os.urandom(16)).decode()
+        with socket.create_connection(("localhost", 8080), timeout=10) as sock:
+            sock.sendall(
+                (
+                    f"GET /v1.40/containers/{container_id}/attach/ws?logs=true&stream=false HTTP/1.1\r\n"
+                    "Host: localhost\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n"
+                    f"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: {key}\r\n\r\n"
+                ).encode()
+            )
+            stream = sock.makefile("rb")
+            self.assertIn(b" 101 ", stream.readline())
+            headers = {}
+            while (line := stream.readline().strip()) != b"":
+                name, value = line.decode().split(":", 1)
+                headers[name.lower()] = value.strip()
+            accept = base64.b64encode(
+                hashlib.sha1((key + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11").encode()).digest()
+            ).decode()
+            self.assertEqual(headers["sec-websocket-accept"], accept)
+
+            # the output is sent raw, without the multiplexing headers
+            output = b""
+            while True:
+                opcode, length = stream.read(2)
+                payload = stream.read(length & 0x7F)
+                if opcode & 0x0F == 0x8:
+                    break
+                self.assertEqual(opcode & 0x0F, 0x2)
+                output += payload
+            self.assertEqual(output, b"podman\nerror\n")
+
+    def test_logs_event_stream(self):
+        r = requests.post(
+            self.podman_url + "/v1.40/containers/create?name=ssecontainer",
+            json={"Cmd": ["sh", "-c", "echo one; echo two >&2; echo three"], "Image": "alpine:latest"},
+        )
+        self.assertEqual(r.status_code, 201, r.text)
+        container_id = r.json()["Id"]
+
+        r = requests.post(self.podman_url + f"/v1.40/containers/{container_id}/start")
+        self.assertEqual(r.status_code, 204, r.text)
+        r = requests.post(self.podman_url + f"/v1.40/containers/{container_id}/wait")
+        self.assertEqual(r.status_code, 200, r.text)
+
+        def read_events(headers):
+            r = requests.get(
+                self.uri(f"/containers/{container_id}/logs?stdout=true&stderr=true"),
+                headers={"Accept": "text/event-stream", **headers},
+            )
+            self.assertEqual(r.status_code, 200, r.text)
+            self.assertTrue(r.headers["Content-Type"].startswith("text/event-stream"))
+            events = []
+            for block in r.text.split("\n\n"):
+                fields = dict(line.split(": ", 1) for line in block.splitlines() if ": " in line)
+                if "data" in fields:
+                    events.append(fields)
+            return events
+
+        events = read_events({})
+        self.assertEqual([e["event"] for e in events], ["stdout", "stderr", "stdout"])
+        self.assertEqual([json.loads(e["data"])["Msg"] for e in events], ["one", "two", "three"])
+
+        # reconnecting resumes after the last received line
+        events = read_events({"Last-Event-ID": events[0]["id"]})
+        self.assertEqual([json.loads(e["data"])["Msg"] for e in events], ["two", "three"])
+
+        r = requests.get(
+            self.uri(f"/containers/{container_id}/logs?stdout=true"),
+            headers={"Accept": "text/event-stream", "Last-Event-ID": "bogus"},
+        )
+        self.assertEqual(r.status_code, 400, r.text)
+
     def test_logs(self):
         r = requests.get(self.uri(self.resolve_container("/containers/{}/logs?stdout=true")))
         self.assertEqual(r.status_code, 200, r.text)
@@ -554,5 +647,39 @@ class ContainerCompatibleAPITestCase(APITestCase):
         finally:
             delete_named_network_ns(network_ns_name)
 
+    def test_create_idempotency_key(self):
+        key = f"retry-{random.getrandbits(64):x}"
+        spec = {"image": "alpine:latest", "command": ["top"]}
+
+        r = requests.post(self.uri("/containers/create"), json=spec, headers={"Idempotency-Key": key})
+        self.assertEqual(r.status_code, 201, r.text)
+        self.assertNotIn("Idempotent-Replayed", r.headers)
+        ctr_id = r.json()["Id"]
+
+        # A retry of the request returns the container already created
+        r = requests.post(self.uri("/containers/create"), json=spec, headers={"Idempotency-Key": key})
+        self.assertEqual(r.status_code, 201, r.text)
+        self.assertEqual(r.headers.get("Idempotent-Replayed"), "true")
+        self.assertEqual(r.json()["Id"], ctr_id)
+
+        # The key cannot be reused for a different request
+        r = requests.post(
+            self.uri("/containers/create"),
+            json={"image": "alpine:latest", "command": ["true"]},
+            headers={"Idempotency-Key": key},
+        )
+        self.assertEqual(r.status_code, 422, r.text)
+
+        r = requests.post(self.uri("/containers/create"), json=spec, headers={"Idempotency-Key": "not valid"})
+        self.assertEqual(r.status_code, 400, r.text)
+
+        r = requests.delete(self.uri(f"/containers/{ctr_id}"))
+        self.assertEqual(r.status_code, 200, r.text)
+
+        # Once the container is gone the key creates a new one
+        r = requests.post(self.uri("/containers/create"), json=spec, headers={"Idempotency-Key": key})
+        self.assertEqual(r.status_code, 201, r.text)
+        self.assertNotEqual(r.json()["Id"], ctr_id)
+
 if __name__ == "__main__":
     unittest.main()
@@ -60,6 +60,31 @@ class TestApi(APITestCase):
         start = r.json()
         self.assertGreater(len(start["Errs"]), 0, r.text)
 
+    def test_pod_create_idempotency_key(self):
+        key = f"retry-{random.getrandbits(64):x}"
+        spec = {"name": f"Pod_{random.getrandbits(160):x}", "no_infra": True}
+
+        r = requests.post(self.uri("/pods/create"), json=spec, headers={"Idempotency-Key": key})
+        self.assertEqual(r.status_code, 201, r.text)
+        pod_id = r.json()["Id"]
+
+        # A retry of the request returns the pod already created instead of
+        # failing on the name conflict
+        r = requests.post(self.uri("/pods/create"), json=spec, headers={"Idempotency-Key": key})
+        self.assertEqual(r.status_code, 201, r.text)
+        self.assertEqual(r.headers.get("Idempotent-Replayed"), "true")
+        self.assertEqual(r.json()["Id"], pod_id)
+
+        r = requests.post(
+            self.uri("/pods/create"),
+            json={"name": f"Pod_{random.getrandbits(160):x}", "no_infra": True},
+            headers={"Idempotency-Key": key},
+        )
+        self.assertEqual(r.status_code, 422, r.text)
+
+        r = requests.delete(self.uri(f"/pods/{pod_id}"))
+        self.assertEqual(r.status_code, 200, r.text)
+
 
 if __name__ == "__main__":
     unittest.main()
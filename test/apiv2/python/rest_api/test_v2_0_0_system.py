@@ -49,6 +49,38 @@ class SystemTestCase(APITestCase):
             if obj["Actor"].get("Attributes") and obj["Actor"]["Attributes"].get("image"):
                 self.assertEqual(obj["Actor"]["Attributes"]["image"], obj["from"])
 
+    def test_events_event_stream(self):
+        def read_events(headers):
+            r = requests.get(
+                self.uri("/events?stream=false"),
+                headers={"Accept": "text/event-stream", **headers},
+            )
+            self.assertEqual(r.status_code, 200, r.text)
+            self.assertTrue(r.headers["Content-Type"].startswith("text/event-stream"))
+            events = []
+            for block in r.text.split("\n\n"):
+                fields = dict(line.split(": ", 1) for line in block.splitlines() if ": " in line)
+                if "data" in fields:
+                    events.append(fields)
+            return events
+
+        events = read_events({})
+        self.assertGreater(len(events), 1, "No events found!")
+        for event in events:
+            obj = json.loads(event["data"])
+            self.assertEqual(event["event"], obj["Type"])
+
+        # reconnecting resumes after the last received event
+        resumed = read_events({"Last-Event-ID": events[0]["id"]})
+        self.assertLess(len(resumed), len(events))
+        self.assertNotIn(events[0]["id"], [e["id"] for e in resumed])
+
+        r = requests.get(
+            self.uri("/events?stream=false"),
+            headers={"Accept": "text/event-stream", "Last-Event-ID": "bogus"},
+        )
+        self.assertEqual(r.status_code, 400, r.text)
+
     def test_ping(self):
         required_headers = (
             "API-Version",
@@ -249,6 +249,64 @@ var _ = Describe("Podman container clone", func() {
 		Expect(clone).ToNot(ExitCleanly())
 	})
 
+	It("podman container clone --copy-changes", func() {
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "prepared", "-v", "/data", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("exec", "prepared", "sh", "-c", "echo hello > /file && rm /etc/alpine-release && echo vol > /data/file")
+		podmanTest.PodmanExitCleanly("stop", "-t0", "prepared")
+
+		podmanTest.PodmanExitCleanly("container", "clone", "--copy-changes", "--run", "prepared", "fork")
+		exec := podmanTest.PodmanExitCleanly("exec", "fork", "cat", "/file", "/data/file")
+		Expect(exec.OutputToStringArray()).To(Equal([]string{"hello", "vol"}))
+		podmanTest.PodmanExitCleanly("exec", "fork", "test", "!", "-e", "/etc/alpine-release")
+
+		// The clone has its own anonymous volume
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{range .Mounts}}{{.Name}}{{end}}", "prepared", "fork")
+		names := inspect.OutputToStringArray()
+		Expect(names).To(HaveLen(2))
+		Expect(names[0]).ToNot(Equal(names[1]))
+		podmanTest.PodmanExitCleanly("exec", "fork", "sh", "-c", "echo changed > /data/file")
+		podmanTest.PodmanExitCleanly("start", "prepared")
+		exec = podmanTest.PodmanExitCleanly("exec", "prepared", "cat", "/data/file")
+		Expect(exec.OutputToString()).To(Equal("vol"))
+
+		// Without --copy-changes the clone starts from the image
+		podmanTest.PodmanExitCleanly("container", "clone", "--run", "prepared", "pristine")
+		podmanTest.PodmanExitCleanly("exec", "pristine", "test", "-e", "/etc/alpine-release")
+
+		clone := podmanTest.Podman([]string{"container", "clone", "--copy-changes", "prepared", "other", FEDORA_MINIMAL})
+		clone.WaitWithDefaultTimeout()
+		Expect(clone).Should(ExitWithError(125, "is not based on the image of container"))
+	})
+
+	It("podman container clone --destroy failure removes the clone", func() {
+		run := podmanTest.PodmanExitCleanly("run", "-d", "--name", "original", ALPINE, "top")
+
+		// A running container is not destroyed without --force
+		clone := podmanTest.Podman([]string{"container", "clone", "--destroy", "original", "replacement"})
+		clone.WaitWithDefaultTimeout()
+		Expect(clone).Should(ExitWithError(125, "cannot remove container"))
+
+		ps := podmanTest.PodmanExitCleanly("ps", "-a", "--no-trunc", "-q")
+		Expect(ps.OutputToStringArray()).To(Equal([]string{run.OutputToString()}))
+	})
+
+	It("podman container clone --copy-changes of a running container", func() {
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "running", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("exec", "running", "sh", "-c", "echo hello > /file && rm -r /etc/alpine-release /etc/apk")
+
+		// The container is paused only while its changes are copied
+		podmanTest.PodmanExitCleanly("container", "clone", "--copy-changes", "--run", "running", "fork")
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}}", "running")
+		Expect(inspect.OutputToString()).To(Equal("running"))
+		podmanTest.PodmanExitCleanly("exec", "running", "true")
+
+		exec := podmanTest.PodmanExitCleanly("exec", "fork", "cat", "/file")
+		Expect(exec.OutputToString()).To(Equal("hello"))
+		// Deleted files and directories stay deleted in the clone
+		podmanTest.PodmanExitCleanly("exec", "fork", "test", "!", "-e", "/etc/alpine-release")
+		podmanTest.PodmanExitCleanly("exec", "fork", "test", "!", "-e", "/etc/apk")
+	})
+
 	It("podman container clone network passing", func() {
 		networkCreate := podmanTest.Podman([]string{"network", "create", "testing123"})
 		networkCreate.WaitWithDefaultTimeout()
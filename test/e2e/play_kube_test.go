@@ -5938,6 +5938,81 @@ spec:
 		Expect(ps.OutputToStringArray()).To(BeEmpty())
 	})
 
+	It("removes the objects created before a failure", func() {
+		podmanTest.PodmanExitCleanly("pod", "create", "existing")
+
+		pvcYaml, err := getKubeYaml("persistentVolumeClaim", getPVC(withPVCName("rollback-pvc")))
+		Expect(err).ToNot(HaveOccurred())
+		goodYaml, err := getKubeYaml("pod", getPod(withPodName("good")))
+		Expect(err).ToNot(HaveOccurred())
+		badYaml, err := getKubeYaml("pod", getPod(withPodName("bad"), withCtr(getCtr(withName("testctr"))), withCtr(getCtr(withName("testctr")))))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = generateMultiDocKubeYaml([]string{secretYaml, pvcYaml, goodYaml, badYaml}, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).To(ExitWithError(125, `the pod "bad" is invalid; duplicate container name "testctr" detected`))
+
+		// Only the pod which existed before is left
+		ps := podmanTest.PodmanExitCleanly("pod", "ps", "--format", "{{.Name}}")
+		Expect(ps.OutputToStringArray()).To(Equal([]string{"existing"}))
+		ctrs := podmanTest.PodmanExitCleanly("ps", "-a", "-q")
+		Expect(ctrs.OutputToStringArray()).To(HaveLen(1), "only the infra container of the existing pod")
+
+		exists := podmanTest.Podman([]string{"volume", "exists", "rollback-pvc"})
+		exists.WaitWithDefaultTimeout()
+		Expect(exists).Should(ExitWithError(1, ""))
+		exists = podmanTest.Podman([]string{"secret", "exists", "newsecret"})
+		exists.WaitWithDefaultTimeout()
+		Expect(exists).Should(ExitWithError(1, ""))
+
+		// Once the YAML is fixed, it can be played again
+		err = generateMultiDocKubeYaml([]string{secretYaml, pvcYaml, goodYaml}, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+	})
+
+	It("keeps an existing PVC volume after a failure", func() {
+		podmanTest.PodmanExitCleanly("volume", "create", "--label", "keep=me", "rollback-pvc")
+
+		pvcYaml, err := getKubeYaml("persistentVolumeClaim", getPVC(withPVCName("rollback-pvc")))
+		Expect(err).ToNot(HaveOccurred())
+		badYaml, err := getKubeYaml("pod", getPod(withPodName("bad"), withCtr(getCtr(withName("testctr"))), withCtr(getCtr(withName("testctr")))))
+		Expect(err).ToNot(HaveOccurred())
+		err = generateMultiDocKubeYaml([]string{pvcYaml, badYaml}, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).To(ExitWithError(125, `the pod "bad" is invalid; duplicate container name "testctr" detected`))
+
+		// The volume existed before, so it is not removed
+		inspect := podmanTest.PodmanExitCleanly("volume", "inspect", "--format", "{{.Labels.keep}}", "rollback-pvc")
+		Expect(inspect.OutputToString()).To(Equal("me"))
+	})
+
+	It("restores a replaced secret after a failure", func() {
+		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFilePath, []byte("olddata"), 0o600)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("secret", "create", "--label", "keep=me", "newsecret", secretFilePath)
+
+		badYaml, err := getKubeYaml("pod", getPod(withPodName("bad"), withCtr(getCtr(withName("testctr"))), withCtr(getCtr(withName("testctr")))))
+		Expect(err).ToNot(HaveOccurred())
+		err = generateMultiDocKubeYaml([]string{secretYaml, badYaml}, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).To(ExitWithError(125, `the pod "bad" is invalid; duplicate container name "testctr" detected`))
+
+		// The secret has its previous contents and labels again
+		inspect := podmanTest.PodmanExitCleanly("secret", "inspect", "--showsecret", "--format", "{{.SecretData}} {{.Spec.Labels.keep}}", "newsecret")
+		Expect(inspect.OutputToString()).To(Equal("olddata me"))
+	})
+
 	It("with named volume subpaths", func() {
 		SkipIfRemote("volume export does not exist on remote")
 		podmanTest.PodmanExitCleanly("volume", "create", "testvol1")
@@ -0,0 +1,72 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("Podman plugin", func() {
+	BeforeEach(func() {
+		os.Setenv("CONTAINERS_CONF", "config/containers.conf")
+		SkipIfRemote("Volume plugins only supported as local")
+		SkipIfRootless("Root is required for volume plugin testing")
+		err = os.MkdirAll("/run/docker/plugins", 0o755)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("plugin ls lists configured plugins", func() {
+		session := podmanTest.PodmanExitCleanly("plugin", "ls", "--noheading", "--format", "{{.Name}} {{.SocketPath}}")
+		Expect(session.OutputToStringArray()).To(ContainElement("testvol0 /run/docker/plugins/testvol0.sock"))
+
+		session = podmanTest.PodmanExitCleanly("plugin", "ls", "--quiet", "--filter", "name=^testvol0$")
+		Expect(session.OutputToStringArray()).To(Equal([]string{"testvol0"}))
+
+		session = podmanTest.Podman([]string{"plugin", "ls", "--filter", "label=foo"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, `"label" is an invalid plugin filter`))
+	})
+
+	It("plugin inspect reports an unreachable plugin", func() {
+		session := podmanTest.Podman([]string{"plugin", "inspect", "testvol0", "notexist"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, `inspecting plugin: no such plugin "notexist"`))
+		Expect(session.OutputToString()).To(BeValidJSON())
+
+		session = podmanTest.PodmanExitCleanly("plugin", "inspect", "--format", "{{.Reachable}} {{len .Implements}}", "testvol0")
+		Expect(session.OutputToString()).To(Equal("false 0"))
+
+		session = podmanTest.PodmanExitCleanly("plugin", "ls", "--quiet", "--filter", "enabled=false", "--filter", "name=testvol0")
+		Expect(session.OutputToStringArray()).To(Equal([]string{"testvol0"}))
+	})
+
+	It("plugin inspect reports a running plugin", func() {
+		podmanTest.AddImageToRWStore(volumeTest)
+
+		pluginStatePath := filepath.Join(podmanTest.TempDir, "volumes")
+		err := os.Mkdir(pluginStatePath, 0o755)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Keep this distinct within tests to avoid multiple tests using the same plugin.
+		pluginName := "testvol7"
+		plugin := podmanTest.Podman([]string{"run", "--security-opt", "label=disable", "-v", "/run/docker/plugins:/run/docker/plugins", "-v", fmt.Sprintf("%v:%v", pluginStatePath, pluginStatePath), "-d", volumeTest, "--sock-name", pluginName, "--path", pluginStatePath})
+		plugin.WaitWithDefaultTimeout()
+		Expect(plugin).Should(ExitCleanly())
+
+		// Make sure the socket is available (see #17956)
+		err = WaitForFile(fmt.Sprintf("/run/docker/plugins/%s.sock", pluginName))
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.PodmanExitCleanly("plugin", "inspect", "--format", "{{.Reachable}} {{join .Implements \",\"}} {{.Scope}}", pluginName)
+		Expect(session.OutputToString()).To(Equal("true VolumeDriver local"))
+
+		session = podmanTest.PodmanExitCleanly("plugin", "ls", "--quiet", "--filter", "capability=volumedriver", "--filter", "enabled=true")
+		Expect(session.OutputToStringArray()).To(ContainElement(pluginName))
+	})
+})
@@ -0,0 +1,156 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.podman.io/podman/v6/pkg/checkpoint/crutils"
+	"go.podman.io/podman/v6/pkg/criu"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("Podman pod checkpoint", func() {
+	BeforeEach(func() {
+		SkipIfRootless("checkpoint not supported in rootless mode")
+
+		cmd := exec.Command(podmanTest.OCIRuntime, "checkpoint", "--help")
+		if err := cmd.Start(); err != nil {
+			Skip("OCI runtime does not support checkpoint/restore")
+		}
+		if err := cmd.Wait(); err != nil {
+			Skip("OCI runtime does not support checkpoint/restore")
+		}
+
+		if err := criu.CheckForCriu(criu.MinCriuVersion); err != nil {
+			Skip(fmt.Sprintf("check CRIU version error: %v", err))
+		}
+	})
+
+	It("podman pod checkpoint bogus pod", func() {
+		session := podmanTest.Podman([]string{"pod", "checkpoint", "foobar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "no pod with name or ID foobar found"))
+	})
+
+	It("podman pod restore bogus pod", func() {
+		session := podmanTest.Podman([]string{"pod", "restore", "foobar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "no pod with name or ID foobar found"))
+	})
+
+	It("podman pod checkpoint pod without running containers", func() {
+		_, ec, podID := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"pod", "checkpoint", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "has no running containers to checkpoint"))
+	})
+
+	It("podman pod checkpoint and restore in place", func() {
+		skipIfNoPodCheckpointSupport()
+
+		_, ec, podID := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		podmanTest.PodmanExitCleanly("run", "-d", "--pod", podID, "--name", "podctr1", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("run", "-d", "--pod", podID, "--name", "podctr2", ALPINE, "top")
+
+		result := podmanTest.PodmanExitCleanly("pod", "checkpoint", podID)
+		Expect(result.OutputToString()).To(Equal(podID))
+
+		for _, ctr := range []string{"podctr1", "podctr2"} {
+			inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.State.Checkpointed}}", ctr)
+			Expect(inspect.OutputToString()).To(Equal("exited true"))
+		}
+
+		result = podmanTest.PodmanExitCleanly("pod", "restore", podID)
+		Expect(result.OutputToString()).To(Equal(podID))
+
+		for _, ctr := range []string{"podctr1", "podctr2"} {
+			inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.State.Restored}}", ctr)
+			Expect(inspect.OutputToString()).To(Equal("running true"))
+		}
+	})
+
+	It("podman pod restore of a stopped pod", func() {
+		skipIfNoPodCheckpointSupport()
+
+		_, ec, podID := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		podmanTest.PodmanExitCleanly("run", "-d", "--pod", podID, "--name", "stoppedctr", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("pod", "checkpoint", podID)
+
+		// Stopping the pod stops its infra container, which restore has
+		// to start again
+		podmanTest.PodmanExitCleanly("pod", "stop", "-t0", podID)
+		inspect := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.State}}", podID)
+		Expect(inspect.OutputToString()).To(Equal("Exited"))
+
+		result := podmanTest.PodmanExitCleanly("pod", "restore", podID)
+		Expect(result.OutputToString()).To(Equal(podID))
+
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.State.Restored}}", "stoppedctr")
+		Expect(inspect.OutputToString()).To(Equal("running true"))
+		inspect = podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.State}}", podID)
+		Expect(inspect.OutputToString()).To(Equal("Running"))
+	})
+
+	It("podman pod checkpoint export and restore import with new name", func() {
+		skipIfNoPodCheckpointSupport()
+
+		podName := "checkpointpod"
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", podName, "--share", "ipc,net,uts")
+		podmanTest.PodmanExitCleanly("run", "-d", "--pod", podName, "--name", "exportctr", ALPINE, "top")
+
+		archive := filepath.Join(podmanTest.TempDir, "pod-checkpoint.tar")
+		podmanTest.PodmanExitCleanly("pod", "checkpoint", "--export", archive, "--leave-running", podName)
+
+		// The original pod is still running, so restore under a new name.
+		session := podmanTest.Podman([]string{"pod", "restore", "--import", archive})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "pod already exists"))
+
+		podmanTest.PodmanExitCleanly("pod", "restore", "--import", archive, "--name", "restoredpod")
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.PodName}}", "restoredpod-exportctr")
+		Expect(inspect.OutputToString()).To(Equal("running restoredpod"))
+
+		inspect = podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.SharedNamespaces}}", "restoredpod")
+		Expect(inspect.OutputToString()).To(ContainSubstring("ipc"))
+		Expect(inspect.OutputToString()).To(ContainSubstring("net"))
+		Expect(inspect.OutputToString()).To(ContainSubstring("uts"))
+
+		// Remove the original pod and restore it under its own name.
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", "-t0", podName)
+		podmanTest.PodmanExitCleanly("pod", "restore", "--import", archive)
+
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.PodName}}", "exportctr")
+		Expect(inspect.OutputToString()).To(Equal("running " + podName))
+	})
+
+	It("podman pod restore option validation", func() {
+		session := podmanTest.Podman([]string{"pod", "restore", "--name", "foo", "bar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "--name can only be used with --import"))
+
+		session = podmanTest.Podman([]string{"pod", "restore", "--import", "/tmp/pod.tar", "bar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "cannot use --import with positional arguments"))
+	})
+})
+
+func skipIfNoPodCheckpointSupport() {
+	if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+		Skip(fmt.Sprintf("check CRIU pod version error: %v", err))
+	}
+	if !crutils.CRRuntimeSupportsPodCheckpointRestore(podmanTest.OCIRuntime) {
+		Skip("runtime does not support pod restore: " + podmanTest.OCIRuntime)
+	}
+}
@@ -0,0 +1,79 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("Podman pod events", func() {
+
+	It("podman pod events bogus pod", func() {
+		session := podmanTest.Podman([]string{"pod", "events", "--stream=false", "foobar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "no such pod"))
+	})
+
+	It("podman pod events includes removed containers", func() {
+		_, ec, podid := podmanTest.CreatePod(map[string][]string{"--name": {"eventspod"}})
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"run", "--name", "removedctr", "--rm", "--pod", podid, ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// A container outside of the pod must not show up.
+		session = podmanTest.Podman([]string{"run", "--name", "otherctr", "--rm", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "events", "--stream=false", "--format", "{{.Type}} {{.Status}} {{.Name}}", "eventspod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		events := session.OutputToStringArray()
+		Expect(events).To(ContainElement("pod create eventspod"))
+		Expect(events).To(ContainElement("container create removedctr"))
+		Expect(events).To(ContainElement("container died removedctr"))
+		Expect(events).To(ContainElement("container remove removedctr"))
+		Expect(session.OutputToString()).ToNot(ContainSubstring("otherctr"))
+
+		session = podmanTest.Podman([]string{"pod", "events", "--stream=false", "--filter", "event=died", "--format", "{{.Status}} {{.Name}} {{.PodID}}", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"died removedctr " + podid}))
+	})
+
+	It("podman pod events --timeline", func() {
+		_, ec, podid := podmanTest.CreatePod(map[string][]string{"--name": {"timelinepod"}})
+		Expect(ec).To(Equal(0))
+
+		for _, ctr := range []struct {
+			name     string
+			exitCode int
+		}{
+			{"first", 0},
+			{"second", 7},
+		} {
+			session := podmanTest.Podman([]string{"run", "--name", ctr.name, "--pod", podid, ALPINE, "sh", "-c", fmt.Sprintf("exit %d", ctr.exitCode)})
+			session.WaitWithDefaultTimeout()
+			Expect(session.ExitCode()).To(Equal(ctr.exitCode))
+		}
+
+		session := podmanTest.Podman([]string{"pod", "events", "--timeline", "timelinepod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		lines := session.OutputToStringArray()
+		Expect(lines).ToNot(BeEmpty())
+		Expect(lines[0]).To(MatchRegexp(`^TIME\s+POD\s+.*first\s+second$`))
+		Expect(session.OutputToString()).To(ContainSubstring("died (exit 0)"))
+		Expect(session.OutputToString()).To(ContainSubstring("died (exit 7)"))
+
+		session = podmanTest.Podman([]string{"pod", "events", "--timeline", "--stream", "timelinepod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "--timeline and --stream cannot be used together"))
+	})
+})
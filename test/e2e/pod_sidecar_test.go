@@ -0,0 +1,173 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "go.podman.io/podman/v6/pkg/k8s.io/api/core/v1"
+	. "go.podman.io/podman/v6/test/utils"
+	"sigs.k8s.io/yaml"
+)
+
+var sidecarPodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: sidecarpod
+spec:
+  restartPolicy: Never
+  initContainers:
+  - name: proxy
+    image: ` + ALPINE + `
+    restartPolicy: Always
+    command: ["sh", "-c", "sleep 2; touch /data/ready; exec sleep inf"]
+    livenessProbe:
+      exec:
+        command: ["test", "-f", "/data/ready"]
+      periodSeconds: 1
+    volumeMounts:
+    - name: data
+      mountPath: /data
+  containers:
+  - name: app
+    image: ` + ALPINE + `
+    command: ["sh", "-c", "test -f /data/ready && exec sleep inf"]
+    volumeMounts:
+    - name: data
+      mountPath: /data
+  volumes:
+  - name: data
+    emptyDir: {}
+`
+
+var _ = Describe("Podman pod sidecars", func() {
+	timeFormat := "2006-01-02 15:04:05.999999999 -0700 MST"
+
+	It("podman create sidecar without --pod should fail", func() {
+		session := podmanTest.Podman([]string{"create", "--sidecar", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "must specify pod value with sidecar"))
+	})
+
+	It("podman create sidecar and init container should fail", func() {
+		session := podmanTest.Podman([]string{"create", "--sidecar", "--init-ctr", "once", "--pod", "new:foobar", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "--sidecar and --init-ctr cannot be used together"))
+	})
+
+	It("podman pod start waits for healthy sidecar and stops it last", func() {
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "sidecarpod")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "proxy", "--sidecar", "-v", "sidecarvol:/data",
+			"--health-cmd", "test -f /data/ready", "--health-interval", "1s",
+			ALPINE, "sh", "-c", "sleep 2; touch /data/ready; exec sleep inf")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "app", "-v", "sidecarvol:/data",
+			ALPINE, "sh", "-c", "test -f /data/ready && exec sleep inf")
+
+		podmanTest.PodmanExitCleanly("pod", "start", "sidecarpod")
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}}", "app")
+		Expect(inspect.OutputToString()).To(Equal("running"))
+
+		podmanTest.PodmanExitCleanly("pod", "stop", "-t", "0", "sidecarpod")
+
+		appStop := podmanTest.PodmanExitCleanly("inspect", "--format", "{{ .State.FinishedAt }}", "app")
+		proxyStop := podmanTest.PodmanExitCleanly("inspect", "--format", "{{ .State.FinishedAt }}", "proxy")
+		appStopTime, err := time.Parse(timeFormat, appStop.OutputToString())
+		Expect(err).ShouldNot(HaveOccurred())
+		proxyStopTime, err := time.Parse(timeFormat, proxyStop.OutputToString())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(proxyStopTime).To(BeTemporally(">", appStopTime))
+	})
+
+	It("podman pod start fails when sidecar stops before turning healthy", func() {
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "sidecarpod")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "proxy", "--sidecar",
+			"--health-cmd", "false", "--health-interval", "1s", ALPINE, "true")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "app", ALPINE, "top")
+
+		session := podmanTest.Podman([]string{"pod", "start", "sidecarpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "stopped before it became healthy"))
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}}", "app")
+		Expect(inspect.OutputToString()).To(Equal("created"))
+	})
+
+	It("podman start of a pod member starts and waits for healthy sidecar", func() {
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "sidecarpod")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "proxy", "--sidecar", "-v", "sidecarvol:/data",
+			"--health-cmd", "test -f /data/ready", "--health-interval", "1s",
+			ALPINE, "sh", "-c", "sleep 2; touch /data/ready; exec sleep inf")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--name", "app", "-v", "sidecarvol:/data",
+			ALPINE, "sh", "-c", "test -f /data/ready && exec sleep inf")
+
+		podmanTest.PodmanExitCleanly("start", "app")
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}}", "app")
+		Expect(inspect.OutputToString()).To(Equal("running"))
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.State.Health.Status}}", "proxy")
+		Expect(inspect.OutputToString()).To(Equal("running healthy"))
+	})
+
+	It("podman pod wait ignores sidecars", func() {
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "sidecarpod")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", "--sidecar", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("create", "--pod", "sidecarpod", ALPINE, "sh", "-c", "exit 3")
+		podmanTest.PodmanExitCleanly("pod", "start", "sidecarpod")
+
+		session := podmanTest.Podman([]string{"pod", "wait", "sidecarpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(3))
+	})
+
+	It("podman run --requires with healthy condition", func() {
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "db", "-v", "requiresvol:/data",
+			"--health-cmd", "test -f /data/ready", "--health-interval", "1s",
+			ALPINE, "sh", "-c", "sleep 2; touch /data/ready; exec sleep inf")
+
+		session := podmanTest.PodmanExitCleanly("run", "--requires", "db:healthy", "-v", "requiresvol:/data", ALPINE, "cat", "/data/ready")
+		Expect(session.OutputToString()).To(BeEmpty())
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Health.Status}}", "db")
+		Expect(inspect.OutputToString()).To(Equal("healthy"))
+	})
+
+	It("podman create --requires with invalid condition should fail", func() {
+		podmanTest.PodmanExitCleanly("create", "--name", "db", ALPINE, "top")
+
+		session := podmanTest.Podman([]string{"create", "--requires", "db:bogus", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `invalid condition "bogus" for dependency "db"`))
+
+		session = podmanTest.Podman([]string{"create", "--requires", "db:healthy", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "has no healthcheck"))
+	})
+
+	It("podman kube play native sidecar", func() {
+		kubeYaml := filepath.Join(podmanTest.TempDir, "sidecar.yaml")
+		err := writeYaml(sidecarPodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("kube", "play", kubeYaml)
+
+		inspect := podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}}", "sidecarpod-app")
+		Expect(inspect.OutputToString()).To(Equal("running"))
+		inspect = podmanTest.PodmanExitCleanly("inspect", "--format", "{{.State.Status}} {{.HostConfig.RestartPolicy.Name}}", "sidecarpod-proxy")
+		Expect(inspect.OutputToString()).To(Equal("running always"))
+
+		generate := podmanTest.PodmanExitCleanly("kube", "generate", "sidecarpod")
+		pod := new(v1.Pod)
+		err = yaml.Unmarshal(generate.Out.Contents(), pod)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.InitContainers).To(HaveLen(1))
+		Expect(pod.Spec.InitContainers[0].Name).To(Equal("sidecarpod-proxy"))
+		Expect(pod.Spec.InitContainers[0].RestartPolicy).ToNot(BeNil())
+		Expect(*pod.Spec.InitContainers[0].RestartPolicy).To(Equal(v1.ContainerRestartPolicyAlways))
+		Expect(pod.Spec.Containers).To(HaveLen(1))
+	})
+})
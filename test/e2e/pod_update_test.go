@@ -0,0 +1,97 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("Podman pod update", func() {
+	It("podman pod update bogus pod", func() {
+		session := podmanTest.Podman([]string{"pod", "update", "--restart", "always", "123"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "123"))
+	})
+
+	It("podman pod update without options", func() {
+		_, ec, podID := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"pod", "update", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "must provide at least one of resources, restartPolicy and labels to update a pod"))
+	})
+
+	It("podman pod update resources", func() {
+		SkipIfRootless("many of these handlers are not enabled while rootless in CI")
+		_, ec, podID := podmanTest.CreatePod(map[string][]string{"--cpus": {"1"}, "--memory": {"512m"}})
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.RunTopContainerInPod("", podID)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "update", "--cpus", "2", "--memory", "1g", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(podID))
+
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.CPUQuota}} {{.CPUPeriod}} {{.MemoryLimit}}", podID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("200000 100000 1073741824"))
+
+		cgroupPath := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.CgroupPath}}", podID})
+		cgroupPath.WaitWithDefaultTimeout()
+		Expect(cgroupPath).Should(ExitCleanly())
+
+		if !IsRemote() {
+			cpuMax, err := os.ReadFile(filepath.Join("/sys/fs/cgroup", cgroupPath.OutputToString(), "cpu.max"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.TrimSpace(string(cpuMax))).To(Equal("200000 100000"))
+		}
+	})
+
+	It("podman pod update restart policy", func() {
+		_, ec, podID := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"create", "--pod", podID, "--name", "podupdatectr", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "update", "--restart", "on-failure:3", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.RestartPolicy}}", podID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("on-failure"))
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.RestartPolicy.Name}} {{.HostConfig.RestartPolicy.MaximumRetryCount}}", "podupdatectr"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("on-failure 3"))
+	})
+
+	It("podman pod update labels", func() {
+		_, ec, podID := podmanTest.CreatePod(map[string][]string{"--label": {"tier=web", "team=a"}})
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"pod", "update", "--label", "team=b", "--label", "env=prod", "--unset-label", "tier", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.Labels}}", podID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("map[env:prod team:b]"))
+	})
+})
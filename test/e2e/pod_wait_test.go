@@ -0,0 +1,122 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("Podman pod wait", func() {
+
+	It("podman pod wait bogus pod", func() {
+		session := podmanTest.Podman([]string{"pod", "wait", "foobar"})
+		session.WaitWithDefaultTimeout()
+		expect := "no pod with name or ID foobar found: no such pod"
+		if IsRemote() {
+			expect = `unable to find pod "foobar": no such pod`
+		}
+		Expect(session).To(ExitWithError(125, expect))
+	})
+
+	It("podman pod wait without containers", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"pod", "wait", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "has no containers to wait for"))
+	})
+
+	It("podman pod wait aggregates exit codes", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		for _, cmd := range []string{"exit 0", "exit 3"} {
+			session := podmanTest.Podman([]string{"create", "--pod", podid, ALPINE, "sh", "-c", cmd})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+		}
+
+		for _, tt := range []struct {
+			propagation string
+			exitCode    string
+		}{
+			{"", "3"},
+			{"any", "3"},
+			{"all", "0"},
+			{"none", "0"},
+		} {
+			session := podmanTest.Podman([]string{"pod", "start", podid})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			args := []string{"pod", "wait"}
+			if tt.propagation != "" {
+				args = append(args, "--exit-code-propagation", tt.propagation)
+			}
+			session = podmanTest.Podman(append(args, podid))
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(tt.exitCode), "exit-code-propagation %q", tt.propagation)
+		}
+	})
+
+	It("podman pod wait --exit-code-propagation all with all containers failing", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		for _, cmd := range []string{"exit 2", "sleep 1; exit 5"} {
+			session := podmanTest.Podman([]string{"run", "-d", "--pod", podid, ALPINE, "sh", "-c", cmd})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+		}
+
+		session := podmanTest.Podman([]string{"pod", "wait", "--exit-code-propagation", "all", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("5"))
+	})
+
+	It("podman pod wait invalid exit-code-propagation", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"pod", "wait", "--exit-code-propagation", "bogus", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `unsupported exit-code propagation "bogus"`))
+	})
+
+	It("podman pod wait --condition running", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.RunTopContainerInPod("", podid)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "wait", "--condition", "running", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("-1"))
+	})
+
+	It("podman pod wait --any", func() {
+		_, ec, podid := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.RunTopContainerInPod("", podid)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podid, ALPINE, "sh", "-c", "exit 4"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "wait", "--any", "-i", "100ms", podid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("4"))
+	})
+})
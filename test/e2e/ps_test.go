@@ -1182,4 +1182,56 @@ var _ = Describe("Podman ps", func() {
 		Expect(output).ToNot(ContainSubstring("test-unless-stopped-not-user-stop"))
 		Expect(output).ToNot(ContainSubstring("test-always-not-user-stop"))
 	})
+
+	It("podman ps --removed", func() {
+		session := podmanTest.Podman([]string{"run", "--rm", "--name", "removed-job", "--log-driver", "k8s-file", ALPINE, "sh", "-c", "echo first; echo last; exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(3, ""))
+
+		podmanTest.PodmanExitCleanly("create", "--name", "removed-created", ALPINE, "true")
+		podmanTest.PodmanExitCleanly("rm", "removed-created")
+
+		session = podmanTest.PodmanExitCleanly("ps", "-a", "--format", "{{.Names}}")
+		Expect(session.OutputToString()).ToNot(ContainSubstring("removed-"))
+
+		session = podmanTest.PodmanExitCleanly("ps", "--removed", "--format", "{{.Names}} {{.Status}}")
+		lines := session.OutputToStringArray()
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(Equal("removed-created Created"))
+		Expect(lines[1]).To(HavePrefix("removed-job Exited (3) "))
+
+		session = podmanTest.PodmanExitCleanly("ps", "--removed", "--last", "1", "--format", "{{.Names}}")
+		Expect(session.OutputToString()).To(Equal("removed-created"))
+
+		session = podmanTest.PodmanExitCleanly("ps", "--removed", "--format", "json")
+		Expect(session.OutputToString()).To(BeValidJSON())
+		Expect(session.OutputToString()).To(ContainSubstring(`"ExitCode": 3`))
+
+		session = podmanTest.PodmanExitCleanly("ps", "--removed", "--format", "{{.Names}} {{.Logs}}")
+		Expect(session.OutputToStringArray()[1]).To(Equal("removed-job [first last]"))
+
+		session = podmanTest.PodmanExitCleanly("ps", "--removed", "-q", "--no-trunc")
+		Expect(session.OutputToStringArray()).To(HaveLen(2))
+		Expect(session.OutputToStringArray()[0]).To(HaveLen(64))
+
+		session = podmanTest.Podman([]string{"ps", "--removed", "--all"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "--removed and --all cannot be used together"))
+	})
+
+	It("podman ps --removed with tombstone retention of the cleanup process", func() {
+		SkipIfRemote("--tombstone-count is not a remote option")
+		for _, name := range []string{"removed-first", "removed-second"} {
+			podmanTest.PodmanExitCleanly("--tombstone-count=1", "run", "-d", "--rm", "--name", name, ALPINE, "true")
+			// The container is removed by its cleanup process
+			Eventually(func() int {
+				session := podmanTest.Podman([]string{"container", "exists", name})
+				session.WaitWithDefaultTimeout()
+				return session.ExitCode()
+			}, defaultWaitTimeout, 1).Should(Equal(1), "container %s is removed", name)
+		}
+
+		session := podmanTest.PodmanExitCleanly("ps", "--removed", "--format", "{{.Names}}")
+		Expect(session.OutputToStringArray()).To(Equal([]string{"removed-second"}))
+	})
 })
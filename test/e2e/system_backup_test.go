@@ -0,0 +1,184 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("podman system backup", func() {
+	It("podman system backup and restore", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		secretFile := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFile, []byte("mysecret"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("network", "create", "backupnet")
+		podmanTest.PodmanExitCleanly("secret", "create", "backupsecret", secretFile)
+		podmanTest.PodmanExitCleanly("volume", "create", "--label", "backup=true", "backupvol")
+		podmanTest.PodmanExitCleanly("run", "-v", "backupvol:/data", ALPINE, "sh", "-c", "echo hello > /data/file")
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "backuppod")
+		ctr := podmanTest.PodmanExitCleanly("create", "--name", "backupctr", "--pod", "backuppod", "--network", "backupnet", "--secret", "backupsecret", "-v", "backupvol:/data", ALPINE, "cat", "/data/file", "/run/secrets/backupsecret")
+		ctrID := ctr.OutputToString()
+
+		podmanTest.PodmanExitCleanly("system", "backup", "--volumes", "--secret-data", backup)
+
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", "backuppod")
+		podmanTest.PodmanExitCleanly("rm", "-af")
+		podmanTest.PodmanExitCleanly("volume", "rm", "backupvol")
+		podmanTest.PodmanExitCleanly("secret", "rm", "backupsecret")
+		podmanTest.PodmanExitCleanly("network", "rm", "backupnet")
+
+		session := podmanTest.PodmanExitCleanly("system", "restore", backup)
+		Expect(session.OutputToString()).To(ContainSubstring("1 pods, 1 volumes, 1 networks, 1 secrets"))
+
+		inspect := podmanTest.PodmanExitCleanly("container", "inspect", "--format", "{{.ID}} {{.State.Status}} {{.Pod}}", "backupctr")
+		podID := podmanTest.PodmanExitCleanly("pod", "inspect", "--format", "{{.ID}}", "backuppod").OutputToString()
+		Expect(inspect.OutputToString()).To(Equal(ctrID + " created " + podID))
+
+		session = podmanTest.PodmanExitCleanly("volume", "inspect", "--format", "{{.Labels.backup}}", "backupvol")
+		Expect(session.OutputToString()).To(Equal("true"))
+
+		podmanTest.PodmanExitCleanly("pod", "start", "backuppod")
+		session = podmanTest.PodmanExitCleanly("wait", "backupctr")
+		Expect(session.OutputToString()).To(Equal("0"))
+		session = podmanTest.PodmanExitCleanly("logs", "backupctr")
+		Expect(session.OutputToStringArray()).To(Equal([]string{"hello", "mysecret"}))
+	})
+
+	It("podman system restore of secrets without data", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		secretFile := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFile, []byte("mysecret"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+
+		podmanTest.PodmanExitCleanly("secret", "create", "--label", "backup=true", "backupsecret", secretFile)
+		podmanTest.PodmanExitCleanly("create", "--name", "backupctr", "--secret", "backupsecret", ALPINE, "cat", "/run/secrets/backupsecret")
+		podmanTest.PodmanExitCleanly("system", "backup", backup)
+
+		f, err := os.Open(backup)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		secrets := 0
+		for tr := tar.NewReader(f); ; {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			if filepath.Dir(hdr.Name) != "secrets" {
+				continue
+			}
+			contents, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`"backup": "true"`))
+			Expect(string(contents)).ToNot(ContainSubstring(`"data"`))
+			secrets++
+		}
+		Expect(secrets).To(Equal(1))
+
+		podmanTest.PodmanExitCleanly("rm", "backupctr")
+		podmanTest.PodmanExitCleanly("secret", "rm", "backupsecret")
+
+		session := podmanTest.Podman([]string{"system", "restore", backup})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "secrets backupsecret were backed up without their data and must be created with podman secret create before restoring: no such secret"))
+		session = podmanTest.Podman([]string{"container", "exists", "backupctr"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+
+		err = os.WriteFile(secretFile, []byte("newsecret"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("secret", "create", "backupsecret", secretFile)
+		session = podmanTest.PodmanExitCleanly("system", "restore", backup)
+		Expect(session.OutputToString()).To(ContainSubstring("0 secrets"))
+
+		session = podmanTest.PodmanExitCleanly("start", "--attach", "backupctr")
+		Expect(session.OutputToString()).To(Equal("newsecret"))
+	})
+
+	It("podman system restore removes restored objects on failure", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		// An image only present locally cannot be pulled again
+		podmanTest.PodmanExitCleanly("create", "--name", "imagesrc", ALPINE)
+		podmanTest.PodmanExitCleanly("commit", "-q", "imagesrc", "localhost/backup-only:latest")
+		podmanTest.PodmanExitCleanly("rm", "imagesrc")
+
+		podmanTest.PodmanExitCleanly("network", "create", "backupnet")
+		podmanTest.PodmanExitCleanly("volume", "create", "backupvol")
+		podmanTest.PodmanExitCleanly("pod", "create", "--name", "backuppod")
+		podmanTest.PodmanExitCleanly("create", "--name", "backupctr", "--network", "backupnet", "-v", "backupvol:/data", "localhost/backup-only:latest", "true")
+		podmanTest.PodmanExitCleanly("system", "backup", backup)
+
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", "backuppod")
+		podmanTest.PodmanExitCleanly("rm", "backupctr")
+		podmanTest.PodmanExitCleanly("volume", "rm", "backupvol")
+		podmanTest.PodmanExitCleanly("network", "rm", "backupnet")
+		podmanTest.PodmanExitCleanly("rmi", "localhost/backup-only:latest")
+
+		session := podmanTest.Podman([]string{"system", "restore", backup})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "image localhost/backup-only:latest is missing and could not be pulled"))
+
+		session = podmanTest.Podman([]string{"container", "exists", "backupctr"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+		session = podmanTest.Podman([]string{"pod", "exists", "backuppod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+		session = podmanTest.Podman([]string{"volume", "exists", "backupvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+		session = podmanTest.Podman([]string{"network", "exists", "backupnet"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+	})
+
+	It("podman system backup of volume used by running container", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "backupctr", "-v", "backupvol:/data", ALPINE, "top")
+
+		session := podmanTest.Podman([]string{"system", "backup", "--volumes", backup})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "backing up volume backupvol contents: volume is in use by running container"))
+		Expect(backup).ToNot(BeAnExistingFile())
+
+		// The configuration alone can be backed up
+		podmanTest.PodmanExitCleanly("system", "backup", backup)
+	})
+
+	It("podman system restore with name in use", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		podmanTest.PodmanExitCleanly("volume", "create", "backupvol")
+		podmanTest.PodmanExitCleanly("system", "backup", backup)
+
+		session := podmanTest.Podman([]string{"system", "restore", backup})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `volume name "backupvol" is already in use: volume already exists`))
+	})
+
+	It("podman system restore with existing networks", func() {
+		backup := filepath.Join(podmanTest.TempDir, "backup.tar")
+		podmanTest.PodmanExitCleanly("network", "create", "--subnet", "10.89.100.0/24", "backupnet")
+		podmanTest.PodmanExitCleanly("system", "backup", backup)
+
+		// A matching network is reused
+		session := podmanTest.PodmanExitCleanly("system", "restore", backup)
+		Expect(session.OutputToString()).To(ContainSubstring("Network backupnet already exists and matches the backup, reusing it"))
+		Expect(session.OutputToString()).To(ContainSubstring("0 networks"))
+
+		// A network of the same name with another subnet is not
+		podmanTest.PodmanExitCleanly("network", "rm", "backupnet")
+		podmanTest.PodmanExitCleanly("network", "create", "--subnet", "10.89.101.0/24", "backupnet")
+		session = podmanTest.Podman([]string{"system", "restore", backup})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `network "backupnet" exists and differs from the backup in its subnets: network already exists`))
+	})
+})
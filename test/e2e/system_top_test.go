@@ -0,0 +1,48 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.podman.io/podman/v6/libpod/define"
+	. "go.podman.io/podman/v6/test/utils"
+)
+
+var _ = Describe("podman system top", func() {
+	It("podman system top --no-stream", func() {
+		podmanTest.PodmanExitCleanly("create", ALPINE)
+		podmanTest.PodmanExitCleanly("run", "-d", ALPINE, "top")
+
+		session := podmanTest.PodmanExitCleanly("system", "top", "--no-stream")
+		lines := session.OutputToStringArray()
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring("CONTAINERS"))
+		Expect(lines[0]).To(ContainSubstring("CONMON"))
+
+		session = podmanTest.PodmanExitCleanly("system", "top", "--no-stream", "--format", "{{.Total}} {{.Running}} {{.Conmon}}")
+		Expect(session.OutputToString()).To(Equal("2 1 1"))
+	})
+
+	It("podman system top --format json", func() {
+		podmanTest.PodmanExitCleanly("run", "-d", ALPINE, "top")
+
+		session := podmanTest.PodmanExitCleanly("system", "top", "--no-stream", "--format", "json")
+		Expect(session.OutputToString()).To(BeValidJSON())
+		var usage define.SystemUsage
+		err := json.Unmarshal(session.Out.Contents(), &usage)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(usage.Containers.Total).To(Equal(1))
+		Expect(usage.Containers.States).To(HaveKeyWithValue("running", 1))
+		Expect(usage.Storage.GraphRootAllocated).To(BeNumerically(">", 0))
+		Expect(usage.Locks.Allocated).To(BeNumerically(">=", 1))
+	})
+
+	It("podman system top with invalid interval", func() {
+		session := podmanTest.Podman([]string{"system", "top", "--no-stream", "--interval", "0"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "invalid interval, must be a positive number greater zero"))
+	})
+})
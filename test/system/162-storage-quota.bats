@@ -0,0 +1,107 @@
+#!/usr/bin/env bats   -*- bats -*-
+#
+# podman container storage quota tests
+#
+
+load helpers
+
+# bats test_tags=ci:parallel
+@test "podman create --storage-quota - invalid options" {
+    run_podman 125 create --storage-quota-action pause $IMAGE true
+    is "$output" ".*storage quota action requires a storage quota.*" \
+       "action without a quota"
+
+    run_podman 125 create --storage-quota 1m --storage-quota-action bogus $IMAGE true
+    is "$output" ".*invalid storage quota action \"bogus\".*" "invalid action"
+
+    run_podman 125 create --storage-quota 0 $IMAGE true
+    is "$output" ".*storage quota must be greater than 0.*" "zero quota"
+
+    run_podman 125 create --storage-quota 1m --rootfs $PODMAN_TMPDIR true
+    is "$output" ".*storage quota and rootfs are mutually exclusive options.*" "conflicts with --rootfs"
+}
+
+# bats test_tags=ci:parallel
+@test "podman create --storage-quota - inspect" {
+    cname=c-quota-$(safename)
+    run_podman create --name $cname --storage-quota 1m $IMAGE true
+    run_podman inspect --format '{{.Config.StorageQuota}} {{.Config.StorageQuotaAction}}' $cname
+    is "$output" "1048576 stop" "quota and default action"
+
+    run_podman inspect --format '{{.Config.StorageQuotaMode}}' $cname
+    assert "$output" =~ "^(project|monitor)$" "storage quota mode"
+
+    # The clone keeps the quota
+    run_podman container clone $cname $cname-clone
+    run_podman inspect --format '{{.Config.StorageQuota}}' $cname-clone
+    is "$output" "1048576" "quota of the clone"
+
+    run_podman rm $cname $cname-clone
+}
+
+@test "podman run --storage-quota - monitored quota" {
+    skip_if_remote "podman container check-storage-quota is not available remotely"
+
+    cname=c-quota-$(safename)
+    run_podman run -d --name $cname --storage-quota 1m --storage-quota-action pause \
+               $IMAGE sleep infinity
+    run_podman inspect --format '{{.Config.StorageQuotaMode}}' $cname
+    if [[ "$output" == "project" ]]; then
+        run_podman rm -f -t0 $cname
+        skip "storage quota is enforced by project quotas"
+    fi
+
+    # Under the quota nothing happens
+    run_podman container check-storage-quota $cname
+    is "$output" "" "check under the quota"
+
+    run_podman ps --size --filter name=$cname --format '{{.Size}}'
+    assert "$output" =~ "quota .* / 1.05MB" "ps --size shows the quota"
+
+    run_podman exec $cname dd if=/dev/zero of=/big bs=1M count=2
+    run_podman container check-storage-quota $cname
+    is "$output" "exceeded" "check over the quota"
+
+    run_podman inspect --format '{{.State.Status}}' $cname
+    is "$output" "paused" "container paused by the quota action"
+
+    run_podman events --filter container=$cname --filter event=storage-quota-exceeded \
+               --stream=false --format '{{.Status}}'
+    is "$output" "storage-quota-exceeded" "storage-quota-exceeded event"
+
+    # Once space is freed, the container can be resumed
+    run_podman unpause $cname
+    run_podman exec $cname rm /big
+    run_podman container check-storage-quota $cname
+    is "$output" "" "check after freeing space"
+
+    run_podman rm -f -t0 $cname
+}
+
+@test "podman run --storage-quota - writable layer and volume together" {
+    skip_if_remote "podman container check-storage-quota is not available remotely"
+
+    cname=c-quota-$(safename)
+    run_podman run -d --name $cname --storage-quota 3m -v /data $IMAGE sleep infinity
+
+    # Project quotas limit each directory on its own, so the combined usage
+    # of a container with anonymous volumes is always monitored
+    run_podman inspect --format '{{.Config.StorageQuotaMode}}' $cname
+    is "$output" "monitor" "storage quota mode with an anonymous volume"
+
+    # Each on its own stays under the quota, together they exceed it
+    run_podman exec $cname dd if=/dev/zero of=/big bs=1M count=2
+    run_podman container check-storage-quota $cname
+    is "$output" "" "check with only the writable layer filled"
+
+    run_podman exec $cname dd if=/dev/zero of=/data/big bs=1M count=2
+    run_podman container check-storage-quota $cname
+    is "$output" "exceeded" "check with the writable layer and the volume filled"
+
+    run_podman inspect --format '{{.State.Status}}' $cname
+    is "$output" "exited" "container stopped by the quota action"
+
+    run_podman rm -f -t0 -v $cname
+}
+
+# vim: filetype=sh
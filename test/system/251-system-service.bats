@@ -361,3 +361,182 @@ function _podman_system_service {
   is "$output" ".* remote error: tls: certificate required"
   systemctl stop $SERVICE_NAME
 }
+
+@test "podman-system-service --authorization-policy=malformed fails to start" {
+    unset REMOTESYSTEM_TRANSPORT
+
+    skip_if_remote "podman system service unavailable over remote"
+
+    echo '{"defaultRole": "missing", "roles": {}}' >"${PODMAN_TMPDIR}/policy.json"
+
+    run_podman 125 system service unix://$PODMAN_TMPDIR/myunix.sock \
+      --authorization-policy="${PODMAN_TMPDIR}/policy.json"
+    is "$output" ".*invalid authorization policy .*: default role \"missing\" is not defined"
+}
+
+@test "podman-system-service --authorization-policy enforces roles" {
+    unset REMOTESYSTEM_TRANSPORT
+
+    skip_if_remote "podman system service unavailable over remote"
+    URL=unix://$PODMAN_TMPDIR/myunix.sock
+
+    cat >"${PODMAN_TMPDIR}/policy.json" <<EOF2
+{
+  "roles": {
+    "developer": {
+      "endpoints": [{"path": "/_ping"}, {"path": "/libpod/**"}],
+      "create": {"allowedHostPaths": ["$PODMAN_TMPDIR/allowed"]}
+    }
+  },
+  "bindings": [{"uid": $(id -u), "role": "developer"}]
+}
+EOF2
+    mkdir -p $PODMAN_TMPDIR/allowed $PODMAN_TMPDIR/forbidden
+
+    _podman_system_service $URL --time=0 --authorization-policy="${PODMAN_TMPDIR}/policy.json"
+    wait_for_file $PODMAN_TMPDIR/myunix.sock
+
+    run_podman --url $URL run --rm -v $PODMAN_TMPDIR/allowed:/data $IMAGE true
+
+    run_podman 125 --url $URL run --rm --privileged $IMAGE true
+    is "$output" ".*authorization denied: role \"developer\": privileged is not allowed"
+
+    run_podman 125 --url $URL run --rm --network host $IMAGE true
+    is "$output" ".*authorization denied: role \"developer\": host network namespace is not allowed"
+
+    run_podman 125 --url $URL run --rm -v $PODMAN_TMPDIR/forbidden:/data $IMAGE true
+    is "$output" ".*authorization denied: role \"developer\": bind mount of host path \"$PODMAN_TMPDIR/forbidden\" is not allowed"
+
+    run_podman 125 --url $URL run --rm --cap-add SYS_ADMIN $IMAGE true
+    is "$output" ".*authorization denied: role \"developer\": capability CAP_SYS_ADMIN is not allowed"
+
+    run_podman 125 --url $URL run --rm --device /dev/null:/dev/mynull $IMAGE true
+    is "$output" ".*authorization denied: role \"developer\": host device \"/dev/null\" is not allowed"
+
+    run_podman 125 --url $URL volume create -o type=none -o o=bind -o device=$PODMAN_TMPDIR/forbidden myvol
+    is "$output" ".*authorization denied: role \"developer\": bind mount of host path \"$PODMAN_TMPDIR/forbidden\" is not allowed"
+
+    cat >$PODMAN_TMPDIR/privileged.yaml <<EOF2
+apiVersion: v1
+kind: Pod
+metadata:
+  name: authzpod
+spec:
+  containers:
+  - name: ctr
+    image: $IMAGE
+    securityContext:
+      privileged: true
+EOF2
+    run_podman 125 --url $URL kube play $PODMAN_TMPDIR/privileged.yaml
+    is "$output" ".*authorization denied: role \"developer\": privileged is not allowed"
+
+    cat >$PODMAN_TMPDIR/hostpath.yaml <<EOF2
+apiVersion: v1
+kind: Pod
+metadata:
+  name: authzpod
+spec:
+  containers:
+  - name: ctr
+    image: $IMAGE
+  volumes:
+  - name: root
+    hostPath:
+      path: /
+EOF2
+    run_podman 125 --url $URL kube play $PODMAN_TMPDIR/hostpath.yaml
+    is "$output" ".*authorization denied: role \"developer\": bind mount of host path \"/\" is not allowed"
+
+    run curl -s --unix-socket $PODMAN_TMPDIR/myunix.sock -X POST -H "Content-Type: application/json" \
+        -d '{"image": "'$IMAGE'", "privileged": tru' http://d/v6.0.0/libpod/containers/create
+    assert "$output" =~ "authorization denied: checking request: decoding request body as JSON" \
+           "undecodable create requests are denied"
+
+    run curl -s --unix-socket $PODMAN_TMPDIR/myunix.sock http://d/v1.44/containers/json
+    assert "$output" =~ "is not allowed to GET /containers/json" \
+           "compat endpoints are not part of the role"
+
+    run_podman events --stream=false --since=1m --filter type=system --filter event=denied \
+               --format '{{.Name}} {{.Status}}'
+    assert "$output" =~ "uid=$(id -u) denied" "denials are logged as events"
+
+    systemctl stop $SERVICE_NAME
+    rm -f $PODMAN_TMPDIR/myunix.sock
+}
+
+@test "podman-system-service --audit-log records mutating requests" {
+    unset REMOTESYSTEM_TRANSPORT
+
+    skip_if_remote "podman system service unavailable over remote"
+    URL=unix://$PODMAN_TMPDIR/myunix.sock
+    auditlog=$PODMAN_TMPDIR/audit.log
+
+    cat >"${PODMAN_TMPDIR}/audit-policy.json" <<EOF2
+{
+  "rules": [
+    {"methods": ["POST"], "path": "/libpod/containers/create", "level": "request", "redact": ["Labels"]},
+    {"path": "/libpod/containers/*/wait", "level": "none"}
+  ]
+}
+EOF2
+
+    run_podman 125 system service $URL --audit-policy="${PODMAN_TMPDIR}/audit-policy.json"
+    is "$output" ".*--audit-policy requires --audit-log"
+
+    _podman_system_service $URL --time=0 --audit-log=$auditlog \
+        --audit-policy="${PODMAN_TMPDIR}/audit-policy.json"
+    wait_for_file $PODMAN_TMPDIR/myunix.sock
+
+    cname=c-$(random_string)
+    run_podman --url $URL create --name $cname --label secret=hush --env FOO=bar $IMAGE true
+    cid=$output
+    run_podman --url $URL ps -a
+    run_podman --url $URL rm $cname
+
+    run jq -c 'select(.method == "POST" and (.path | endswith("/libpod/containers/create")))' $auditlog
+    assert "$output" =~ "\"status\":201" "create is recorded with its status"
+    assert "$output" =~ "\"objects\":\\[\"$cid\"\\]" "create is recorded with the ID of the container"
+    assert "$output" =~ "\"identity\":\"uid=$(id -u)\"" "create is recorded with the caller"
+    assert "$output" =~ "\"labels\":\"<redacted>\"" "labels are redacted"
+    assert "$output" =~ "\"env\":\"<redacted>\"" "environment is redacted"
+    assert "$output" !~ "hush" "label value is not recorded"
+
+    run jq -c 'select(.method == "DELETE")' $auditlog
+    assert "$output" =~ "\"objects\":\\[\"$cname\"\\]" "remove is recorded with the container name"
+    assert "$output" !~ "\"headers\"" "metadata level omits headers"
+
+    run jq -c 'select(.method == "GET")' $auditlog
+    assert "$output" == "" "non-mutating requests are not recorded"
+
+    systemctl stop $SERVICE_NAME
+    rm -f $PODMAN_TMPDIR/myunix.sock
+}
+
+@test "podman-system-service --rate-limit refuses requests over the limit" {
+    unset REMOTESYSTEM_TRANSPORT
+
+    skip_if_remote "podman system service unavailable over remote"
+    URL=unix://$PODMAN_TMPDIR/myunix.sock
+
+    run_podman 125 system service $URL --rate-limit push=1/m
+    is "$output" ".*invalid request class \"push\": must be one of pull, build or default"
+
+    _podman_system_service $URL --time=0 --rate-limit pull=1/h --max-in-flight default=4
+    wait_for_file $PODMAN_TMPDIR/myunix.sock
+
+    pull_url="http://d/v6.0.0/libpod/images/pull?reference=$IMAGE&policy=missing&quiet=true"
+    run curl -s -o /dev/null -w '%{http_code}' -X POST --unix-socket $PODMAN_TMPDIR/myunix.sock "$pull_url"
+    is "$output" "200" "first pull is allowed"
+
+    run curl -s -D - -o /dev/null -X POST --unix-socket $PODMAN_TMPDIR/myunix.sock "$pull_url"
+    assert "$output" =~ "HTTP/1.1 429 Too Many Requests" "second pull is over the rate"
+    assert "$output" =~ "Retry-After: [0-9]+" "client is told when to retry"
+
+    # Other requests have their own budget
+    run_podman --url $URL info --format '{{.Service.QueueDepth}} {{range .Service.Limits}}{{.Class}}:{{.Burst}}:{{.MaxInFlight}} {{end}}'
+    is "$output" "0 pull:1:0 build:0:0 default:0:4 " "limits are shown by info"
+
+    systemctl stop $SERVICE_NAME
+    rm -f $PODMAN_TMPDIR/myunix.sock
+}
@@ -178,6 +178,40 @@ Size           |   ~${size}.*MB |        !0B |            0B
     run_podman volume rm -a
 }
 
+@test "podman system df --group-by" {
+    c1=c1-$(safename)
+    c2=c2-$(safename)
+    c3=c3-$(safename)
+    run_podman run --name $c1 --label team=a --log-driver k8s-file $IMAGE echo hello
+    run_podman run --name $c2 --label team=a $IMAGE true
+    run_podman create --name $c3 --label team=b $IMAGE true
+
+    run_podman system df --group-by label=team --format '{{.Group}}:{{.Containers}}'
+    assert "$output" == "a:2
+b:1" "containers grouped by label"
+
+    # Image layers shared between the groups are divided between them
+    run_podman system df --group-by label=team --format json
+    a_images=$(jq -r '.[] | select(.Group == "a") | .ImagesSize' <<<"$output")
+    b_images=$(jq -r '.[] | select(.Group == "b") | .ImagesSize' <<<"$output")
+    a_logs=$(jq -r '.[] | select(.Group == "a") | .LogSize' <<<"$output")
+    assert "$b_images" -gt 0 "image layers attributed to team b"
+    assert "$a_images" -gt "$b_images" "team a is attributed more of the image layers than team b"
+    assert "$a_logs" -gt 0 "log file of $c1 attributed to team a"
+
+    # ...or attributed to no group at all
+    run_podman system df --group-by label=team --exclusive --format '{{.Group}}:{{.ImagesSize}}'
+    assert "$output" == "a:0B
+b:0B" "shared image layers are not attributed with --exclusive"
+
+    run_podman 125 system df --group-by image
+    assert "$output" =~ "invalid group \"image\"" "invalid --group-by"
+    run_podman 125 system df --exclusive
+    is "$output" "Error: --exclusive requires --group-by"
+
+    run_podman rm $c1 $c2 $c3
+}
+
 # https://github.com/containers/podman/issues/24452
 @test "podman system df - Reclaimable is not negative" {
     local c1="c1-$(safename)"
@@ -199,6 +199,41 @@ load helpers
     run_podman rmi $imageID
 }
 
+@test "podman system check - volume mountpoint missing" {
+    volname=v-$(safename)
+    run_podman volume create $volname
+    run_podman volume inspect --format '{{.Mountpoint}}' $volname
+    mountpoint="$output"
+    rm -rf $mountpoint
+    run_podman 125 system check
+    assert "$output" =~ "volume-mountpoint-missing" "output from 'podman system check' with missing volume mountpoint"
+    run_podman 125 system check --format '{{range .Libpod}}{{.Check}} {{.Name}}{{end}}'
+    assert "$output" == "volume-mountpoint-missing $volname" "libpod issues in 'podman system check --format'"
+    run_podman system check -r
+    test -d $mountpoint || die "volume mountpoint $mountpoint was not recreated"
+    run_podman system check
+    run_podman volume rm $volname
+}
+
+@test "podman system check - container storage missing" {
+    cname=c-$(safename)
+    run_podman create --name $cname $IMAGE
+    cid="$output"
+    run_podman_testing remove-container --container=$cid
+    run_podman 125 system check --format '{{range .Libpod}}{{.Check}} {{.Name}} {{.RepairLossy}}{{end}}'
+    assert "$output" == "container-storage-missing $cname true" "libpod issues in 'podman system check --format'"
+
+    # Repairing removes the container, which requires --force
+    run_podman 125 system check -r
+    assert "$output" =~ "repairing it removes the container, use --force to do so" "output from 'podman system check -r'"
+    run_podman container exists $cname
+
+    run_podman 0+w system check -r -f
+    assert "$output" =~ "repaired" "output from 'podman system check -r -f'"
+    run_podman 1 container exists $cname
+    run_podman system check
+}
+
 function make_layer_blob() {
     local tmpdir=$(mktemp -d --tmpdir=${PODMAN_TMPDIR} make_layer_blob.XXXXXX)
     local blobfile
@@ -0,0 +1,106 @@
+#!/usr/bin/env bats   -*- bats -*-
+#
+# Tests for podman system gc
+#
+
+load helpers
+
+@test "podman system gc - invalid policy" {
+    run_podman 125 system gc
+    assert "$output" =~ "no garbage collection policy given" "system gc without a policy"
+
+    run_podman 125 system gc --max-disk-usage 101
+    assert "$output" =~ "must be between 0 and 100 percent" "system gc --max-disk-usage 101"
+
+    run_podman 125 system gc --image-unused-for 1h --protected-image '['
+    assert "$output" =~ "invalid protected image pattern" "system gc --protected-image with a bad pattern"
+
+    # The last use of images is not known for longer than tombstones are kept
+    run_podman 125 system gc --image-unused-for 720h
+    assert "$output" =~ "is longer than tombstones of removed containers are kept for \(168h0m0s\)" "system gc --image-unused-for past the tombstone retention"
+}
+
+@test "podman system gc - container TTL" {
+    local c_old="c-old-$(safename)"
+    local c_keep="c-keep-$(safename)"
+    local c_running="c-running-$(safename)"
+
+    run_podman run --name $c_old $IMAGE true
+    run_podman run --name $c_keep --label io.podman.gc.keep $IMAGE true
+    run_podman run -d --name $c_running $IMAGE top
+    sleep 2
+
+    local since=$(date --iso-8601=seconds)
+
+    # A dry run reports the container but keeps it
+    run_podman system gc --dry-run --container-ttl 1s \
+               --format '{{range .Removed}}{{.Type}} {{.Name}} {{.Reason}}{{"\n"}}{{end}}'
+    assert "$output" =~ "container $c_old container-ttl" "dry run reports the exited container"
+    assert "$output" !~ "$c_keep" "dry run skips the container with the keep label"
+    assert "$output" !~ "$c_running" "dry run skips the running container"
+    run_podman container exists $c_old
+
+    # A long TTL keeps it too
+    run_podman system gc --container-ttl 1h
+    assert "$output" !~ "$c_old" "container exited less than the TTL ago"
+    run_podman container exists $c_old
+
+    run_podman system gc --container-ttl 1s
+    assert "$output" =~ "Removed container [0-9a-f]{64} \($c_old\): container-ttl" "system gc output"
+    assert "$output" =~ "Total reclaimed space:" "system gc output"
+    run_podman 1 container exists $c_old
+    run_podman container exists $c_keep
+    run_podman container exists $c_running
+
+    run_podman events --since "$since" --stream=false --filter type=system --filter event=gc
+    assert "$output" =~ "system gc $c_old \(type=container, id=[0-9a-f]+, reason=container-ttl\)" "gc event"
+    assert "$output" !~ "$c_keep" "no gc event for the kept container"
+
+    # The keep label can be changed
+    run_podman system gc --container-ttl 1s --keep-label other.label
+    assert "$output" =~ "\($c_keep\): container-ttl" "container without the other keep label"
+    run_podman 1 container exists $c_keep
+
+    run_podman rm -f -t0 $c_running
+}
+
+@test "podman system gc - unused images" {
+    local imgname="i-$(safename)"
+    local cname="c-$(safename)"
+    run_podman build -t $imgname - <<EOF
+FROM $IMAGE
+RUN echo $imgname >/$imgname
+EOF
+    run_podman image inspect --format '{{.ID}}' $imgname
+    local iid="$output"
+
+    # Only dry runs, to keep the images of other tests
+    local format='{{range .Removed}}{{.Type}} {{.ID}} {{.Reason}}{{"\n"}}{{end}}'
+
+    # Images never used by a container are last used when they were built
+    run_podman system gc --dry-run --image-unused-for 1ns --format "$format"
+    assert "$output" =~ "image $iid image-unused" "image never used"
+    run_podman system gc --dry-run --image-unused-for 1h --format "$format"
+    assert "$output" !~ "$iid" "image built less than an hour ago"
+
+    # Images used by a container are never removed
+    run_podman create --name $cname $imgname
+    run_podman system gc --dry-run --image-unused-for 1ns --format "$format"
+    assert "$output" !~ "$iid" "image used by a container"
+    run_podman rm $cname
+
+    run_podman system gc --dry-run --image-unused-for 1ns --format "$format"
+    assert "$output" =~ "image $iid image-unused" "unused image"
+
+    # Not yet unused for long enough
+    run_podman system gc --dry-run --image-unused-for 1h --format "$format"
+    assert "$output" !~ "$iid" "image unused for less than an hour"
+
+    run_podman system gc --dry-run --image-unused-for 1ns --protected-image "localhost/i-*" --format "$format"
+    assert "$output" !~ "$iid" "protected image"
+
+    run_podman image exists $imgname
+    run_podman rmi $imgname
+}
+
+# vim: filetype=sh
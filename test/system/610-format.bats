@@ -164,6 +164,7 @@ pod inspect       | $podname
 
 events            | --stream=false --events-backend=file
 system events     | --stream=false --events-backend=file
+system top        | --no-stream
 "
 
 
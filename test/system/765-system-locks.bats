@@ -0,0 +1,40 @@
+#!/usr/bin/env bats   -*- bats -*-
+#
+# tests for podman system locks
+#
+
+load helpers
+
+function setup() {
+    basic_setup
+
+    skip_if_remote "podman system locks is not available remote"
+}
+
+@test "podman system locks - verbose and break" {
+    run_podman system locks --verbose
+    assert "$output" =~ "No lock conflicts have been detected" "system locks --verbose output"
+    assert "$output" !~ "Lock-order cycles" "no cycles without contention"
+
+    # Nothing holds a lock, so there is nothing to break
+    run_podman system locks --break
+    assert "$output" !~ "has been released" "system locks --break output"
+
+    run_podman system locks --help
+    assert "$output" =~ "--verbose" "--verbose is documented"
+    assert "$output" =~ "--break" "--break is documented"
+}
+
+@test "podman system locks - record holders" {
+    run_podman system locks --record-holders=false --verbose
+    assert "$output" =~ "Lock holders are not being recorded" "recording is off"
+
+    run_podman system locks --record-holders
+    run_podman system locks --verbose
+    assert "$output" !~ "Lock holders are not being recorded" "recording is on"
+
+    run_podman system locks --record-holders=false --verbose
+    assert "$output" =~ "Lock holders are not being recorded" "recording is off again"
+}
+
+# vim: filetype=sh
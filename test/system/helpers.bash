@@ -439,8 +439,8 @@ function clean_setup() {
 
             # Special case for timeout: check for locks (#18514)
             if [[ $status -eq 124 ]]; then
-                echo "# [teardown] $_LOG_PROMPT podman system locks" >&3
-                run "${PODMAN_CMD[@]}" system locks
+                echo "# [teardown] $_LOG_PROMPT podman system locks --verbose" >&3
+                run "${PODMAN_CMD[@]}" system locks --verbose
                 for line in "${lines[@]}"; do
                     echo "# $line" >&3
                 done